@@ -19,10 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_Login_FullMethodName          = "/api.auth.v1.AuthService/Login"
-	AuthService_Relogin_FullMethodName        = "/api.auth.v1.AuthService/Relogin"
-	AuthService_GetUserInfo_FullMethodName    = "/api.auth.v1.AuthService/GetUserInfo"
-	AuthService_UpdateUserInfo_FullMethodName = "/api.auth.v1.AuthService/UpdateUserInfo"
+	AuthService_Register_FullMethodName             = "/api.auth.v1.AuthService/Register"
+	AuthService_Login_FullMethodName                = "/api.auth.v1.AuthService/Login"
+	AuthService_SendLoginCode_FullMethodName        = "/api.auth.v1.AuthService/SendLoginCode"
+	AuthService_LoginWithCode_FullMethodName        = "/api.auth.v1.AuthService/LoginWithCode"
+	AuthService_OAuthLogin_FullMethodName           = "/api.auth.v1.AuthService/OAuthLogin"
+	AuthService_LinkOAuthIdentity_FullMethodName    = "/api.auth.v1.AuthService/LinkOAuthIdentity"
+	AuthService_ListOAuthIdentities_FullMethodName  = "/api.auth.v1.AuthService/ListOAuthIdentities"
+	AuthService_Refresh_FullMethodName              = "/api.auth.v1.AuthService/Refresh"
+	AuthService_Logout_FullMethodName               = "/api.auth.v1.AuthService/Logout"
+	AuthService_LogoutAll_FullMethodName            = "/api.auth.v1.AuthService/LogoutAll"
+	AuthService_ListSessions_FullMethodName         = "/api.auth.v1.AuthService/ListSessions"
+	AuthService_RevokeSession_FullMethodName        = "/api.auth.v1.AuthService/RevokeSession"
+	AuthService_ChangePassword_FullMethodName       = "/api.auth.v1.AuthService/ChangePassword"
+	AuthService_RequestPasswordReset_FullMethodName = "/api.auth.v1.AuthService/RequestPasswordReset"
+	AuthService_ResetPassword_FullMethodName        = "/api.auth.v1.AuthService/ResetPassword"
+	AuthService_DeleteAccount_FullMethodName        = "/api.auth.v1.AuthService/DeleteAccount"
+	AuthService_ExportMyData_FullMethodName         = "/api.auth.v1.AuthService/ExportMyData"
+	AuthService_Relogin_FullMethodName              = "/api.auth.v1.AuthService/Relogin"
+	AuthService_GetUserInfo_FullMethodName          = "/api.auth.v1.AuthService/GetUserInfo"
+	AuthService_UpdateUserInfo_FullMethodName       = "/api.auth.v1.AuthService/UpdateUserInfo"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -32,11 +48,65 @@ const (
 // 认证服务（JWT）
 //   - 登录成功签发 JWT，前端需在后续所有请求 Header 中携带：
 //     Authorization: Bearer <token>
+//   - access token 短期有效；过期后使用 refresh_token 调用 Refresh 换取新令牌对
 //   - 所有时间字段统一为字符串格式：YYYY-MM-DD HH:MM:SS
 type AuthServiceClient interface {
+	// 注册（用户名 + 密码）
+	// 用户名：3-32 位字母/数字/下划线，须以字母开头；密码：8-64 位，至少包含字母与数字
+	// 注册成功即视为登录，返回 user_id 与 JWT token
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterReply, error)
 	// 登录（用户名 + 密码）
 	// 返回 user_id 与 JWT token；expires_in 为 token 的有效期（秒）
+	// 用户不存在返回 USER_NOT_FOUND；密码错误返回 invalid credentials
+	// 连续失败过多（按用户名/IP 计数）返回 code=429 LOGIN_LOCKED，data.retry_after 为需等待秒数（同时设置 Retry-After 头）
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error)
+	// 发送手机登录验证码（匿名可调用）
+	// phone 支持 11 位中国大陆手机号或带国家码的 E.164 格式（如 +8613800138000）
+	// 同一手机号 resend_after 秒内不可重发；窗口内按手机号/IP 限制发送次数，超出返回 code=429 LOGIN_CODE_THROTTLED，data.retry_after 为需等待秒数
+	SendLoginCode(ctx context.Context, in *SendLoginCodeRequest, opts ...grpc.CallOption) (*SendLoginCodeReply, error)
+	// 手机验证码登录（匿名可调用）
+	// 验证码单次有效；错误、过期、已使用或尝试次数过多均返回 INVALID_LOGIN_CODE
+	// 手机号未注册时自动创建用户（无密码，可通过重置密码设置），is_new_user=true
+	LoginWithCode(ctx context.Context, in *LoginWithCodeRequest, opts ...grpc.CallOption) (*LoginWithCodeReply, error)
+	// 第三方登录（匿名可调用）
+	// 客户端完成渠道授权后提交 provider 与授权码；外部账号已绑定则登录对应用户，否则自动注册并绑定（is_new_user=true）
+	// 渠道未配置返回 UNKNOWN_OAUTH_PROVIDER；授权码无效返回 OAUTH_EXCHANGE_FAILED
+	OAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...grpc.CallOption) (*OAuthLoginReply, error)
+	// 为当前用户绑定第三方账号（每个渠道最多绑定一个）
+	// 外部账号已绑定其它用户返回 IDENTITY_ALREADY_LINKED；当前用户已绑定该渠道的其它账号返回 PROVIDER_ALREADY_LINKED
+	LinkOAuthIdentity(ctx context.Context, in *LinkOAuthIdentityRequest, opts ...grpc.CallOption) (*LinkOAuthIdentityReply, error)
+	// 当前用户已绑定的第三方账号
+	ListOAuthIdentities(ctx context.Context, in *ListOAuthIdentitiesRequest, opts ...grpc.CallOption) (*ListOAuthIdentitiesReply, error)
+	// 刷新令牌（匿名可调用）
+	// 使用 refresh_token 换取新的 access token 与 refresh token；旧 refresh token 立即失效
+	// refresh token 无效、已使用、已吊销或已过期返回 INVALID_REFRESH_TOKEN
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshReply, error)
+	// 退出登录：当前 access token 立即失效，并吊销其所属会话的 refresh token
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutReply, error)
+	// 退出全部设备：吊销当前用户的所有会话
+	LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption) (*LogoutAllReply, error)
+	// 登录设备/会话列表（当前用户未退出且未过期的会话）
+	// last_seen_at 为批量刷新，存在分钟级延迟；current=true 表示发起本次请求的会话
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsReply, error)
+	// 踢下线指定会话（仅限本人会话），该会话的 token 立即失效
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionReply, error)
+	// 修改密码（需提供旧密码）；成功后其它设备的会话将被吊销，当前会话保持登录
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordReply, error)
+	// 申请密码重置验证码（匿名可调用）
+	// 验证码通过通知通道发送，单次有效且会过期；为防止探测用户名，用户不存在时同样返回成功
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetReply, error)
+	// 使用验证码重置密码（匿名可调用）；成功后该用户所有会话被吊销，需要重新登录
+	// 验证码错误/已使用/已过期/尝试次数过多返回 INVALID_RESET_CODE
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordReply, error)
+	// 注销账号（需提供当前密码；无密码账号改为在重新登录后 5 分钟内调用）：吊销全部会话后删除账号及其聊天记录、帖子、评论、点赞、关注、小纸条解锁记录与第三方绑定
+	// 自己的帖子连同其下评论一并删除；他人内容上的点赞数/评论数同步回减；操作不可恢复
+	// 密码错误返回 invalid password；无密码账号登录已超过 5 分钟返回 RECENT_LOGIN_REQUIRED
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountReply, error)
+	// 导出个人数据（ZIP）：profile/pets/messages/posts/comments/likes/following/unlock_records/coin_transactions/identities/
+	// pet_health/reminders/memories 各一个 JSON 文件，以及 files/ 目录下头像、宠物头像、帖子与就诊记录引用的本地上传文件；
+	// 无法导出的文件 URL 列在 files_missing.json
+	// HTTP 直接返回 application/zip 文件流（Content-Disposition: attachment）；gRPC 通过 data 字段返回
+	ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataReply, error)
 	// 重新登录/校验当前登录态
 	// 说明：服务端从请求头读取 JWT 校验有效性，入参可为空即可。
 	Relogin(ctx context.Context, in *ReloginRequest, opts ...grpc.CallOption) (*ReloginReply, error)
@@ -54,6 +124,16 @@ func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
 	return &authServiceClient{cc}
 }
 
+func (c *authServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterReply)
+	err := c.cc.Invoke(ctx, AuthService_Register_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginReply)
@@ -64,6 +144,156 @@ func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ..
 	return out, nil
 }
 
+func (c *authServiceClient) SendLoginCode(ctx context.Context, in *SendLoginCodeRequest, opts ...grpc.CallOption) (*SendLoginCodeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendLoginCodeReply)
+	err := c.cc.Invoke(ctx, AuthService_SendLoginCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LoginWithCode(ctx context.Context, in *LoginWithCodeRequest, opts ...grpc.CallOption) (*LoginWithCodeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginWithCodeReply)
+	err := c.cc.Invoke(ctx, AuthService_LoginWithCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) OAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...grpc.CallOption) (*OAuthLoginReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthLoginReply)
+	err := c.cc.Invoke(ctx, AuthService_OAuthLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LinkOAuthIdentity(ctx context.Context, in *LinkOAuthIdentityRequest, opts ...grpc.CallOption) (*LinkOAuthIdentityReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkOAuthIdentityReply)
+	err := c.cc.Invoke(ctx, AuthService_LinkOAuthIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListOAuthIdentities(ctx context.Context, in *ListOAuthIdentitiesRequest, opts ...grpc.CallOption) (*ListOAuthIdentitiesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOAuthIdentitiesReply)
+	err := c.cc.Invoke(ctx, AuthService_ListOAuthIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshReply)
+	err := c.cc.Invoke(ctx, AuthService_Refresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutReply)
+	err := c.cc.Invoke(ctx, AuthService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption) (*LogoutAllReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutAllReply)
+	err := c.cc.Invoke(ctx, AuthService_LogoutAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsReply)
+	err := c.cc.Invoke(ctx, AuthService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionReply)
+	err := c.cc.Invoke(ctx, AuthService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordReply)
+	err := c.cc.Invoke(ctx, AuthService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordResetReply)
+	err := c.cc.Invoke(ctx, AuthService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetPasswordReply)
+	err := c.cc.Invoke(ctx, AuthService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountReply)
+	err := c.cc.Invoke(ctx, AuthService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportMyDataReply)
+	err := c.cc.Invoke(ctx, AuthService_ExportMyData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) Relogin(ctx context.Context, in *ReloginRequest, opts ...grpc.CallOption) (*ReloginReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReloginReply)
@@ -101,11 +331,65 @@ func (c *authServiceClient) UpdateUserInfo(ctx context.Context, in *UpdateUserIn
 // 认证服务（JWT）
 //   - 登录成功签发 JWT，前端需在后续所有请求 Header 中携带：
 //     Authorization: Bearer <token>
+//   - access token 短期有效；过期后使用 refresh_token 调用 Refresh 换取新令牌对
 //   - 所有时间字段统一为字符串格式：YYYY-MM-DD HH:MM:SS
 type AuthServiceServer interface {
+	// 注册（用户名 + 密码）
+	// 用户名：3-32 位字母/数字/下划线，须以字母开头；密码：8-64 位，至少包含字母与数字
+	// 注册成功即视为登录，返回 user_id 与 JWT token
+	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
 	// 登录（用户名 + 密码）
 	// 返回 user_id 与 JWT token；expires_in 为 token 的有效期（秒）
+	// 用户不存在返回 USER_NOT_FOUND；密码错误返回 invalid credentials
+	// 连续失败过多（按用户名/IP 计数）返回 code=429 LOGIN_LOCKED，data.retry_after 为需等待秒数（同时设置 Retry-After 头）
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	// 发送手机登录验证码（匿名可调用）
+	// phone 支持 11 位中国大陆手机号或带国家码的 E.164 格式（如 +8613800138000）
+	// 同一手机号 resend_after 秒内不可重发；窗口内按手机号/IP 限制发送次数，超出返回 code=429 LOGIN_CODE_THROTTLED，data.retry_after 为需等待秒数
+	SendLoginCode(context.Context, *SendLoginCodeRequest) (*SendLoginCodeReply, error)
+	// 手机验证码登录（匿名可调用）
+	// 验证码单次有效；错误、过期、已使用或尝试次数过多均返回 INVALID_LOGIN_CODE
+	// 手机号未注册时自动创建用户（无密码，可通过重置密码设置），is_new_user=true
+	LoginWithCode(context.Context, *LoginWithCodeRequest) (*LoginWithCodeReply, error)
+	// 第三方登录（匿名可调用）
+	// 客户端完成渠道授权后提交 provider 与授权码；外部账号已绑定则登录对应用户，否则自动注册并绑定（is_new_user=true）
+	// 渠道未配置返回 UNKNOWN_OAUTH_PROVIDER；授权码无效返回 OAUTH_EXCHANGE_FAILED
+	OAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginReply, error)
+	// 为当前用户绑定第三方账号（每个渠道最多绑定一个）
+	// 外部账号已绑定其它用户返回 IDENTITY_ALREADY_LINKED；当前用户已绑定该渠道的其它账号返回 PROVIDER_ALREADY_LINKED
+	LinkOAuthIdentity(context.Context, *LinkOAuthIdentityRequest) (*LinkOAuthIdentityReply, error)
+	// 当前用户已绑定的第三方账号
+	ListOAuthIdentities(context.Context, *ListOAuthIdentitiesRequest) (*ListOAuthIdentitiesReply, error)
+	// 刷新令牌（匿名可调用）
+	// 使用 refresh_token 换取新的 access token 与 refresh token；旧 refresh token 立即失效
+	// refresh token 无效、已使用、已吊销或已过期返回 INVALID_REFRESH_TOKEN
+	Refresh(context.Context, *RefreshRequest) (*RefreshReply, error)
+	// 退出登录：当前 access token 立即失效，并吊销其所属会话的 refresh token
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	// 退出全部设备：吊销当前用户的所有会话
+	LogoutAll(context.Context, *LogoutAllRequest) (*LogoutAllReply, error)
+	// 登录设备/会话列表（当前用户未退出且未过期的会话）
+	// last_seen_at 为批量刷新，存在分钟级延迟；current=true 表示发起本次请求的会话
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error)
+	// 踢下线指定会话（仅限本人会话），该会话的 token 立即失效
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionReply, error)
+	// 修改密码（需提供旧密码）；成功后其它设备的会话将被吊销，当前会话保持登录
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error)
+	// 申请密码重置验证码（匿名可调用）
+	// 验证码通过通知通道发送，单次有效且会过期；为防止探测用户名，用户不存在时同样返回成功
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetReply, error)
+	// 使用验证码重置密码（匿名可调用）；成功后该用户所有会话被吊销，需要重新登录
+	// 验证码错误/已使用/已过期/尝试次数过多返回 INVALID_RESET_CODE
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
+	// 注销账号（需提供当前密码；无密码账号改为在重新登录后 5 分钟内调用）：吊销全部会话后删除账号及其聊天记录、帖子、评论、点赞、关注、小纸条解锁记录与第三方绑定
+	// 自己的帖子连同其下评论一并删除；他人内容上的点赞数/评论数同步回减；操作不可恢复
+	// 密码错误返回 invalid password；无密码账号登录已超过 5 分钟返回 RECENT_LOGIN_REQUIRED
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountReply, error)
+	// 导出个人数据（ZIP）：profile/pets/messages/posts/comments/likes/following/unlock_records/coin_transactions/identities/
+	// pet_health/reminders/memories 各一个 JSON 文件，以及 files/ 目录下头像、宠物头像、帖子与就诊记录引用的本地上传文件；
+	// 无法导出的文件 URL 列在 files_missing.json
+	// HTTP 直接返回 application/zip 文件流（Content-Disposition: attachment）；gRPC 通过 data 字段返回
+	ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataReply, error)
 	// 重新登录/校验当前登录态
 	// 说明：服务端从请求头读取 JWT 校验有效性，入参可为空即可。
 	Relogin(context.Context, *ReloginRequest) (*ReloginReply, error)
@@ -123,9 +407,57 @@ type AuthServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedAuthServiceServer struct{}
 
+func (UnimplementedAuthServiceServer) Register(context.Context, *RegisterRequest) (*RegisterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
 func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*LoginReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedAuthServiceServer) SendLoginCode(context.Context, *SendLoginCodeRequest) (*SendLoginCodeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendLoginCode not implemented")
+}
+func (UnimplementedAuthServiceServer) LoginWithCode(context.Context, *LoginWithCodeRequest) (*LoginWithCodeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithCode not implemented")
+}
+func (UnimplementedAuthServiceServer) OAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OAuthLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) LinkOAuthIdentity(context.Context, *LinkOAuthIdentityRequest) (*LinkOAuthIdentityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkOAuthIdentity not implemented")
+}
+func (UnimplementedAuthServiceServer) ListOAuthIdentities(context.Context, *ListOAuthIdentitiesRequest) (*ListOAuthIdentitiesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOAuthIdentities not implemented")
+}
+func (UnimplementedAuthServiceServer) Refresh(context.Context, *RefreshRequest) (*RefreshReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedAuthServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedAuthServiceServer) LogoutAll(context.Context, *LogoutAllRequest) (*LogoutAllReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAll not implemented")
+}
+func (UnimplementedAuthServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedAuthServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
 func (UnimplementedAuthServiceServer) Relogin(context.Context, *ReloginRequest) (*ReloginReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Relogin not implemented")
 }
@@ -156,6 +488,24 @@ func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
 	s.RegisterService(&AuthService_ServiceDesc, srv)
 }
 
+func _AuthService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoginRequest)
 	if err := dec(in); err != nil {
@@ -174,6 +524,276 @@ func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_SendLoginCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendLoginCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SendLoginCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SendLoginCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SendLoginCode(ctx, req.(*SendLoginCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LoginWithCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginWithCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginWithCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginWithCode(ctx, req.(*LoginWithCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_OAuthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).OAuthLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_OAuthLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).OAuthLogin(ctx, req.(*OAuthLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LinkOAuthIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkOAuthIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LinkOAuthIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LinkOAuthIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LinkOAuthIdentity(ctx, req.(*LinkOAuthIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListOAuthIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOAuthIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListOAuthIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListOAuthIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListOAuthIdentities(ctx, req.(*ListOAuthIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Refresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Refresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LogoutAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LogoutAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LogoutAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LogoutAll(ctx, req.(*LogoutAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMyDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ExportMyData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ExportMyData(ctx, req.(*ExportMyDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_Relogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReloginRequest)
 	if err := dec(in); err != nil {
@@ -235,10 +855,74 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "api.auth.v1.AuthService",
 	HandlerType: (*AuthServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _AuthService_Register_Handler,
+		},
 		{
 			MethodName: "Login",
 			Handler:    _AuthService_Login_Handler,
 		},
+		{
+			MethodName: "SendLoginCode",
+			Handler:    _AuthService_SendLoginCode_Handler,
+		},
+		{
+			MethodName: "LoginWithCode",
+			Handler:    _AuthService_LoginWithCode_Handler,
+		},
+		{
+			MethodName: "OAuthLogin",
+			Handler:    _AuthService_OAuthLogin_Handler,
+		},
+		{
+			MethodName: "LinkOAuthIdentity",
+			Handler:    _AuthService_LinkOAuthIdentity_Handler,
+		},
+		{
+			MethodName: "ListOAuthIdentities",
+			Handler:    _AuthService_ListOAuthIdentities_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _AuthService_Refresh_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AuthService_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAll",
+			Handler:    _AuthService_LogoutAll_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _AuthService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AuthService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AuthService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AuthService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _AuthService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _AuthService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "ExportMyData",
+			Handler:    _AuthService_ExportMyData_Handler,
+		},
 		{
 			MethodName: "Relogin",
 			Handler:    _AuthService_Relogin_Handler,
@@ -19,32 +19,139 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthServiceChangePassword = "/api.auth.v1.AuthService/ChangePassword"
+const OperationAuthServiceDeleteAccount = "/api.auth.v1.AuthService/DeleteAccount"
+const OperationAuthServiceExportMyData = "/api.auth.v1.AuthService/ExportMyData"
 const OperationAuthServiceGetUserInfo = "/api.auth.v1.AuthService/GetUserInfo"
+const OperationAuthServiceLinkOAuthIdentity = "/api.auth.v1.AuthService/LinkOAuthIdentity"
+const OperationAuthServiceListOAuthIdentities = "/api.auth.v1.AuthService/ListOAuthIdentities"
+const OperationAuthServiceListSessions = "/api.auth.v1.AuthService/ListSessions"
 const OperationAuthServiceLogin = "/api.auth.v1.AuthService/Login"
+const OperationAuthServiceLoginWithCode = "/api.auth.v1.AuthService/LoginWithCode"
+const OperationAuthServiceLogout = "/api.auth.v1.AuthService/Logout"
+const OperationAuthServiceLogoutAll = "/api.auth.v1.AuthService/LogoutAll"
+const OperationAuthServiceOAuthLogin = "/api.auth.v1.AuthService/OAuthLogin"
+const OperationAuthServiceRefresh = "/api.auth.v1.AuthService/Refresh"
+const OperationAuthServiceRegister = "/api.auth.v1.AuthService/Register"
 const OperationAuthServiceRelogin = "/api.auth.v1.AuthService/Relogin"
+const OperationAuthServiceRequestPasswordReset = "/api.auth.v1.AuthService/RequestPasswordReset"
+const OperationAuthServiceResetPassword = "/api.auth.v1.AuthService/ResetPassword"
+const OperationAuthServiceRevokeSession = "/api.auth.v1.AuthService/RevokeSession"
+const OperationAuthServiceSendLoginCode = "/api.auth.v1.AuthService/SendLoginCode"
 const OperationAuthServiceUpdateUserInfo = "/api.auth.v1.AuthService/UpdateUserInfo"
 
 type AuthServiceHTTPServer interface {
+	// ChangePassword 修改密码（需提供旧密码）；成功后其它设备的会话将被吊销，当前会话保持登录
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error)
+	// DeleteAccount 注销账号（需提供当前密码；无密码账号改为在重新登录后 5 分钟内调用）：吊销全部会话后删除账号及其聊天记录、帖子、评论、点赞、关注、小纸条解锁记录与第三方绑定
+	// 自己的帖子连同其下评论一并删除；他人内容上的点赞数/评论数同步回减；操作不可恢复
+	// 密码错误返回 invalid password；无密码账号登录已超过 5 分钟返回 RECENT_LOGIN_REQUIRED
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountReply, error)
+	// ExportMyData 导出个人数据（ZIP）：profile/pets/messages/posts/comments/likes/following/unlock_records/coin_transactions/identities/
+	// pet_health/reminders/memories 各一个 JSON 文件，以及 files/ 目录下头像、宠物头像、帖子与就诊记录引用的本地上传文件；
+	// 无法导出的文件 URL 列在 files_missing.json
+	// HTTP 直接返回 application/zip 文件流（Content-Disposition: attachment）；gRPC 通过 data 字段返回
+	ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataReply, error)
 	// GetUserInfo 获取当前登录用户信息（从 JWT 中获取 user_id）
 	GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoReply, error)
+	// LinkOAuthIdentity 为当前用户绑定第三方账号（每个渠道最多绑定一个）
+	// 外部账号已绑定其它用户返回 IDENTITY_ALREADY_LINKED；当前用户已绑定该渠道的其它账号返回 PROVIDER_ALREADY_LINKED
+	LinkOAuthIdentity(context.Context, *LinkOAuthIdentityRequest) (*LinkOAuthIdentityReply, error)
+	// ListOAuthIdentities 当前用户已绑定的第三方账号
+	ListOAuthIdentities(context.Context, *ListOAuthIdentitiesRequest) (*ListOAuthIdentitiesReply, error)
+	// ListSessions 登录设备/会话列表（当前用户未退出且未过期的会话）
+	// last_seen_at 为批量刷新，存在分钟级延迟；current=true 表示发起本次请求的会话
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error)
 	// Login 登录（用户名 + 密码）
 	// 返回 user_id 与 JWT token；expires_in 为 token 的有效期（秒）
+	// 用户不存在返回 USER_NOT_FOUND；密码错误返回 invalid credentials
+	// 连续失败过多（按用户名/IP 计数）返回 code=429 LOGIN_LOCKED，data.retry_after 为需等待秒数（同时设置 Retry-After 头）
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	// LoginWithCode 手机验证码登录（匿名可调用）
+	// 验证码单次有效；错误、过期、已使用或尝试次数过多均返回 INVALID_LOGIN_CODE
+	// 手机号未注册时自动创建用户（无密码，可通过重置密码设置），is_new_user=true
+	LoginWithCode(context.Context, *LoginWithCodeRequest) (*LoginWithCodeReply, error)
+	// Logout 退出登录：当前 access token 立即失效，并吊销其所属会话的 refresh token
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	// LogoutAll 退出全部设备：吊销当前用户的所有会话
+	LogoutAll(context.Context, *LogoutAllRequest) (*LogoutAllReply, error)
+	// OAuthLogin 第三方登录（匿名可调用）
+	// 客户端完成渠道授权后提交 provider 与授权码；外部账号已绑定则登录对应用户，否则自动注册并绑定（is_new_user=true）
+	// 渠道未配置返回 UNKNOWN_OAUTH_PROVIDER；授权码无效返回 OAUTH_EXCHANGE_FAILED
+	OAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginReply, error)
+	// Refresh 刷新令牌（匿名可调用）
+	// 使用 refresh_token 换取新的 access token 与 refresh token；旧 refresh token 立即失效
+	// refresh token 无效、已使用、已吊销或已过期返回 INVALID_REFRESH_TOKEN
+	Refresh(context.Context, *RefreshRequest) (*RefreshReply, error)
+	// Register 注册（用户名 + 密码）
+	// 用户名：3-32 位字母/数字/下划线，须以字母开头；密码：8-64 位，至少包含字母与数字
+	// 注册成功即视为登录，返回 user_id 与 JWT token
+	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
 	// Relogin 重新登录/校验当前登录态
 	// 说明：服务端从请求头读取 JWT 校验有效性，入参可为空即可。
 	Relogin(context.Context, *ReloginRequest) (*ReloginReply, error)
+	// RequestPasswordReset 申请密码重置验证码（匿名可调用）
+	// 验证码通过通知通道发送，单次有效且会过期；为防止探测用户名，用户不存在时同样返回成功
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetReply, error)
+	// ResetPassword 使用验证码重置密码（匿名可调用）；成功后该用户所有会话被吊销，需要重新登录
+	// 验证码错误/已使用/已过期/尝试次数过多返回 INVALID_RESET_CODE
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
+	// RevokeSession 踢下线指定会话（仅限本人会话），该会话的 token 立即失效
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionReply, error)
+	// SendLoginCode 发送手机登录验证码（匿名可调用）
+	// phone 支持 11 位中国大陆手机号或带国家码的 E.164 格式（如 +8613800138000）
+	// 同一手机号 resend_after 秒内不可重发；窗口内按手机号/IP 限制发送次数，超出返回 code=429 LOGIN_CODE_THROTTLED，data.retry_after 为需等待秒数
+	SendLoginCode(context.Context, *SendLoginCodeRequest) (*SendLoginCodeReply, error)
 	// UpdateUserInfo 更新当前登录用户信息（仅POST）
 	UpdateUserInfo(context.Context, *UpdateUserInfoRequest) (*UpdateUserInfoReply, error)
 }
 
 func RegisterAuthServiceHTTPServer(s *http.Server, srv AuthServiceHTTPServer) {
 	r := s.Route("/")
+	r.POST("/v1/auth/register", _AuthService_Register0_HTTP_Handler(srv))
 	r.POST("/v1/auth/login", _AuthService_Login0_HTTP_Handler(srv))
+	r.POST("/v1/auth/phone/code", _AuthService_SendLoginCode0_HTTP_Handler(srv))
+	r.POST("/v1/auth/phone/login", _AuthService_LoginWithCode0_HTTP_Handler(srv))
+	r.POST("/v1/auth/oauth/login", _AuthService_OAuthLogin0_HTTP_Handler(srv))
+	r.POST("/v1/auth/oauth/link", _AuthService_LinkOAuthIdentity0_HTTP_Handler(srv))
+	r.GET("/v1/auth/oauth/identities", _AuthService_ListOAuthIdentities0_HTTP_Handler(srv))
+	r.POST("/v1/auth/refresh", _AuthService_Refresh0_HTTP_Handler(srv))
+	r.POST("/v1/auth/logout", _AuthService_Logout0_HTTP_Handler(srv))
+	r.POST("/v1/auth/logout-all", _AuthService_LogoutAll0_HTTP_Handler(srv))
+	r.GET("/v1/auth/sessions", _AuthService_ListSessions0_HTTP_Handler(srv))
+	r.POST("/v1/auth/sessions/{session_id}/revoke", _AuthService_RevokeSession0_HTTP_Handler(srv))
+	r.POST("/v1/auth/password/change", _AuthService_ChangePassword0_HTTP_Handler(srv))
+	r.POST("/v1/auth/password/reset-code", _AuthService_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/v1/auth/password/reset", _AuthService_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/v1/auth/account/delete", _AuthService_DeleteAccount0_HTTP_Handler(srv))
+	r.GET("/v1/auth/account/export", _AuthService_ExportMyData0_HTTP_Handler(srv))
 	r.POST("/v1/auth/relogin", _AuthService_Relogin0_HTTP_Handler(srv))
 	r.GET("/v1/auth/user-info", _AuthService_GetUserInfo0_HTTP_Handler(srv))
 	r.POST("/v1/auth/user-info", _AuthService_UpdateUserInfo0_HTTP_Handler(srv))
 }
 
+func _AuthService_Register0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RegisterRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRegister)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Register(ctx, req.(*RegisterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RegisterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _AuthService_Login0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in LoginRequest
@@ -67,6 +174,327 @@ func _AuthService_Login0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.C
 	}
 }
 
+func _AuthService_SendLoginCode0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SendLoginCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceSendLoginCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SendLoginCode(ctx, req.(*SendLoginCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SendLoginCodeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LoginWithCode0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginWithCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLoginWithCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginWithCode(ctx, req.(*LoginWithCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginWithCodeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_OAuthLogin0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OAuthLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceOAuthLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OAuthLogin(ctx, req.(*OAuthLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OAuthLoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LinkOAuthIdentity0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LinkOAuthIdentityRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLinkOAuthIdentity)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LinkOAuthIdentity(ctx, req.(*LinkOAuthIdentityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LinkOAuthIdentityReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListOAuthIdentities0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListOAuthIdentitiesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListOAuthIdentities)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListOAuthIdentities(ctx, req.(*ListOAuthIdentitiesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListOAuthIdentitiesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_Refresh0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRefresh)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Refresh(ctx, req.(*RefreshRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefreshReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_Logout0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LogoutAll0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutAllRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLogoutAll)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LogoutAll(ctx, req.(*LogoutAllRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutAllReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListSessions0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSessions(ctx, req.(*ListSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RevokeSession0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRevokeSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSession(ctx, req.(*RevokeSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeSessionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ChangePassword0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangePasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceChangePassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangePassword(ctx, req.(*ChangePasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangePasswordReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RequestPasswordReset0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestPasswordResetReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ResetPassword0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetPasswordReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_DeleteAccount0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAccountRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceDeleteAccount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAccount(ctx, req.(*DeleteAccountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteAccountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ExportMyData0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportMyDataRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceExportMyData)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportMyData(ctx, req.(*ExportMyDataRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportMyDataReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _AuthService_Relogin0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ReloginRequest
@@ -131,9 +559,25 @@ func _AuthService_UpdateUserInfo0_HTTP_Handler(srv AuthServiceHTTPServer) func(c
 }
 
 type AuthServiceHTTPClient interface {
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest, opts ...http.CallOption) (rsp *ChangePasswordReply, err error)
+	DeleteAccount(ctx context.Context, req *DeleteAccountRequest, opts ...http.CallOption) (rsp *DeleteAccountReply, err error)
+	ExportMyData(ctx context.Context, req *ExportMyDataRequest, opts ...http.CallOption) (rsp *ExportMyDataReply, err error)
 	GetUserInfo(ctx context.Context, req *GetUserInfoRequest, opts ...http.CallOption) (rsp *GetUserInfoReply, err error)
+	LinkOAuthIdentity(ctx context.Context, req *LinkOAuthIdentityRequest, opts ...http.CallOption) (rsp *LinkOAuthIdentityReply, err error)
+	ListOAuthIdentities(ctx context.Context, req *ListOAuthIdentitiesRequest, opts ...http.CallOption) (rsp *ListOAuthIdentitiesReply, err error)
+	ListSessions(ctx context.Context, req *ListSessionsRequest, opts ...http.CallOption) (rsp *ListSessionsReply, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	LoginWithCode(ctx context.Context, req *LoginWithCodeRequest, opts ...http.CallOption) (rsp *LoginWithCodeReply, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	LogoutAll(ctx context.Context, req *LogoutAllRequest, opts ...http.CallOption) (rsp *LogoutAllReply, err error)
+	OAuthLogin(ctx context.Context, req *OAuthLoginRequest, opts ...http.CallOption) (rsp *OAuthLoginReply, err error)
+	Refresh(ctx context.Context, req *RefreshRequest, opts ...http.CallOption) (rsp *RefreshReply, err error)
+	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *RegisterReply, err error)
 	Relogin(ctx context.Context, req *ReloginRequest, opts ...http.CallOption) (rsp *ReloginReply, err error)
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest, opts ...http.CallOption) (rsp *RequestPasswordResetReply, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *ResetPasswordReply, err error)
+	RevokeSession(ctx context.Context, req *RevokeSessionRequest, opts ...http.CallOption) (rsp *RevokeSessionReply, err error)
+	SendLoginCode(ctx context.Context, req *SendLoginCodeRequest, opts ...http.CallOption) (rsp *SendLoginCodeReply, err error)
 	UpdateUserInfo(ctx context.Context, req *UpdateUserInfoRequest, opts ...http.CallOption) (rsp *UpdateUserInfoReply, err error)
 }
 
@@ -145,6 +589,45 @@ func NewAuthServiceHTTPClient(client *http.Client) AuthServiceHTTPClient {
 	return &AuthServiceHTTPClientImpl{client}
 }
 
+func (c *AuthServiceHTTPClientImpl) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...http.CallOption) (*ChangePasswordReply, error) {
+	var out ChangePasswordReply
+	pattern := "/v1/auth/password/change"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceChangePassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...http.CallOption) (*DeleteAccountReply, error) {
+	var out DeleteAccountReply
+	pattern := "/v1/auth/account/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceDeleteAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...http.CallOption) (*ExportMyDataReply, error) {
+	var out ExportMyDataReply
+	pattern := "/v1/auth/account/export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceExportMyData))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthServiceHTTPClientImpl) GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...http.CallOption) (*GetUserInfoReply, error) {
 	var out GetUserInfoReply
 	pattern := "/v1/auth/user-info"
@@ -158,6 +641,45 @@ func (c *AuthServiceHTTPClientImpl) GetUserInfo(ctx context.Context, in *GetUser
 	return &out, nil
 }
 
+func (c *AuthServiceHTTPClientImpl) LinkOAuthIdentity(ctx context.Context, in *LinkOAuthIdentityRequest, opts ...http.CallOption) (*LinkOAuthIdentityReply, error) {
+	var out LinkOAuthIdentityReply
+	pattern := "/v1/auth/oauth/link"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLinkOAuthIdentity))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ListOAuthIdentities(ctx context.Context, in *ListOAuthIdentitiesRequest, opts ...http.CallOption) (*ListOAuthIdentitiesReply, error) {
+	var out ListOAuthIdentitiesReply
+	pattern := "/v1/auth/oauth/identities"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListOAuthIdentities))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...http.CallOption) (*ListSessionsReply, error) {
+	var out ListSessionsReply
+	pattern := "/v1/auth/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthServiceHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
 	pattern := "/v1/auth/login"
@@ -171,6 +693,84 @@ func (c *AuthServiceHTTPClientImpl) Login(ctx context.Context, in *LoginRequest,
 	return &out, nil
 }
 
+func (c *AuthServiceHTTPClientImpl) LoginWithCode(ctx context.Context, in *LoginWithCodeRequest, opts ...http.CallOption) (*LoginWithCodeReply, error) {
+	var out LoginWithCodeReply
+	pattern := "/v1/auth/phone/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLoginWithCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/v1/auth/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...http.CallOption) (*LogoutAllReply, error) {
+	var out LogoutAllReply
+	pattern := "/v1/auth/logout-all"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLogoutAll))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) OAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...http.CallOption) (*OAuthLoginReply, error) {
+	var out OAuthLoginReply
+	pattern := "/v1/auth/oauth/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceOAuthLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) Refresh(ctx context.Context, in *RefreshRequest, opts ...http.CallOption) (*RefreshReply, error) {
+	var out RefreshReply
+	pattern := "/v1/auth/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRefresh))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*RegisterReply, error) {
+	var out RegisterReply
+	pattern := "/v1/auth/register"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRegister))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthServiceHTTPClientImpl) Relogin(ctx context.Context, in *ReloginRequest, opts ...http.CallOption) (*ReloginReply, error) {
 	var out ReloginReply
 	pattern := "/v1/auth/relogin"
@@ -184,6 +784,58 @@ func (c *AuthServiceHTTPClientImpl) Relogin(ctx context.Context, in *ReloginRequ
 	return &out, nil
 }
 
+func (c *AuthServiceHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...http.CallOption) (*RequestPasswordResetReply, error) {
+	var out RequestPasswordResetReply
+	pattern := "/v1/auth/password/reset-code"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*ResetPasswordReply, error) {
+	var out ResetPasswordReply
+	pattern := "/v1/auth/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...http.CallOption) (*RevokeSessionReply, error) {
+	var out RevokeSessionReply
+	pattern := "/v1/auth/sessions/{session_id}/revoke"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceRevokeSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) SendLoginCode(ctx context.Context, in *SendLoginCodeRequest, opts ...http.CallOption) (*SendLoginCodeReply, error) {
+	var out SendLoginCodeReply
+	pattern := "/v1/auth/phone/code"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceSendLoginCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthServiceHTTPClientImpl) UpdateUserInfo(ctx context.Context, in *UpdateUserInfoRequest, opts ...http.CallOption) (*UpdateUserInfoReply, error) {
 	var out UpdateUserInfoReply
 	pattern := "/v1/auth/user-info"
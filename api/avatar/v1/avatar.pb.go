@@ -36,9 +36,13 @@ type PetModel struct {
 	// 是否默认
 	IsDefault bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
 	// 排序序号（数值越小越靠前）
-	SortOrder     int32 `protobuf:"varint,6,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	SortOrder int32 `protobuf:"varint,6,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// 当前用户是否尚未解锁（不可设置为当前模型）
+	Locked bool `protobuf:"varint,7,opt,name=locked,proto3" json:"locked,omitempty"`
+	// 解锁条件（无条件时各项为 0）
+	UnlockRequirement *UnlockRequirement `protobuf:"bytes,8,opt,name=unlock_requirement,json=unlockRequirement,proto3" json:"unlock_requirement,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *PetModel) Reset() {
@@ -113,6 +117,84 @@ func (x *PetModel) GetSortOrder() int32 {
 	return 0
 }
 
+func (x *PetModel) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *PetModel) GetUnlockRequirement() *UnlockRequirement {
+	if x != nil {
+		return x.UnlockRequirement
+	}
+	return nil
+}
+
+// 模型解锁条件：先达到等级，再支付金币（两项均可为 0）
+type UnlockRequirement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 所需宠物等级（0/1 表示不限）
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// 所需金币（0 表示免费）
+	Coins int32 `protobuf:"varint,2,opt,name=coins,proto3" json:"coins,omitempty"`
+	// 展示文案，例如“宠物达到 Lv.5 解锁”；无条件时为空
+	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockRequirement) Reset() {
+	*x = UnlockRequirement{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockRequirement) ProtoMessage() {}
+
+func (x *UnlockRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockRequirement.ProtoReflect.Descriptor instead.
+func (*UnlockRequirement) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UnlockRequirement) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *UnlockRequirement) GetCoins() int32 {
+	if x != nil {
+		return x.Coins
+	}
+	return 0
+}
+
+func (x *UnlockRequirement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 type GetModelsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -121,7 +203,7 @@ type GetModelsRequest struct {
 
 func (x *GetModelsRequest) Reset() {
 	*x = GetModelsRequest{}
-	mi := &file_avatar_v1_avatar_proto_msgTypes[1]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -133,7 +215,7 @@ func (x *GetModelsRequest) String() string {
 func (*GetModelsRequest) ProtoMessage() {}
 
 func (x *GetModelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avatar_v1_avatar_proto_msgTypes[1]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -146,20 +228,22 @@ func (x *GetModelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModelsRequest.ProtoReflect.Descriptor instead.
 func (*GetModelsRequest) Descriptor() ([]byte, []int) {
-	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{1}
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{2}
 }
 
 type GetModelsReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 模型列表
-	Models        []*PetModel `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Models []*PetModel `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	// 模型目录版本号（管理端每次修改 +1；HTTP 响应同时带 ETag，可用 If-None-Match 获取 304）
+	CatalogVersion int64 `protobuf:"varint,2,opt,name=catalog_version,json=catalogVersion,proto3" json:"catalog_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetModelsReply) Reset() {
 	*x = GetModelsReply{}
-	mi := &file_avatar_v1_avatar_proto_msgTypes[2]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -171,7 +255,7 @@ func (x *GetModelsReply) String() string {
 func (*GetModelsReply) ProtoMessage() {}
 
 func (x *GetModelsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_avatar_v1_avatar_proto_msgTypes[2]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -184,7 +268,7 @@ func (x *GetModelsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModelsReply.ProtoReflect.Descriptor instead.
 func (*GetModelsReply) Descriptor() ([]byte, []int) {
-	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{2}
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetModelsReply) GetModels() []*PetModel {
@@ -194,6 +278,13 @@ func (x *GetModelsReply) GetModels() []*PetModel {
 	return nil
 }
 
+func (x *GetModelsReply) GetCatalogVersion() int64 {
+	if x != nil {
+		return x.CatalogVersion
+	}
+	return 0
+}
+
 // 设置当前模型
 type SetPetModelRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -205,7 +296,7 @@ type SetPetModelRequest struct {
 
 func (x *SetPetModelRequest) Reset() {
 	*x = SetPetModelRequest{}
-	mi := &file_avatar_v1_avatar_proto_msgTypes[3]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -217,7 +308,7 @@ func (x *SetPetModelRequest) String() string {
 func (*SetPetModelRequest) ProtoMessage() {}
 
 func (x *SetPetModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avatar_v1_avatar_proto_msgTypes[3]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -230,7 +321,7 @@ func (x *SetPetModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPetModelRequest.ProtoReflect.Descriptor instead.
 func (*SetPetModelRequest) Descriptor() ([]byte, []int) {
-	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{3}
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SetPetModelRequest) GetModelId() int64 {
@@ -250,7 +341,7 @@ type SetPetModelReply struct {
 
 func (x *SetPetModelReply) Reset() {
 	*x = SetPetModelReply{}
-	mi := &file_avatar_v1_avatar_proto_msgTypes[4]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -262,7 +353,7 @@ func (x *SetPetModelReply) String() string {
 func (*SetPetModelReply) ProtoMessage() {}
 
 func (x *SetPetModelReply) ProtoReflect() protoreflect.Message {
-	mi := &file_avatar_v1_avatar_proto_msgTypes[4]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -275,7 +366,7 @@ func (x *SetPetModelReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPetModelReply.ProtoReflect.Descriptor instead.
 func (*SetPetModelReply) Descriptor() ([]byte, []int) {
-	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{4}
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SetPetModelReply) GetSuccess() bool {
@@ -285,6 +376,106 @@ func (x *SetPetModelReply) GetSuccess() bool {
 	return false
 }
 
+// 解锁模型
+type UnlockPetModelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 模型ID
+	ModelId       int64 `protobuf:"varint,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockPetModelRequest) Reset() {
+	*x = UnlockPetModelRequest{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockPetModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockPetModelRequest) ProtoMessage() {}
+
+func (x *UnlockPetModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockPetModelRequest.ProtoReflect.Descriptor instead.
+func (*UnlockPetModelRequest) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnlockPetModelRequest) GetModelId() int64 {
+	if x != nil {
+		return x.ModelId
+	}
+	return 0
+}
+
+type UnlockPetModelReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// 解锁后的金币余额
+	Coins         int32 `protobuf:"varint,2,opt,name=coins,proto3" json:"coins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockPetModelReply) Reset() {
+	*x = UnlockPetModelReply{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockPetModelReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockPetModelReply) ProtoMessage() {}
+
+func (x *UnlockPetModelReply) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockPetModelReply.ProtoReflect.Descriptor instead.
+func (*UnlockPetModelReply) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnlockPetModelReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnlockPetModelReply) GetCoins() int32 {
+	if x != nil {
+		return x.Coins
+	}
+	return 0
+}
+
 // 道具
 type Item struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -297,14 +488,18 @@ type Item struct {
 	// 图标URL
 	IconPath string `protobuf:"bytes,4,opt,name=icon_path,json=iconPath,proto3" json:"icon_path,omitempty"`
 	// 消耗金币
-	CoinCost      int32 `protobuf:"varint,5,opt,name=coin_cost,json=coinCost,proto3" json:"coin_cost,omitempty"`
+	CoinCost int32 `protobuf:"varint,5,opt,name=coin_cost,json=coinCost,proto3" json:"coin_cost,omitempty"`
+	// 排序序号（数值越小越靠前）
+	SortOrder int32 `protobuf:"varint,6,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// 使用效果
+	Effects       []*ItemEffect `protobuf:"bytes,7,rep,name=effects,proto3" json:"effects,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Item) Reset() {
 	*x = Item{}
-	mi := &file_avatar_v1_avatar_proto_msgTypes[5]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +511,7 @@ func (x *Item) String() string {
 func (*Item) ProtoMessage() {}
 
 func (x *Item) ProtoReflect() protoreflect.Message {
-	mi := &file_avatar_v1_avatar_proto_msgTypes[5]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +524,7 @@ func (x *Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Item.ProtoReflect.Descriptor instead.
 func (*Item) Descriptor() ([]byte, []int) {
-	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{5}
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Item) GetId() int64 {
@@ -367,6 +562,75 @@ func (x *Item) GetCoinCost() int32 {
 	return 0
 }
 
+func (x *Item) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *Item) GetEffects() []*ItemEffect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+// 道具使用效果，例如 {stat: "hunger", delta: 30} 表示饱食度 +30
+type ItemEffect struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 宠物属性：hunger=饱食度 mood=心情 energy=精力 cleanliness=清洁度
+	Stat string `protobuf:"bytes,1,opt,name=stat,proto3" json:"stat,omitempty"`
+	// 变化值（负数表示降低）
+	Delta         int32 `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemEffect) Reset() {
+	*x = ItemEffect{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemEffect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemEffect) ProtoMessage() {}
+
+func (x *ItemEffect) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemEffect.ProtoReflect.Descriptor instead.
+func (*ItemEffect) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ItemEffect) GetStat() string {
+	if x != nil {
+		return x.Stat
+	}
+	return ""
+}
+
+func (x *ItemEffect) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
 type GetItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -375,7 +639,7 @@ type GetItemsRequest struct {
 
 func (x *GetItemsRequest) Reset() {
 	*x = GetItemsRequest{}
-	mi := &file_avatar_v1_avatar_proto_msgTypes[6]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +651,7 @@ func (x *GetItemsRequest) String() string {
 func (*GetItemsRequest) ProtoMessage() {}
 
 func (x *GetItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avatar_v1_avatar_proto_msgTypes[6]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,20 +664,22 @@ func (x *GetItemsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetItemsRequest.ProtoReflect.Descriptor instead.
 func (*GetItemsRequest) Descriptor() ([]byte, []int) {
-	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{6}
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{10}
 }
 
 type GetItemsReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 道具列表
-	Items         []*Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Items []*Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// 道具目录版本号（管理端每次修改 +1；HTTP 响应同时带 ETag，可用 If-None-Match 获取 304）
+	CatalogVersion int64 `protobuf:"varint,2,opt,name=catalog_version,json=catalogVersion,proto3" json:"catalog_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetItemsReply) Reset() {
 	*x = GetItemsReply{}
-	mi := &file_avatar_v1_avatar_proto_msgTypes[7]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -425,7 +691,7 @@ func (x *GetItemsReply) String() string {
 func (*GetItemsReply) ProtoMessage() {}
 
 func (x *GetItemsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_avatar_v1_avatar_proto_msgTypes[7]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +704,7 @@ func (x *GetItemsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetItemsReply.ProtoReflect.Descriptor instead.
 func (*GetItemsReply) Descriptor() ([]byte, []int) {
-	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{7}
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetItemsReply) GetItems() []*Item {
@@ -448,18 +714,27 @@ func (x *GetItemsReply) GetItems() []*Item {
 	return nil
 }
 
+func (x *GetItemsReply) GetCatalogVersion() int64 {
+	if x != nil {
+		return x.CatalogVersion
+	}
+	return 0
+}
+
 // 使用道具
 type UseItemRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 道具ID
-	ItemId        int64 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemId int64 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// 使用对象宠物ID（0 为当前宠物）
+	PetId         int64 `protobuf:"varint,2,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UseItemRequest) Reset() {
 	*x = UseItemRequest{}
-	mi := &file_avatar_v1_avatar_proto_msgTypes[8]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -471,7 +746,7 @@ func (x *UseItemRequest) String() string {
 func (*UseItemRequest) ProtoMessage() {}
 
 func (x *UseItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avatar_v1_avatar_proto_msgTypes[8]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +759,7 @@ func (x *UseItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseItemRequest.ProtoReflect.Descriptor instead.
 func (*UseItemRequest) Descriptor() ([]byte, []int) {
-	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{8}
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UseItemRequest) GetItemId() int64 {
@@ -494,19 +769,30 @@ func (x *UseItemRequest) GetItemId() int64 {
 	return 0
 }
 
+func (x *UseItemRequest) GetPetId() int64 {
+	if x != nil {
+		return x.PetId
+	}
+	return 0
+}
+
 type UseItemReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 是否成功
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	// 使用结果与提示（例如：喂食成功）
-	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// 该道具剩余数量
+	Remaining int32 `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// 使用后的宠物状态
+	State         *PetState `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UseItemReply) Reset() {
 	*x = UseItemReply{}
-	mi := &file_avatar_v1_avatar_proto_msgTypes[9]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -518,7 +804,7 @@ func (x *UseItemReply) String() string {
 func (*UseItemReply) ProtoMessage() {}
 
 func (x *UseItemReply) ProtoReflect() protoreflect.Message {
-	mi := &file_avatar_v1_avatar_proto_msgTypes[9]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +817,7 @@ func (x *UseItemReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseItemReply.ProtoReflect.Descriptor instead.
 func (*UseItemReply) Descriptor() ([]byte, []int) {
-	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{9}
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UseItemReply) GetSuccess() bool {
@@ -548,18 +834,766 @@ func (x *UseItemReply) GetMessage() string {
 	return ""
 }
 
-// 聊天
-type ChatRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 用户输入的文本内容
-	Content       string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	unknownFields protoimpl.UnknownFields
+func (x *UseItemReply) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *UseItemReply) GetState() *PetState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// 宠物状态（每只宠物各自一份，各属性 0-100，越高越好）
+type PetState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 饱食度
+	Hunger int32 `protobuf:"varint,1,opt,name=hunger,proto3" json:"hunger,omitempty"`
+	// 心情
+	Mood int32 `protobuf:"varint,2,opt,name=mood,proto3" json:"mood,omitempty"`
+	// 精力
+	Energy int32 `protobuf:"varint,3,opt,name=energy,proto3" json:"energy,omitempty"`
+	// 清洁度
+	Cleanliness int32 `protobuf:"varint,4,opt,name=cleanliness,proto3" json:"cleanliness,omitempty"`
+	// 计算时间 YYYY-MM-DD HH:MM:SS
+	UpdatedAt string `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 宠物ID
+	PetId         int64 `protobuf:"varint,6,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetState) Reset() {
+	*x = PetState{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetState) ProtoMessage() {}
+
+func (x *PetState) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetState.ProtoReflect.Descriptor instead.
+func (*PetState) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PetState) GetHunger() int32 {
+	if x != nil {
+		return x.Hunger
+	}
+	return 0
+}
+
+func (x *PetState) GetMood() int32 {
+	if x != nil {
+		return x.Mood
+	}
+	return 0
+}
+
+func (x *PetState) GetEnergy() int32 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+func (x *PetState) GetCleanliness() int32 {
+	if x != nil {
+		return x.Cleanliness
+	}
+	return 0
+}
+
+func (x *PetState) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *PetState) GetPetId() int64 {
+	if x != nil {
+		return x.PetId
+	}
+	return 0
+}
+
+// 宠物等级（达到 L 级所需累计经验为 50×L×(L-1)，最高 50 级）
+type PetLevel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 当前等级
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// 累计经验
+	Xp int64 `protobuf:"varint,2,opt,name=xp,proto3" json:"xp,omitempty"`
+	// 当前等级起点的累计经验
+	LevelXp int64 `protobuf:"varint,3,opt,name=level_xp,json=levelXp,proto3" json:"level_xp,omitempty"`
+	// 升到下一级所需的累计经验（满级为 0）
+	NextLevelXp   int64 `protobuf:"varint,4,opt,name=next_level_xp,json=nextLevelXp,proto3" json:"next_level_xp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetLevel) Reset() {
+	*x = PetLevel{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetLevel) ProtoMessage() {}
+
+func (x *PetLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetLevel.ProtoReflect.Descriptor instead.
+func (*PetLevel) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PetLevel) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *PetLevel) GetXp() int64 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *PetLevel) GetLevelXp() int64 {
+	if x != nil {
+		return x.LevelXp
+	}
+	return 0
+}
+
+func (x *PetLevel) GetNextLevelXp() int64 {
+	if x != nil {
+		return x.NextLevelXp
+	}
+	return 0
+}
+
+type GetPetStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 宠物ID（0 为当前宠物）
+	PetId         int64 `protobuf:"varint,1,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPetStateRequest) Reset() {
+	*x = GetPetStateRequest{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPetStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPetStateRequest) ProtoMessage() {}
+
+func (x *GetPetStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPetStateRequest.ProtoReflect.Descriptor instead.
+func (*GetPetStateRequest) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetPetStateRequest) GetPetId() int64 {
+	if x != nil {
+		return x.PetId
+	}
+	return 0
+}
+
+type GetPetStateReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 当前状态
+	State *PetState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// 等级与经验
+	Level         *PetLevel `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPetStateReply) Reset() {
+	*x = GetPetStateReply{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPetStateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPetStateReply) ProtoMessage() {}
+
+func (x *GetPetStateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPetStateReply.ProtoReflect.Descriptor instead.
+func (*GetPetStateReply) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetPetStateReply) GetState() *PetState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *GetPetStateReply) GetLevel() *PetLevel {
+	if x != nil {
+		return x.Level
+	}
+	return nil
+}
+
+// 购买道具
+type PurchaseItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 道具ID
+	ItemId int64 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// 购买数量（1-99，默认 1）
+	Quantity      int32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseItemRequest) Reset() {
+	*x = PurchaseItemRequest{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseItemRequest) ProtoMessage() {}
+
+func (x *PurchaseItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseItemRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseItemRequest) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PurchaseItemRequest) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *PurchaseItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type PurchaseItemReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 购买记录ID
+	PurchaseId int64 `protobuf:"varint,1,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
+	// 购买后持有数量
+	Quantity int32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// 本次花费金币
+	TotalCost int32 `protobuf:"varint,3,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	// 购买后金币余额
+	Coins         int32 `protobuf:"varint,4,opt,name=coins,proto3" json:"coins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseItemReply) Reset() {
+	*x = PurchaseItemReply{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseItemReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseItemReply) ProtoMessage() {}
+
+func (x *PurchaseItemReply) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseItemReply.ProtoReflect.Descriptor instead.
+func (*PurchaseItemReply) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PurchaseItemReply) GetPurchaseId() int64 {
+	if x != nil {
+		return x.PurchaseId
+	}
+	return 0
+}
+
+func (x *PurchaseItemReply) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PurchaseItemReply) GetTotalCost() int32 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *PurchaseItemReply) GetCoins() int32 {
+	if x != nil {
+		return x.Coins
+	}
+	return 0
+}
+
+// 背包中的道具
+type OwnedItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 道具信息
+	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// 持有数量
+	Quantity      int32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnedItem) Reset() {
+	*x = OwnedItem{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnedItem) ProtoMessage() {}
+
+func (x *OwnedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnedItem.ProtoReflect.Descriptor instead.
+func (*OwnedItem) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OwnedItem) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *OwnedItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ListMyItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyItemsRequest) Reset() {
+	*x = ListMyItemsRequest{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyItemsRequest) ProtoMessage() {}
+
+func (x *ListMyItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyItemsRequest) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{21}
+}
+
+type ListMyItemsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 背包道具（按道具排序序号）
+	List          []*OwnedItem `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyItemsReply) Reset() {
+	*x = ListMyItemsReply{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyItemsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyItemsReply) ProtoMessage() {}
+
+func (x *ListMyItemsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyItemsReply.ProtoReflect.Descriptor instead.
+func (*ListMyItemsReply) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListMyItemsReply) GetList() []*OwnedItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 道具购买记录
+type ItemPurchase struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 购买记录ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 道具ID
+	ItemId int64 `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// 道具名称
+	ItemName string `protobuf:"bytes,3,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	// 购买数量
+	Quantity int32 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// 购买时单价
+	UnitCost int32 `protobuf:"varint,5,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	// 总花费
+	TotalCost int32 `protobuf:"varint,6,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	// 购买时间 YYYY-MM-DD HH:MM:SS
+	CreatedAt     string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemPurchase) Reset() {
+	*x = ItemPurchase{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemPurchase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemPurchase) ProtoMessage() {}
+
+func (x *ItemPurchase) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemPurchase.ProtoReflect.Descriptor instead.
+func (*ItemPurchase) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ItemPurchase) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ItemPurchase) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *ItemPurchase) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *ItemPurchase) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ItemPurchase) GetUnitCost() int32 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *ItemPurchase) GetTotalCost() int32 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *ItemPurchase) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListItemPurchasesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 游标：首次为空，之后传入上一页的 next_cursor
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 每页条数（默认 20，最大 100）
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemPurchasesRequest) Reset() {
+	*x = ListItemPurchasesRequest{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemPurchasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemPurchasesRequest) ProtoMessage() {}
+
+func (x *ListItemPurchasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemPurchasesRequest.ProtoReflect.Descriptor instead.
+func (*ListItemPurchasesRequest) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListItemPurchasesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListItemPurchasesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListItemPurchasesReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 购买记录
+	List []*ItemPurchase `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// 下一页游标，为空表示没有更多
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemPurchasesReply) Reset() {
+	*x = ListItemPurchasesReply{}
+	mi := &file_avatar_v1_avatar_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemPurchasesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemPurchasesReply) ProtoMessage() {}
+
+func (x *ListItemPurchasesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_avatar_v1_avatar_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemPurchasesReply.ProtoReflect.Descriptor instead.
+func (*ListItemPurchasesReply) Descriptor() ([]byte, []int) {
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListItemPurchasesReply) GetList() []*ItemPurchase {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListItemPurchasesReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 聊天
+type ChatRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户输入的文本内容
+	Content       string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChatRequest) Reset() {
 	*x = ChatRequest{}
-	mi := &file_avatar_v1_avatar_proto_msgTypes[10]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -571,7 +1605,7 @@ func (x *ChatRequest) String() string {
 func (*ChatRequest) ProtoMessage() {}
 
 func (x *ChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avatar_v1_avatar_proto_msgTypes[10]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,7 +1618,7 @@ func (x *ChatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatRequest.ProtoReflect.Descriptor instead.
 func (*ChatRequest) Descriptor() ([]byte, []int) {
-	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{10}
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ChatRequest) GetContent() string {
@@ -614,7 +1648,7 @@ type ChatReply struct {
 
 func (x *ChatReply) Reset() {
 	*x = ChatReply{}
-	mi := &file_avatar_v1_avatar_proto_msgTypes[11]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -626,7 +1660,7 @@ func (x *ChatReply) String() string {
 func (*ChatReply) ProtoMessage() {}
 
 func (x *ChatReply) ProtoReflect() protoreflect.Message {
-	mi := &file_avatar_v1_avatar_proto_msgTypes[11]
+	mi := &file_avatar_v1_avatar_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,7 +1673,7 @@ func (x *ChatReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatReply.ProtoReflect.Descriptor instead.
 func (*ChatReply) Descriptor() ([]byte, []int) {
-	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{11}
+	return file_avatar_v1_avatar_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ChatReply) GetMessageId() int64 {
@@ -688,7 +1722,7 @@ var File_avatar_v1_avatar_proto protoreflect.FileDescriptor
 
 const file_avatar_v1_avatar_proto_rawDesc = "" +
 	"\n" +
-	"\x16avatar/v1/avatar.proto\x12\rapi.avatar.v1\x1a\x1cgoogle/api/annotations.proto\"\x9f\x01\n" +
+	"\x16avatar/v1/avatar.proto\x12\rapi.avatar.v1\x1a\x1cgoogle/api/annotations.proto\"\x88\x02\n" +
 	"\bPetModel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -698,28 +1732,102 @@ const file_avatar_v1_avatar_proto_rawDesc = "" +
 	"\n" +
 	"is_default\x18\x05 \x01(\bR\tisDefault\x12\x1d\n" +
 	"\n" +
-	"sort_order\x18\x06 \x01(\x05R\tsortOrder\"\x12\n" +
-	"\x10GetModelsRequest\"A\n" +
+	"sort_order\x18\x06 \x01(\x05R\tsortOrder\x12\x16\n" +
+	"\x06locked\x18\a \x01(\bR\x06locked\x12O\n" +
+	"\x12unlock_requirement\x18\b \x01(\v2 .api.avatar.v1.UnlockRequirementR\x11unlockRequirement\"a\n" +
+	"\x11UnlockRequirement\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x05R\x05level\x12\x14\n" +
+	"\x05coins\x18\x02 \x01(\x05R\x05coins\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x12\n" +
+	"\x10GetModelsRequest\"j\n" +
 	"\x0eGetModelsReply\x12/\n" +
-	"\x06models\x18\x01 \x03(\v2\x17.api.avatar.v1.PetModelR\x06models\"/\n" +
+	"\x06models\x18\x01 \x03(\v2\x17.api.avatar.v1.PetModelR\x06models\x12'\n" +
+	"\x0fcatalog_version\x18\x02 \x01(\x03R\x0ecatalogVersion\"/\n" +
 	"\x12SetPetModelRequest\x12\x19\n" +
 	"\bmodel_id\x18\x01 \x01(\x03R\amodelId\",\n" +
 	"\x10SetPetModelReply\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x86\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\x15UnlockPetModelRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\x03R\amodelId\"E\n" +
+	"\x13UnlockPetModelReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05coins\x18\x02 \x01(\x05R\x05coins\"\xda\x01\n" +
 	"\x04Item\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
 	"\ticon_path\x18\x04 \x01(\tR\biconPath\x12\x1b\n" +
-	"\tcoin_cost\x18\x05 \x01(\x05R\bcoinCost\"\x11\n" +
-	"\x0fGetItemsRequest\":\n" +
+	"\tcoin_cost\x18\x05 \x01(\x05R\bcoinCost\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x06 \x01(\x05R\tsortOrder\x123\n" +
+	"\aeffects\x18\a \x03(\v2\x19.api.avatar.v1.ItemEffectR\aeffects\"6\n" +
+	"\n" +
+	"ItemEffect\x12\x12\n" +
+	"\x04stat\x18\x01 \x01(\tR\x04stat\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x05R\x05delta\"\x11\n" +
+	"\x0fGetItemsRequest\"c\n" +
 	"\rGetItemsReply\x12)\n" +
-	"\x05items\x18\x01 \x03(\v2\x13.api.avatar.v1.ItemR\x05items\")\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.api.avatar.v1.ItemR\x05items\x12'\n" +
+	"\x0fcatalog_version\x18\x02 \x01(\x03R\x0ecatalogVersion\"@\n" +
 	"\x0eUseItemRequest\x12\x17\n" +
-	"\aitem_id\x18\x01 \x01(\x03R\x06itemId\"B\n" +
+	"\aitem_id\x18\x01 \x01(\x03R\x06itemId\x12\x15\n" +
+	"\x06pet_id\x18\x02 \x01(\x03R\x05petId\"\x8f\x01\n" +
 	"\fUseItemReply\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"'\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\tremaining\x18\x03 \x01(\x05R\tremaining\x12-\n" +
+	"\x05state\x18\x04 \x01(\v2\x17.api.avatar.v1.PetStateR\x05state\"\xa6\x01\n" +
+	"\bPetState\x12\x16\n" +
+	"\x06hunger\x18\x01 \x01(\x05R\x06hunger\x12\x12\n" +
+	"\x04mood\x18\x02 \x01(\x05R\x04mood\x12\x16\n" +
+	"\x06energy\x18\x03 \x01(\x05R\x06energy\x12 \n" +
+	"\vcleanliness\x18\x04 \x01(\x05R\vcleanliness\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12\x15\n" +
+	"\x06pet_id\x18\x06 \x01(\x03R\x05petId\"o\n" +
+	"\bPetLevel\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x05R\x05level\x12\x0e\n" +
+	"\x02xp\x18\x02 \x01(\x03R\x02xp\x12\x19\n" +
+	"\blevel_xp\x18\x03 \x01(\x03R\alevelXp\x12\"\n" +
+	"\rnext_level_xp\x18\x04 \x01(\x03R\vnextLevelXp\"+\n" +
+	"\x12GetPetStateRequest\x12\x15\n" +
+	"\x06pet_id\x18\x01 \x01(\x03R\x05petId\"p\n" +
+	"\x10GetPetStateReply\x12-\n" +
+	"\x05state\x18\x01 \x01(\v2\x17.api.avatar.v1.PetStateR\x05state\x12-\n" +
+	"\x05level\x18\x02 \x01(\v2\x17.api.avatar.v1.PetLevelR\x05level\"J\n" +
+	"\x13PurchaseItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x03R\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x85\x01\n" +
+	"\x11PurchaseItemReply\x12\x1f\n" +
+	"\vpurchase_id\x18\x01 \x01(\x03R\n" +
+	"purchaseId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x03 \x01(\x05R\ttotalCost\x12\x14\n" +
+	"\x05coins\x18\x04 \x01(\x05R\x05coins\"P\n" +
+	"\tOwnedItem\x12'\n" +
+	"\x04item\x18\x01 \x01(\v2\x13.api.avatar.v1.ItemR\x04item\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x14\n" +
+	"\x12ListMyItemsRequest\"@\n" +
+	"\x10ListMyItemsReply\x12,\n" +
+	"\x04list\x18\x01 \x03(\v2\x18.api.avatar.v1.OwnedItemR\x04list\"\xcb\x01\n" +
+	"\fItemPurchase\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x03 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\tunit_cost\x18\x05 \x01(\x05R\bunitCost\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x06 \x01(\x05R\ttotalCost\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"O\n" +
+	"\x18ListItemPurchasesRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"j\n" +
+	"\x16ListItemPurchasesReply\x12/\n" +
+	"\x04list\x18\x01 \x03(\v2\x1b.api.avatar.v1.ItemPurchaseR\x04list\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"'\n" +
 	"\vChatRequest\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\"\xca\x01\n" +
 	"\tChatReply\x12\x1d\n" +
@@ -731,12 +1839,17 @@ const file_avatar_v1_avatar_proto_rawDesc = "" +
 	"\rai_message_id\x18\x04 \x01(\x03R\vaiMessageId\x12\x1d\n" +
 	"\n" +
 	"ai_content\x18\x05 \x01(\tR\taiContent\x12\"\n" +
-	"\rai_created_at\x18\x06 \x01(\tR\vaiCreatedAt2\xf3\x04\n" +
+	"\rai_created_at\x18\x06 \x01(\tR\vaiCreatedAt2\xdb\t\n" +
 	"\rAvatarService\x12f\n" +
 	"\tGetModels\x12\x1f.api.avatar.v1.GetModelsRequest\x1a\x1d.api.avatar.v1.GetModelsReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/avatar/models\x12n\n" +
-	"\vSetPetModel\x12!.api.avatar.v1.SetPetModelRequest\x1a\x1f.api.avatar.v1.SetPetModelReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/avatar/model\x12b\n" +
-	"\bGetItems\x12\x1e.api.avatar.v1.GetItemsRequest\x1a\x1c.api.avatar.v1.GetItemsReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/avatar/items\x12e\n" +
-	"\aUseItem\x12\x1d.api.avatar.v1.UseItemRequest\x1a\x1b.api.avatar.v1.UseItemReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/avatar/use-item\x12X\n" +
+	"\vSetPetModel\x12!.api.avatar.v1.SetPetModelRequest\x1a\x1f.api.avatar.v1.SetPetModelReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/avatar/model\x12\x7f\n" +
+	"\x0eUnlockPetModel\x12$.api.avatar.v1.UnlockPetModelRequest\x1a\".api.avatar.v1.UnlockPetModelReply\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/avatar/models/unlock\x12b\n" +
+	"\bGetItems\x12\x1e.api.avatar.v1.GetItemsRequest\x1a\x1c.api.avatar.v1.GetItemsReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/avatar/items\x12z\n" +
+	"\fPurchaseItem\x12\".api.avatar.v1.PurchaseItemRequest\x1a .api.avatar.v1.PurchaseItemReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/avatar/items/purchase\x12n\n" +
+	"\vListMyItems\x12!.api.avatar.v1.ListMyItemsRequest\x1a\x1f.api.avatar.v1.ListMyItemsReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/avatar/my-items\x12\x87\x01\n" +
+	"\x11ListItemPurchases\x12'.api.avatar.v1.ListItemPurchasesRequest\x1a%.api.avatar.v1.ListItemPurchasesReply\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/avatar/items/purchases\x12e\n" +
+	"\aUseItem\x12\x1d.api.avatar.v1.UseItemRequest\x1a\x1b.api.avatar.v1.UseItemReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/avatar/use-item\x12o\n" +
+	"\vGetPetState\x12!.api.avatar.v1.GetPetStateRequest\x1a\x1f.api.avatar.v1.GetPetStateReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/avatar/pet-state\x12X\n" +
 	"\x04Chat\x12\x1a.api.avatar.v1.ChatRequest\x1a\x18.api.avatar.v1.ChatReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/avatar/chat\x12e\n" +
 	"\n" +
 	"ChatStream\x12\x1a.api.avatar.v1.ChatRequest\x1a\x18.api.avatar.v1.ChatReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/avatar/chat/streamB\x1cZ\x1apet-angel/api/avatar/v1;v1b\x06proto3"
@@ -753,41 +1866,75 @@ func file_avatar_v1_avatar_proto_rawDescGZIP() []byte {
 	return file_avatar_v1_avatar_proto_rawDescData
 }
 
-var file_avatar_v1_avatar_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_avatar_v1_avatar_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_avatar_v1_avatar_proto_goTypes = []any{
-	(*PetModel)(nil),           // 0: api.avatar.v1.PetModel
-	(*GetModelsRequest)(nil),   // 1: api.avatar.v1.GetModelsRequest
-	(*GetModelsReply)(nil),     // 2: api.avatar.v1.GetModelsReply
-	(*SetPetModelRequest)(nil), // 3: api.avatar.v1.SetPetModelRequest
-	(*SetPetModelReply)(nil),   // 4: api.avatar.v1.SetPetModelReply
-	(*Item)(nil),               // 5: api.avatar.v1.Item
-	(*GetItemsRequest)(nil),    // 6: api.avatar.v1.GetItemsRequest
-	(*GetItemsReply)(nil),      // 7: api.avatar.v1.GetItemsReply
-	(*UseItemRequest)(nil),     // 8: api.avatar.v1.UseItemRequest
-	(*UseItemReply)(nil),       // 9: api.avatar.v1.UseItemReply
-	(*ChatRequest)(nil),        // 10: api.avatar.v1.ChatRequest
-	(*ChatReply)(nil),          // 11: api.avatar.v1.ChatReply
+	(*PetModel)(nil),                 // 0: api.avatar.v1.PetModel
+	(*UnlockRequirement)(nil),        // 1: api.avatar.v1.UnlockRequirement
+	(*GetModelsRequest)(nil),         // 2: api.avatar.v1.GetModelsRequest
+	(*GetModelsReply)(nil),           // 3: api.avatar.v1.GetModelsReply
+	(*SetPetModelRequest)(nil),       // 4: api.avatar.v1.SetPetModelRequest
+	(*SetPetModelReply)(nil),         // 5: api.avatar.v1.SetPetModelReply
+	(*UnlockPetModelRequest)(nil),    // 6: api.avatar.v1.UnlockPetModelRequest
+	(*UnlockPetModelReply)(nil),      // 7: api.avatar.v1.UnlockPetModelReply
+	(*Item)(nil),                     // 8: api.avatar.v1.Item
+	(*ItemEffect)(nil),               // 9: api.avatar.v1.ItemEffect
+	(*GetItemsRequest)(nil),          // 10: api.avatar.v1.GetItemsRequest
+	(*GetItemsReply)(nil),            // 11: api.avatar.v1.GetItemsReply
+	(*UseItemRequest)(nil),           // 12: api.avatar.v1.UseItemRequest
+	(*UseItemReply)(nil),             // 13: api.avatar.v1.UseItemReply
+	(*PetState)(nil),                 // 14: api.avatar.v1.PetState
+	(*PetLevel)(nil),                 // 15: api.avatar.v1.PetLevel
+	(*GetPetStateRequest)(nil),       // 16: api.avatar.v1.GetPetStateRequest
+	(*GetPetStateReply)(nil),         // 17: api.avatar.v1.GetPetStateReply
+	(*PurchaseItemRequest)(nil),      // 18: api.avatar.v1.PurchaseItemRequest
+	(*PurchaseItemReply)(nil),        // 19: api.avatar.v1.PurchaseItemReply
+	(*OwnedItem)(nil),                // 20: api.avatar.v1.OwnedItem
+	(*ListMyItemsRequest)(nil),       // 21: api.avatar.v1.ListMyItemsRequest
+	(*ListMyItemsReply)(nil),         // 22: api.avatar.v1.ListMyItemsReply
+	(*ItemPurchase)(nil),             // 23: api.avatar.v1.ItemPurchase
+	(*ListItemPurchasesRequest)(nil), // 24: api.avatar.v1.ListItemPurchasesRequest
+	(*ListItemPurchasesReply)(nil),   // 25: api.avatar.v1.ListItemPurchasesReply
+	(*ChatRequest)(nil),              // 26: api.avatar.v1.ChatRequest
+	(*ChatReply)(nil),                // 27: api.avatar.v1.ChatReply
 }
 var file_avatar_v1_avatar_proto_depIdxs = []int32{
-	0,  // 0: api.avatar.v1.GetModelsReply.models:type_name -> api.avatar.v1.PetModel
-	5,  // 1: api.avatar.v1.GetItemsReply.items:type_name -> api.avatar.v1.Item
-	1,  // 2: api.avatar.v1.AvatarService.GetModels:input_type -> api.avatar.v1.GetModelsRequest
-	3,  // 3: api.avatar.v1.AvatarService.SetPetModel:input_type -> api.avatar.v1.SetPetModelRequest
-	6,  // 4: api.avatar.v1.AvatarService.GetItems:input_type -> api.avatar.v1.GetItemsRequest
-	8,  // 5: api.avatar.v1.AvatarService.UseItem:input_type -> api.avatar.v1.UseItemRequest
-	10, // 6: api.avatar.v1.AvatarService.Chat:input_type -> api.avatar.v1.ChatRequest
-	10, // 7: api.avatar.v1.AvatarService.ChatStream:input_type -> api.avatar.v1.ChatRequest
-	2,  // 8: api.avatar.v1.AvatarService.GetModels:output_type -> api.avatar.v1.GetModelsReply
-	4,  // 9: api.avatar.v1.AvatarService.SetPetModel:output_type -> api.avatar.v1.SetPetModelReply
-	7,  // 10: api.avatar.v1.AvatarService.GetItems:output_type -> api.avatar.v1.GetItemsReply
-	9,  // 11: api.avatar.v1.AvatarService.UseItem:output_type -> api.avatar.v1.UseItemReply
-	11, // 12: api.avatar.v1.AvatarService.Chat:output_type -> api.avatar.v1.ChatReply
-	11, // 13: api.avatar.v1.AvatarService.ChatStream:output_type -> api.avatar.v1.ChatReply
-	8,  // [8:14] is the sub-list for method output_type
-	2,  // [2:8] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	1,  // 0: api.avatar.v1.PetModel.unlock_requirement:type_name -> api.avatar.v1.UnlockRequirement
+	0,  // 1: api.avatar.v1.GetModelsReply.models:type_name -> api.avatar.v1.PetModel
+	9,  // 2: api.avatar.v1.Item.effects:type_name -> api.avatar.v1.ItemEffect
+	8,  // 3: api.avatar.v1.GetItemsReply.items:type_name -> api.avatar.v1.Item
+	14, // 4: api.avatar.v1.UseItemReply.state:type_name -> api.avatar.v1.PetState
+	14, // 5: api.avatar.v1.GetPetStateReply.state:type_name -> api.avatar.v1.PetState
+	15, // 6: api.avatar.v1.GetPetStateReply.level:type_name -> api.avatar.v1.PetLevel
+	8,  // 7: api.avatar.v1.OwnedItem.item:type_name -> api.avatar.v1.Item
+	20, // 8: api.avatar.v1.ListMyItemsReply.list:type_name -> api.avatar.v1.OwnedItem
+	23, // 9: api.avatar.v1.ListItemPurchasesReply.list:type_name -> api.avatar.v1.ItemPurchase
+	2,  // 10: api.avatar.v1.AvatarService.GetModels:input_type -> api.avatar.v1.GetModelsRequest
+	4,  // 11: api.avatar.v1.AvatarService.SetPetModel:input_type -> api.avatar.v1.SetPetModelRequest
+	6,  // 12: api.avatar.v1.AvatarService.UnlockPetModel:input_type -> api.avatar.v1.UnlockPetModelRequest
+	10, // 13: api.avatar.v1.AvatarService.GetItems:input_type -> api.avatar.v1.GetItemsRequest
+	18, // 14: api.avatar.v1.AvatarService.PurchaseItem:input_type -> api.avatar.v1.PurchaseItemRequest
+	21, // 15: api.avatar.v1.AvatarService.ListMyItems:input_type -> api.avatar.v1.ListMyItemsRequest
+	24, // 16: api.avatar.v1.AvatarService.ListItemPurchases:input_type -> api.avatar.v1.ListItemPurchasesRequest
+	12, // 17: api.avatar.v1.AvatarService.UseItem:input_type -> api.avatar.v1.UseItemRequest
+	16, // 18: api.avatar.v1.AvatarService.GetPetState:input_type -> api.avatar.v1.GetPetStateRequest
+	26, // 19: api.avatar.v1.AvatarService.Chat:input_type -> api.avatar.v1.ChatRequest
+	26, // 20: api.avatar.v1.AvatarService.ChatStream:input_type -> api.avatar.v1.ChatRequest
+	3,  // 21: api.avatar.v1.AvatarService.GetModels:output_type -> api.avatar.v1.GetModelsReply
+	5,  // 22: api.avatar.v1.AvatarService.SetPetModel:output_type -> api.avatar.v1.SetPetModelReply
+	7,  // 23: api.avatar.v1.AvatarService.UnlockPetModel:output_type -> api.avatar.v1.UnlockPetModelReply
+	11, // 24: api.avatar.v1.AvatarService.GetItems:output_type -> api.avatar.v1.GetItemsReply
+	19, // 25: api.avatar.v1.AvatarService.PurchaseItem:output_type -> api.avatar.v1.PurchaseItemReply
+	22, // 26: api.avatar.v1.AvatarService.ListMyItems:output_type -> api.avatar.v1.ListMyItemsReply
+	25, // 27: api.avatar.v1.AvatarService.ListItemPurchases:output_type -> api.avatar.v1.ListItemPurchasesReply
+	13, // 28: api.avatar.v1.AvatarService.UseItem:output_type -> api.avatar.v1.UseItemReply
+	17, // 29: api.avatar.v1.AvatarService.GetPetState:output_type -> api.avatar.v1.GetPetStateReply
+	27, // 30: api.avatar.v1.AvatarService.Chat:output_type -> api.avatar.v1.ChatReply
+	27, // 31: api.avatar.v1.AvatarService.ChatStream:output_type -> api.avatar.v1.ChatReply
+	21, // [21:32] is the sub-list for method output_type
+	10, // [10:21] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_avatar_v1_avatar_proto_init() }
@@ -801,7 +1948,7 @@ func file_avatar_v1_avatar_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_avatar_v1_avatar_proto_rawDesc), len(file_avatar_v1_avatar_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AvatarService_GetModels_FullMethodName   = "/api.avatar.v1.AvatarService/GetModels"
-	AvatarService_SetPetModel_FullMethodName = "/api.avatar.v1.AvatarService/SetPetModel"
-	AvatarService_GetItems_FullMethodName    = "/api.avatar.v1.AvatarService/GetItems"
-	AvatarService_UseItem_FullMethodName     = "/api.avatar.v1.AvatarService/UseItem"
-	AvatarService_Chat_FullMethodName        = "/api.avatar.v1.AvatarService/Chat"
-	AvatarService_ChatStream_FullMethodName  = "/api.avatar.v1.AvatarService/ChatStream"
+	AvatarService_GetModels_FullMethodName         = "/api.avatar.v1.AvatarService/GetModels"
+	AvatarService_SetPetModel_FullMethodName       = "/api.avatar.v1.AvatarService/SetPetModel"
+	AvatarService_UnlockPetModel_FullMethodName    = "/api.avatar.v1.AvatarService/UnlockPetModel"
+	AvatarService_GetItems_FullMethodName          = "/api.avatar.v1.AvatarService/GetItems"
+	AvatarService_PurchaseItem_FullMethodName      = "/api.avatar.v1.AvatarService/PurchaseItem"
+	AvatarService_ListMyItems_FullMethodName       = "/api.avatar.v1.AvatarService/ListMyItems"
+	AvatarService_ListItemPurchases_FullMethodName = "/api.avatar.v1.AvatarService/ListItemPurchases"
+	AvatarService_UseItem_FullMethodName           = "/api.avatar.v1.AvatarService/UseItem"
+	AvatarService_GetPetState_FullMethodName       = "/api.avatar.v1.AvatarService/GetPetState"
+	AvatarService_Chat_FullMethodName              = "/api.avatar.v1.AvatarService/Chat"
+	AvatarService_ChatStream_FullMethodName        = "/api.avatar.v1.AvatarService/ChatStream"
 )
 
 // AvatarServiceClient is the client API for AvatarService service.
@@ -36,14 +41,27 @@ const (
 // - 道具：列出与使用（消费金币或数量的策略在服务端控制）
 // - 聊天：向 AI 发送一条消息，可选返回 AI 的同步应答
 type AvatarServiceClient interface {
-	// 获取可用的宠物模型列表
+	// 获取可用的宠物模型列表（登录后按当前用户的宠物等级与解锁记录标记 locked；未登录按 1 级计算）
 	GetModels(ctx context.Context, in *GetModelsRequest, opts ...grpc.CallOption) (*GetModelsReply, error)
-	// 设置当前宠物模型
+	// 设置当前宠物模型；未解锁的模型返回 avatar is locked
 	SetPetModel(ctx context.Context, in *SetPetModelRequest, opts ...grpc.CallOption) (*SetPetModelReply, error)
+	// 用金币解锁模型：需先达到等级要求（否则返回 avatar is locked），金币不足返回 insufficient coins
+	// 已解锁或无需金币的模型不重复扣费
+	UnlockPetModel(ctx context.Context, in *UnlockPetModelRequest, opts ...grpc.CallOption) (*UnlockPetModelReply, error)
 	// 获取道具列表
 	GetItems(ctx context.Context, in *GetItemsRequest, opts ...grpc.CallOption) (*GetItemsReply, error)
-	// 使用一个道具（例如喂食/玩具等）
+	// 购买道具：按 coin_cost × quantity 扣除金币并放入背包，同时写入购买记录
+	// 金币不足返回 insufficient coins
+	PurchaseItem(ctx context.Context, in *PurchaseItemRequest, opts ...grpc.CallOption) (*PurchaseItemReply, error)
+	// 我的背包（持有数量大于 0 的道具）
+	ListMyItems(ctx context.Context, in *ListMyItemsRequest, opts ...grpc.CallOption) (*ListMyItemsReply, error)
+	// 道具购买记录（按时间倒序，游标分页）
+	ListItemPurchases(ctx context.Context, in *ListItemPurchasesRequest, opts ...grpc.CallOption) (*ListItemPurchasesReply, error)
+	// 使用一个道具（例如喂食/玩具等）：消耗背包中的 1 个，不再扣金币，并按道具效果改变宠物状态（pet_id 为 0 时作用于当前宠物）；未持有返回 prop not owned
 	UseItem(ctx context.Context, in *UseItemRequest, opts ...grpc.CallOption) (*UseItemReply, error)
+	// 宠物当前状态与等级（每只宠物各自计算；饱食度/心情/精力/清洁度随时间自然下降，使用道具可恢复；
+	// 聊天、喂食为对应宠物获得经验，社区活跃为当前宠物获得经验；尚无宠物返回 pet not found）
+	GetPetState(ctx context.Context, in *GetPetStateRequest, opts ...grpc.CallOption) (*GetPetStateReply, error)
 	// 发送一条聊天消息给 AI（同步返回本条消息；可选返回AI的即时回复）
 	Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatReply, error)
 	// 流式聊天（服务端以 SSE 持续返回 AI 回复片段；前端逐段渲染）
@@ -79,6 +97,16 @@ func (c *avatarServiceClient) SetPetModel(ctx context.Context, in *SetPetModelRe
 	return out, nil
 }
 
+func (c *avatarServiceClient) UnlockPetModel(ctx context.Context, in *UnlockPetModelRequest, opts ...grpc.CallOption) (*UnlockPetModelReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockPetModelReply)
+	err := c.cc.Invoke(ctx, AvatarService_UnlockPetModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *avatarServiceClient) GetItems(ctx context.Context, in *GetItemsRequest, opts ...grpc.CallOption) (*GetItemsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetItemsReply)
@@ -89,6 +117,36 @@ func (c *avatarServiceClient) GetItems(ctx context.Context, in *GetItemsRequest,
 	return out, nil
 }
 
+func (c *avatarServiceClient) PurchaseItem(ctx context.Context, in *PurchaseItemRequest, opts ...grpc.CallOption) (*PurchaseItemReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseItemReply)
+	err := c.cc.Invoke(ctx, AvatarService_PurchaseItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *avatarServiceClient) ListMyItems(ctx context.Context, in *ListMyItemsRequest, opts ...grpc.CallOption) (*ListMyItemsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyItemsReply)
+	err := c.cc.Invoke(ctx, AvatarService_ListMyItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *avatarServiceClient) ListItemPurchases(ctx context.Context, in *ListItemPurchasesRequest, opts ...grpc.CallOption) (*ListItemPurchasesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListItemPurchasesReply)
+	err := c.cc.Invoke(ctx, AvatarService_ListItemPurchases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *avatarServiceClient) UseItem(ctx context.Context, in *UseItemRequest, opts ...grpc.CallOption) (*UseItemReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UseItemReply)
@@ -99,6 +157,16 @@ func (c *avatarServiceClient) UseItem(ctx context.Context, in *UseItemRequest, o
 	return out, nil
 }
 
+func (c *avatarServiceClient) GetPetState(ctx context.Context, in *GetPetStateRequest, opts ...grpc.CallOption) (*GetPetStateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPetStateReply)
+	err := c.cc.Invoke(ctx, AvatarService_GetPetState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *avatarServiceClient) Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ChatReply)
@@ -128,14 +196,27 @@ func (c *avatarServiceClient) ChatStream(ctx context.Context, in *ChatRequest, o
 // - 道具：列出与使用（消费金币或数量的策略在服务端控制）
 // - 聊天：向 AI 发送一条消息，可选返回 AI 的同步应答
 type AvatarServiceServer interface {
-	// 获取可用的宠物模型列表
+	// 获取可用的宠物模型列表（登录后按当前用户的宠物等级与解锁记录标记 locked；未登录按 1 级计算）
 	GetModels(context.Context, *GetModelsRequest) (*GetModelsReply, error)
-	// 设置当前宠物模型
+	// 设置当前宠物模型；未解锁的模型返回 avatar is locked
 	SetPetModel(context.Context, *SetPetModelRequest) (*SetPetModelReply, error)
+	// 用金币解锁模型：需先达到等级要求（否则返回 avatar is locked），金币不足返回 insufficient coins
+	// 已解锁或无需金币的模型不重复扣费
+	UnlockPetModel(context.Context, *UnlockPetModelRequest) (*UnlockPetModelReply, error)
 	// 获取道具列表
 	GetItems(context.Context, *GetItemsRequest) (*GetItemsReply, error)
-	// 使用一个道具（例如喂食/玩具等）
+	// 购买道具：按 coin_cost × quantity 扣除金币并放入背包，同时写入购买记录
+	// 金币不足返回 insufficient coins
+	PurchaseItem(context.Context, *PurchaseItemRequest) (*PurchaseItemReply, error)
+	// 我的背包（持有数量大于 0 的道具）
+	ListMyItems(context.Context, *ListMyItemsRequest) (*ListMyItemsReply, error)
+	// 道具购买记录（按时间倒序，游标分页）
+	ListItemPurchases(context.Context, *ListItemPurchasesRequest) (*ListItemPurchasesReply, error)
+	// 使用一个道具（例如喂食/玩具等）：消耗背包中的 1 个，不再扣金币，并按道具效果改变宠物状态（pet_id 为 0 时作用于当前宠物）；未持有返回 prop not owned
 	UseItem(context.Context, *UseItemRequest) (*UseItemReply, error)
+	// 宠物当前状态与等级（每只宠物各自计算；饱食度/心情/精力/清洁度随时间自然下降，使用道具可恢复；
+	// 聊天、喂食为对应宠物获得经验，社区活跃为当前宠物获得经验；尚无宠物返回 pet not found）
+	GetPetState(context.Context, *GetPetStateRequest) (*GetPetStateReply, error)
 	// 发送一条聊天消息给 AI（同步返回本条消息；可选返回AI的即时回复）
 	Chat(context.Context, *ChatRequest) (*ChatReply, error)
 	// 流式聊天（服务端以 SSE 持续返回 AI 回复片段；前端逐段渲染）
@@ -157,12 +238,27 @@ func (UnimplementedAvatarServiceServer) GetModels(context.Context, *GetModelsReq
 func (UnimplementedAvatarServiceServer) SetPetModel(context.Context, *SetPetModelRequest) (*SetPetModelReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPetModel not implemented")
 }
+func (UnimplementedAvatarServiceServer) UnlockPetModel(context.Context, *UnlockPetModelRequest) (*UnlockPetModelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockPetModel not implemented")
+}
 func (UnimplementedAvatarServiceServer) GetItems(context.Context, *GetItemsRequest) (*GetItemsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetItems not implemented")
 }
+func (UnimplementedAvatarServiceServer) PurchaseItem(context.Context, *PurchaseItemRequest) (*PurchaseItemReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseItem not implemented")
+}
+func (UnimplementedAvatarServiceServer) ListMyItems(context.Context, *ListMyItemsRequest) (*ListMyItemsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyItems not implemented")
+}
+func (UnimplementedAvatarServiceServer) ListItemPurchases(context.Context, *ListItemPurchasesRequest) (*ListItemPurchasesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemPurchases not implemented")
+}
 func (UnimplementedAvatarServiceServer) UseItem(context.Context, *UseItemRequest) (*UseItemReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UseItem not implemented")
 }
+func (UnimplementedAvatarServiceServer) GetPetState(context.Context, *GetPetStateRequest) (*GetPetStateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPetState not implemented")
+}
 func (UnimplementedAvatarServiceServer) Chat(context.Context, *ChatRequest) (*ChatReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Chat not implemented")
 }
@@ -226,6 +322,24 @@ func _AvatarService_SetPetModel_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AvatarService_UnlockPetModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockPetModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvatarServiceServer).UnlockPetModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvatarService_UnlockPetModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvatarServiceServer).UnlockPetModel(ctx, req.(*UnlockPetModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AvatarService_GetItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetItemsRequest)
 	if err := dec(in); err != nil {
@@ -244,6 +358,60 @@ func _AvatarService_GetItems_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AvatarService_PurchaseItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvatarServiceServer).PurchaseItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvatarService_PurchaseItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvatarServiceServer).PurchaseItem(ctx, req.(*PurchaseItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvatarService_ListMyItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvatarServiceServer).ListMyItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvatarService_ListMyItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvatarServiceServer).ListMyItems(ctx, req.(*ListMyItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvatarService_ListItemPurchases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemPurchasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvatarServiceServer).ListItemPurchases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvatarService_ListItemPurchases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvatarServiceServer).ListItemPurchases(ctx, req.(*ListItemPurchasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AvatarService_UseItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UseItemRequest)
 	if err := dec(in); err != nil {
@@ -262,6 +430,24 @@ func _AvatarService_UseItem_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AvatarService_GetPetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPetStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvatarServiceServer).GetPetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvatarService_GetPetState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvatarServiceServer).GetPetState(ctx, req.(*GetPetStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AvatarService_Chat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChatRequest)
 	if err := dec(in); err != nil {
@@ -313,14 +499,34 @@ var AvatarService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPetModel",
 			Handler:    _AvatarService_SetPetModel_Handler,
 		},
+		{
+			MethodName: "UnlockPetModel",
+			Handler:    _AvatarService_UnlockPetModel_Handler,
+		},
 		{
 			MethodName: "GetItems",
 			Handler:    _AvatarService_GetItems_Handler,
 		},
+		{
+			MethodName: "PurchaseItem",
+			Handler:    _AvatarService_PurchaseItem_Handler,
+		},
+		{
+			MethodName: "ListMyItems",
+			Handler:    _AvatarService_ListMyItems_Handler,
+		},
+		{
+			MethodName: "ListItemPurchases",
+			Handler:    _AvatarService_ListItemPurchases_Handler,
+		},
 		{
 			MethodName: "UseItem",
 			Handler:    _AvatarService_UseItem_Handler,
 		},
+		{
+			MethodName: "GetPetState",
+			Handler:    _AvatarService_GetPetState_Handler,
+		},
 		{
 			MethodName: "Chat",
 			Handler:    _AvatarService_Chat_Handler,
@@ -23,7 +23,12 @@ const OperationAvatarServiceChat = "/api.avatar.v1.AvatarService/Chat"
 const OperationAvatarServiceChatStream = "/api.avatar.v1.AvatarService/ChatStream"
 const OperationAvatarServiceGetItems = "/api.avatar.v1.AvatarService/GetItems"
 const OperationAvatarServiceGetModels = "/api.avatar.v1.AvatarService/GetModels"
+const OperationAvatarServiceGetPetState = "/api.avatar.v1.AvatarService/GetPetState"
+const OperationAvatarServiceListItemPurchases = "/api.avatar.v1.AvatarService/ListItemPurchases"
+const OperationAvatarServiceListMyItems = "/api.avatar.v1.AvatarService/ListMyItems"
+const OperationAvatarServicePurchaseItem = "/api.avatar.v1.AvatarService/PurchaseItem"
 const OperationAvatarServiceSetPetModel = "/api.avatar.v1.AvatarService/SetPetModel"
+const OperationAvatarServiceUnlockPetModel = "/api.avatar.v1.AvatarService/UnlockPetModel"
 const OperationAvatarServiceUseItem = "/api.avatar.v1.AvatarService/UseItem"
 
 type AvatarServiceHTTPServer interface {
@@ -34,11 +39,24 @@ type AvatarServiceHTTPServer interface {
 	ChatStream(context.Context, *ChatRequest) (*ChatReply, error)
 	// GetItems 获取道具列表
 	GetItems(context.Context, *GetItemsRequest) (*GetItemsReply, error)
-	// GetModels 获取可用的宠物模型列表
+	// GetModels 获取可用的宠物模型列表（登录后按当前用户的宠物等级与解锁记录标记 locked；未登录按 1 级计算）
 	GetModels(context.Context, *GetModelsRequest) (*GetModelsReply, error)
-	// SetPetModel 设置当前宠物模型
+	// GetPetState 宠物当前状态与等级（每只宠物各自计算；饱食度/心情/精力/清洁度随时间自然下降，使用道具可恢复；
+	// 聊天、喂食为对应宠物获得经验，社区活跃为当前宠物获得经验；尚无宠物返回 pet not found）
+	GetPetState(context.Context, *GetPetStateRequest) (*GetPetStateReply, error)
+	// ListItemPurchases 道具购买记录（按时间倒序，游标分页）
+	ListItemPurchases(context.Context, *ListItemPurchasesRequest) (*ListItemPurchasesReply, error)
+	// ListMyItems 我的背包（持有数量大于 0 的道具）
+	ListMyItems(context.Context, *ListMyItemsRequest) (*ListMyItemsReply, error)
+	// PurchaseItem 购买道具：按 coin_cost × quantity 扣除金币并放入背包，同时写入购买记录
+	// 金币不足返回 insufficient coins
+	PurchaseItem(context.Context, *PurchaseItemRequest) (*PurchaseItemReply, error)
+	// SetPetModel 设置当前宠物模型；未解锁的模型返回 avatar is locked
 	SetPetModel(context.Context, *SetPetModelRequest) (*SetPetModelReply, error)
-	// UseItem 使用一个道具（例如喂食/玩具等）
+	// UnlockPetModel 用金币解锁模型：需先达到等级要求（否则返回 avatar is locked），金币不足返回 insufficient coins
+	// 已解锁或无需金币的模型不重复扣费
+	UnlockPetModel(context.Context, *UnlockPetModelRequest) (*UnlockPetModelReply, error)
+	// UseItem 使用一个道具（例如喂食/玩具等）：消耗背包中的 1 个，不再扣金币，并按道具效果改变宠物状态（pet_id 为 0 时作用于当前宠物）；未持有返回 prop not owned
 	UseItem(context.Context, *UseItemRequest) (*UseItemReply, error)
 }
 
@@ -46,8 +64,13 @@ func RegisterAvatarServiceHTTPServer(s *http.Server, srv AvatarServiceHTTPServer
 	r := s.Route("/")
 	r.GET("/v1/avatar/models", _AvatarService_GetModels0_HTTP_Handler(srv))
 	r.POST("/v1/avatar/model", _AvatarService_SetPetModel0_HTTP_Handler(srv))
+	r.POST("/v1/avatar/models/unlock", _AvatarService_UnlockPetModel0_HTTP_Handler(srv))
 	r.GET("/v1/avatar/items", _AvatarService_GetItems0_HTTP_Handler(srv))
+	r.POST("/v1/avatar/items/purchase", _AvatarService_PurchaseItem0_HTTP_Handler(srv))
+	r.GET("/v1/avatar/my-items", _AvatarService_ListMyItems0_HTTP_Handler(srv))
+	r.GET("/v1/avatar/items/purchases", _AvatarService_ListItemPurchases0_HTTP_Handler(srv))
 	r.POST("/v1/avatar/use-item", _AvatarService_UseItem0_HTTP_Handler(srv))
+	r.GET("/v1/avatar/pet-state", _AvatarService_GetPetState0_HTTP_Handler(srv))
 	r.POST("/v1/avatar/chat", _AvatarService_Chat0_HTTP_Handler(srv))
 	r.POST("/v1/avatar/chat/stream", _AvatarService_ChatStream0_HTTP_Handler(srv))
 }
@@ -93,6 +116,28 @@ func _AvatarService_SetPetModel0_HTTP_Handler(srv AvatarServiceHTTPServer) func(
 	}
 }
 
+func _AvatarService_UnlockPetModel0_HTTP_Handler(srv AvatarServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlockPetModelRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAvatarServiceUnlockPetModel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlockPetModel(ctx, req.(*UnlockPetModelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnlockPetModelReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _AvatarService_GetItems0_HTTP_Handler(srv AvatarServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetItemsRequest
@@ -112,6 +157,66 @@ func _AvatarService_GetItems0_HTTP_Handler(srv AvatarServiceHTTPServer) func(ctx
 	}
 }
 
+func _AvatarService_PurchaseItem0_HTTP_Handler(srv AvatarServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurchaseItemRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAvatarServicePurchaseItem)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurchaseItem(ctx, req.(*PurchaseItemRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurchaseItemReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AvatarService_ListMyItems0_HTTP_Handler(srv AvatarServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyItemsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAvatarServiceListMyItems)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyItems(ctx, req.(*ListMyItemsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMyItemsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AvatarService_ListItemPurchases0_HTTP_Handler(srv AvatarServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListItemPurchasesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAvatarServiceListItemPurchases)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListItemPurchases(ctx, req.(*ListItemPurchasesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListItemPurchasesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _AvatarService_UseItem0_HTTP_Handler(srv AvatarServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in UseItemRequest
@@ -134,6 +239,25 @@ func _AvatarService_UseItem0_HTTP_Handler(srv AvatarServiceHTTPServer) func(ctx
 	}
 }
 
+func _AvatarService_GetPetState0_HTTP_Handler(srv AvatarServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPetStateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAvatarServiceGetPetState)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPetState(ctx, req.(*GetPetStateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPetStateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _AvatarService_Chat0_HTTP_Handler(srv AvatarServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ChatRequest
@@ -183,7 +307,12 @@ type AvatarServiceHTTPClient interface {
 	ChatStream(ctx context.Context, req *ChatRequest, opts ...http.CallOption) (rsp *ChatReply, err error)
 	GetItems(ctx context.Context, req *GetItemsRequest, opts ...http.CallOption) (rsp *GetItemsReply, err error)
 	GetModels(ctx context.Context, req *GetModelsRequest, opts ...http.CallOption) (rsp *GetModelsReply, err error)
+	GetPetState(ctx context.Context, req *GetPetStateRequest, opts ...http.CallOption) (rsp *GetPetStateReply, err error)
+	ListItemPurchases(ctx context.Context, req *ListItemPurchasesRequest, opts ...http.CallOption) (rsp *ListItemPurchasesReply, err error)
+	ListMyItems(ctx context.Context, req *ListMyItemsRequest, opts ...http.CallOption) (rsp *ListMyItemsReply, err error)
+	PurchaseItem(ctx context.Context, req *PurchaseItemRequest, opts ...http.CallOption) (rsp *PurchaseItemReply, err error)
 	SetPetModel(ctx context.Context, req *SetPetModelRequest, opts ...http.CallOption) (rsp *SetPetModelReply, err error)
+	UnlockPetModel(ctx context.Context, req *UnlockPetModelRequest, opts ...http.CallOption) (rsp *UnlockPetModelReply, err error)
 	UseItem(ctx context.Context, req *UseItemRequest, opts ...http.CallOption) (rsp *UseItemReply, err error)
 }
 
@@ -247,6 +376,58 @@ func (c *AvatarServiceHTTPClientImpl) GetModels(ctx context.Context, in *GetMode
 	return &out, nil
 }
 
+func (c *AvatarServiceHTTPClientImpl) GetPetState(ctx context.Context, in *GetPetStateRequest, opts ...http.CallOption) (*GetPetStateReply, error) {
+	var out GetPetStateReply
+	pattern := "/v1/avatar/pet-state"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAvatarServiceGetPetState))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AvatarServiceHTTPClientImpl) ListItemPurchases(ctx context.Context, in *ListItemPurchasesRequest, opts ...http.CallOption) (*ListItemPurchasesReply, error) {
+	var out ListItemPurchasesReply
+	pattern := "/v1/avatar/items/purchases"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAvatarServiceListItemPurchases))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AvatarServiceHTTPClientImpl) ListMyItems(ctx context.Context, in *ListMyItemsRequest, opts ...http.CallOption) (*ListMyItemsReply, error) {
+	var out ListMyItemsReply
+	pattern := "/v1/avatar/my-items"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAvatarServiceListMyItems))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AvatarServiceHTTPClientImpl) PurchaseItem(ctx context.Context, in *PurchaseItemRequest, opts ...http.CallOption) (*PurchaseItemReply, error) {
+	var out PurchaseItemReply
+	pattern := "/v1/avatar/items/purchase"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAvatarServicePurchaseItem))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AvatarServiceHTTPClientImpl) SetPetModel(ctx context.Context, in *SetPetModelRequest, opts ...http.CallOption) (*SetPetModelReply, error) {
 	var out SetPetModelReply
 	pattern := "/v1/avatar/model"
@@ -260,6 +441,19 @@ func (c *AvatarServiceHTTPClientImpl) SetPetModel(ctx context.Context, in *SetPe
 	return &out, nil
 }
 
+func (c *AvatarServiceHTTPClientImpl) UnlockPetModel(ctx context.Context, in *UnlockPetModelRequest, opts ...http.CallOption) (*UnlockPetModelReply, error) {
+	var out UnlockPetModelReply
+	pattern := "/v1/avatar/models/unlock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAvatarServiceUnlockPetModel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AvatarServiceHTTPClientImpl) UseItem(ctx context.Context, in *UseItemRequest, opts ...http.CallOption) (*UseItemReply, error) {
 	var out UseItemReply
 	pattern := "/v1/avatar/use-item"
@@ -28,7 +28,9 @@ type Category struct {
 	// 分类ID
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// 分类名称
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 排序序号（数值越小越靠前）
+	SortOrder     int32 `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -77,6 +79,13 @@ func (x *Category) GetName() string {
 	return ""
 }
 
+func (x *Category) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
 type GetCategoriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -116,9 +125,11 @@ func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
 type GetCategoriesReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 分类列表
-	Categories    []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Categories []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	// 分类目录版本号（管理端每次修改 +1；HTTP 响应同时带 ETag，可用 If-None-Match 获取 304）
+	CatalogVersion int64 `protobuf:"varint,2,opt,name=catalog_version,json=catalogVersion,proto3" json:"catalog_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetCategoriesReply) Reset() {
@@ -158,6 +169,13 @@ func (x *GetCategoriesReply) GetCategories() []*Category {
 	return nil
 }
 
+func (x *GetCategoriesReply) GetCatalogVersion() int64 {
+	if x != nil {
+		return x.CatalogVersion
+	}
+	return 0
+}
+
 // 用户简要信息（用于帖子/评论作者）
 type UserBrief struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1481,15 +1499,18 @@ var File_community_v1_community_proto protoreflect.FileDescriptor
 
 const file_community_v1_community_proto_rawDesc = "" +
 	"\n" +
-	"\x1ccommunity/v1/community.proto\x12\x10api.community.v1\x1a\x1cgoogle/api/annotations.proto\".\n" +
+	"\x1ccommunity/v1/community.proto\x12\x10api.community.v1\x1a\x1cgoogle/api/annotations.proto\"M\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\x16\n" +
-	"\x14GetCategoriesRequest\"P\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x05R\tsortOrder\"\x16\n" +
+	"\x14GetCategoriesRequest\"y\n" +
 	"\x12GetCategoriesReply\x12:\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x1a.api.community.v1.CategoryR\n" +
-	"categories\"p\n" +
+	"categories\x12'\n" +
+	"\x0fcatalog_version\x18\x02 \x01(\x03R\x0ecatalogVersion\"p\n" +
 	"\tUserBrief\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x16\n" +
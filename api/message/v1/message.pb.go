@@ -29,7 +29,7 @@ type Message struct {
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// 发送方：0=用户 1=AI
 	Sender int32 `protobuf:"varint,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// 消息类型：0=聊天 1=小纸条
+	// 消息类型：0=聊天 1=小纸条 2=护理提醒（宠物发送，sender=1）
 	MessageType int32 `protobuf:"varint,3,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
 	// 小纸条是否锁定
 	IsLocked bool `protobuf:"varint,4,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
@@ -38,7 +38,9 @@ type Message struct {
 	// 文本内容（锁定时可为空或返回占位）
 	Content string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
 	// 创建时间 YYYY-MM-DD HH:MM:SS
-	CreatedAt     string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 聊天对象宠物ID（小纸条为 0）
+	PetId         int64 `protobuf:"varint,8,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,6 +124,13 @@ func (x *Message) GetCreatedAt() string {
 	return ""
 }
 
+func (x *Message) GetPetId() int64 {
+	if x != nil {
+		return x.PetId
+	}
+	return 0
+}
+
 // 消息列表请求
 type GetMessageListRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -130,7 +139,9 @@ type GetMessageListRequest struct {
 	// 每页条数（默认20，最大100）
 	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// 仅小纸条（true=只返回 message_type=1）
-	OnlyNotes     bool `protobuf:"varint,3,opt,name=only_notes,json=onlyNotes,proto3" json:"only_notes,omitempty"`
+	OnlyNotes bool `protobuf:"varint,3,opt,name=only_notes,json=onlyNotes,proto3" json:"only_notes,omitempty"`
+	// 聊天记录与提醒所属宠物ID（0 表示当前宠物）；小纸条不区分宠物，始终全部返回
+	PetId         int64 `protobuf:"varint,4,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -186,6 +197,13 @@ func (x *GetMessageListRequest) GetOnlyNotes() bool {
 	return false
 }
 
+func (x *GetMessageListRequest) GetPetId() int64 {
+	if x != nil {
+		return x.PetId
+	}
+	return 0
+}
+
 // 消息列表响应
 type GetMessageListReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -355,7 +373,7 @@ var File_message_v1_message_proto protoreflect.FileDescriptor
 
 const file_message_v1_message_proto_rawDesc = "" +
 	"\n" +
-	"\x18message/v1/message.proto\x12\x0eapi.message.v1\x1a\x1cgoogle/api/annotations.proto\"\xcd\x01\n" +
+	"\x18message/v1/message.proto\x12\x0eapi.message.v1\x1a\x1cgoogle/api/annotations.proto\"\xe4\x01\n" +
 	"\aMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
 	"\x06sender\x18\x02 \x01(\x05R\x06sender\x12!\n" +
@@ -364,12 +382,14 @@ const file_message_v1_message_proto_rawDesc = "" +
 	"\funlock_coins\x18\x05 \x01(\x05R\vunlockCoins\x12\x18\n" +
 	"\acontent\x18\x06 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAt\"g\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x15\n" +
+	"\x06pet_id\x18\b \x01(\x03R\x05petId\"~\n" +
 	"\x15GetMessageListRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"only_notes\x18\x03 \x01(\bR\tonlyNotes\"X\n" +
+	"only_notes\x18\x03 \x01(\bR\tonlyNotes\x12\x15\n" +
+	"\x06pet_id\x18\x04 \x01(\x03R\x05petId\"X\n" +
 	"\x13GetMessageListReply\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12+\n" +
 	"\x04list\x18\x02 \x03(\v2\x17.api.message.v1.MessageR\x04list\"5\n" +
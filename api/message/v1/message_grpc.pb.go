@@ -31,7 +31,7 @@ const (
 // - 列表：按时间倒序分页
 // - 小纸条解锁：扣金币并返回完整内容
 type MessageServiceClient interface {
-	// 获取消息列表（包含聊天与小纸条）
+	// 获取消息列表（包含某只宠物的聊天记录与全部小纸条）
 	GetMessageList(ctx context.Context, in *GetMessageListRequest, opts ...grpc.CallOption) (*GetMessageListReply, error)
 	// 解锁一条小纸条（message_type=1）
 	UnlockMessage(ctx context.Context, in *UnlockMessageRequest, opts ...grpc.CallOption) (*UnlockMessageReply, error)
@@ -73,7 +73,7 @@ func (c *messageServiceClient) UnlockMessage(ctx context.Context, in *UnlockMess
 // - 列表：按时间倒序分页
 // - 小纸条解锁：扣金币并返回完整内容
 type MessageServiceServer interface {
-	// 获取消息列表（包含聊天与小纸条）
+	// 获取消息列表（包含某只宠物的聊天记录与全部小纸条）
 	GetMessageList(context.Context, *GetMessageListRequest) (*GetMessageListReply, error)
 	// 解锁一条小纸条（message_type=1）
 	UnlockMessage(context.Context, *UnlockMessageRequest) (*UnlockMessageReply, error)
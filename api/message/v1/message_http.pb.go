@@ -23,7 +23,7 @@ const OperationMessageServiceGetMessageList = "/api.message.v1.MessageService/Ge
 const OperationMessageServiceUnlockMessage = "/api.message.v1.MessageService/UnlockMessage"
 
 type MessageServiceHTTPServer interface {
-	// GetMessageList 获取消息列表（包含聊天与小纸条）
+	// GetMessageList 获取消息列表（包含某只宠物的聊天记录与全部小纸条）
 	GetMessageList(context.Context, *GetMessageListRequest) (*GetMessageListReply, error)
 	// UnlockMessage 解锁一条小纸条（message_type=1）
 	UnlockMessage(context.Context, *UnlockMessageRequest) (*UnlockMessageReply, error)
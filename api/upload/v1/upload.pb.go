@@ -25,7 +25,7 @@ const (
 // 表单上传请求
 type UploadFileRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 资源类别（avatar/image/video），也可由表单字段传入（缺省=image）
+	// 资源类别（avatar/image/video/document），也可由表单字段传入（缺省=image）
 	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -31,7 +31,7 @@ const (
 // 上传服务
 // 当前实现：后端中转保存到本地服务器目录，并返回可访问 URL
 // 说明：
-// - 统一入口 /v1/upload/file，表单字段：file（必填），type（可选：avatar/image/video）
+// - 统一入口 /v1/upload/file，表单字段：file（必填），type（可选：avatar/image/video/document，document 支持 PDF 与图片）
 // - 资源会保存到 {local_root}/{type}/{YYYY/MM/DD}/ 文件夹
 // - 返回可直接访问的 URL（以 public_prefix 开头，如 /static/...）
 type UploadServiceClient interface {
@@ -89,7 +89,7 @@ func (c *uploadServiceClient) UploadDone(ctx context.Context, in *UploadDoneRequ
 // 上传服务
 // 当前实现：后端中转保存到本地服务器目录，并返回可访问 URL
 // 说明：
-// - 统一入口 /v1/upload/file，表单字段：file（必填），type（可选：avatar/image/video）
+// - 统一入口 /v1/upload/file，表单字段：file（必填），type（可选：avatar/image/video/document，document 支持 PDF 与图片）
 // - 资源会保存到 {local_root}/{type}/{YYYY/MM/DD}/ 文件夹
 // - 返回可直接访问的 URL（以 public_prefix 开头，如 /static/...）
 type UploadServiceServer interface {
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+	"pet-angel/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// runCommand 执行运维子命令（不启动服务）
+//
+//	pet-angel -conf ../../configs seed-admin -username admin -password 'Secr3t!'
+func runCommand(args []string, bc *conf.Bootstrap, logger log.Logger) error {
+	switch args[0] {
+	case "seed-admin":
+		return seedAdmin(args[1:], bc, logger)
+	default:
+		return fmt.Errorf("unknown command %q (available: seed-admin)", args[0])
+	}
+}
+
+// seedAdmin 创建管理员账号，或将已有账号提升为管理员（指定 -password 时同时重置密码）
+func seedAdmin(args []string, bc *conf.Bootstrap, logger log.Logger) error {
+	fs := flag.NewFlagSet("seed-admin", flag.ContinueOnError)
+	username := fs.String("username", "", "admin username")
+	password := fs.String("password", os.Getenv("PET_ANGEL_ADMIN_PASSWORD"), "admin password (or env PET_ANGEL_ADMIN_PASSWORD); required when creating")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *username == "" {
+		return fmt.Errorf("seed-admin: -username is required")
+	}
+	if bc.Data == nil || bc.Data.Database == nil || bc.Data.Database.Source == "" {
+		return fmt.Errorf("seed-admin: data.database.source is not configured")
+	}
+	d, cleanup, err := data.NewData(bc.Data, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	u, created, err := biz.SeedAdmin(context.Background(), data.NewAuthRepo(d), *username, *password)
+	if err != nil {
+		return err
+	}
+	if created {
+		fmt.Printf("created admin %q (id=%d)\n", u.Username, u.Id)
+	} else {
+		fmt.Printf("promoted %q (id=%d) to admin\n", u.Username, u.Id)
+	}
+	return nil
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	aiclient "pet-angel/internal/ai"
+	"pet-angel/internal/biz"
 	"pet-angel/internal/conf"
 
 	"github.com/go-kratos/kratos/v2"
@@ -16,6 +18,8 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/http"
 
 	_ "go.uber.org/automaxprocs"
+	// 内置时区数据库：签到等按用户时区计算自然日，容器镜像可能没有 tzdata
+	_ "time/tzdata"
 )
 
 // go build -ldflags "-X main.Version=x.y.z"
@@ -31,7 +35,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, sessions *biz.SessionTracker, reminders *biz.ReminderScheduler, memories *biz.MemoryWorker) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -41,6 +45,9 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			sessions,  // 会话最近活跃时间批量落库
+			reminders, // 到期护理提醒写入消息
+			memories,  // 较早的聊天整理为宠物长期记忆
 		),
 	)
 }
@@ -72,10 +79,19 @@ func main() {
 		panic(err)
 	}
 
+	// 运维子命令（如 seed-admin），执行完即退出
+	if flag.NArg() > 0 {
+		if err := runCommand(flag.Args(), &bc, logger); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// 初始化 AI 客户端（从配置加载）。
 	aiclient.LoadFromConfig(c)
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, bc.Minio, bc.Storage, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, bc.Minio, bc.Storage, bc.Notify, bc.Rewards, logger)
 	if err != nil {
 		panic(err)
 	}
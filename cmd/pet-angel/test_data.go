@@ -59,7 +59,7 @@ func testDataQueries() {
 	messageRepo := data.NewMessageRepo(d)
 
 	// 测试消息列表
-	msgTotal, messages, err := messageRepo.ListMessages(ctx, 1, false, 1, 10)
+	msgTotal, messages, err := messageRepo.ListMessages(ctx, 1, 0, false, 1, 10)
 	if err != nil {
 		fmt.Printf("ListMessages error: %v\n", err)
 	} else {
@@ -74,7 +74,7 @@ func testDataQueries() {
 	}
 
 	// 测试小纸条列表
-	noteTotal, notes, err := messageRepo.ListMessages(ctx, 1, true, 1, 10)
+	noteTotal, notes, err := messageRepo.ListMessages(ctx, 1, 0, true, 1, 10)
 	if err != nil {
 		fmt.Printf("ListNotes error: %v\n", err)
 	} else {
@@ -10,42 +10,112 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 
+	"pet-angel/internal/auth"
 	"pet-angel/internal/biz"
 	"pet-angel/internal/conf"
 	"pet-angel/internal/data"
+	"pet-angel/internal/notify"
+	"pet-angel/internal/oauth"
 	"pet-angel/internal/server"
 	"pet-angel/internal/service"
 )
 
 // wireApp init kratos application.
-func wireApp(srv *conf.Server, dataConf *conf.Data, authConf *conf.Auth, minioConf *conf.Minio, storageConf *conf.Storage, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(srv *conf.Server, dataConf *conf.Data, authConf *conf.Auth, minioConf *conf.Minio, storageConf *conf.Storage, notifyConf *conf.Notify, rewardsConf *conf.Rewards, logger log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(
 		// data/infrastructure
 		data.NewData,
+		notify.NewNotifier,
+		notify.NewSmsGateway,
+		auth.NewKeyring,
+		auth.NewTrustedProxies,
+		oauth.NewProviders,
 
 		// repo providers
 		data.NewGreeterRepo,
 		data.NewAuthRepo,
+		data.NewSessionRepo,
+		data.NewTokenDenylist,
+		data.NewPasswordResetRepo,
+		data.NewLoginAttemptRepo,
 		data.NewUserRepo,
 		data.NewCommunityRepo,
 		data.NewAvatarRepo,
 		data.NewMessageRepo,
+		data.NewCatalogRepo,
+		data.NewAccountRepo,
+		data.NewIdentityRepo,
+		data.NewLoginCodeRepo,
+		data.NewWalletRepo,
+		data.NewCheckInRepo,
+		data.NewActivityRewardRepo,
+		data.NewInventoryRepo,
+		data.NewPetStateRepo,
+		data.NewPetLevelRepo,
+		data.NewPetRepo,
+		data.NewPetHealthRepo,
+		data.NewReminderRepo,
+		data.NewPetMemoryRepo,
+		data.NewMemorySummarizer,
+		data.NewPromptRepo,
+		data.NewLocalUploadStore,
 
 		// interface bindings
 		wire.Bind(new(biz.GreeterRepo), new(*data.GreeterRepo)),
 		wire.Bind(new(biz.AuthRepo), new(*data.AuthRepo)),
+		wire.Bind(new(biz.SessionRepo), new(*data.SessionRepo)),
+		wire.Bind(new(biz.TokenDenylist), new(*data.TokenDenylist)),
+		wire.Bind(new(biz.PasswordResetRepo), new(*data.PasswordResetRepo)),
+		wire.Bind(new(biz.LoginAttemptRepo), new(*data.LoginAttemptRepo)),
 		wire.Bind(new(biz.UserRepo), new(*data.UserRepoImpl)),
 		wire.Bind(new(biz.CommunityRepo), new(*data.CommunityRepoImpl)),
 		wire.Bind(new(biz.AvatarRepo), new(*data.AvatarRepo)),
 		wire.Bind(new(biz.MessageRepo), new(*data.MessageRepoImpl)),
+		wire.Bind(new(biz.CatalogRepo), new(*data.CatalogRepo)),
+		wire.Bind(new(biz.AccountRepo), new(*data.AccountRepo)),
+		wire.Bind(new(biz.IdentityRepo), new(*data.IdentityRepo)),
+		wire.Bind(new(biz.LoginCodeRepo), new(*data.LoginCodeRepo)),
+		wire.Bind(new(biz.WalletRepo), new(*data.WalletRepo)),
+		wire.Bind(new(biz.CheckInRepo), new(*data.CheckInRepo)),
+		wire.Bind(new(biz.ActivityRewardRepo), new(*data.ActivityRewardRepo)),
+		wire.Bind(new(biz.InventoryRepo), new(*data.InventoryRepo)),
+		wire.Bind(new(biz.PetStateRepo), new(*data.PetStateRepo)),
+		wire.Bind(new(biz.PetLevelRepo), new(*data.PetLevelRepo)),
+		wire.Bind(new(biz.PetRepo), new(*data.PetRepo)),
+		wire.Bind(new(biz.PetHealthRepo), new(*data.PetHealthRepo)),
+		wire.Bind(new(biz.ReminderRepo), new(*data.ReminderRepo)),
+		wire.Bind(new(biz.PetMemoryRepo), new(*data.PetMemoryRepo)),
+		wire.Bind(new(biz.MemorySummarizer), new(*data.MemorySummarizer)),
+		wire.Bind(new(biz.PromptRepo), new(*data.PromptRepo)),
+		wire.Bind(new(biz.Transaction), new(*data.Data)),
+		wire.Bind(new(biz.UploadStore), new(*data.LocalUploadStore)),
 
 		// biz
 		biz.NewGreeterUsecase,
 		biz.NewAuthUsecase,
+		biz.NewSessionTracker,
+		biz.NewWalletUsecase,
+		biz.NewCheckInUsecase,
+		biz.NewActivityRewardUsecase,
+		biz.NewPetLevelUsecase,
+		biz.NewPetUsecase,
+		biz.NewPetHealthUsecase,
+		biz.NewReminderUsecase,
+		biz.NewReminderScheduler,
+		biz.NewPetMemoryUsecase,
+		biz.NewMemoryWorker,
+		biz.NewPromptUsecase,
+		biz.NewEventBus,
 		biz.NewUserUsecase,
 		biz.NewCommunityUsecase,
 		biz.NewAvatarUsecase,
+		biz.NewPetStateUsecase,
+		biz.NewInventoryUsecase,
 		biz.NewMessageUsecase,
+		biz.NewCatalogUsecase,
+		biz.NewAccountUsecase,
+		biz.NewOAuthUsecase,
+		biz.NewPhoneLoginUsecase,
 
 		// service
 		service.NewGreeterService,
@@ -55,8 +125,15 @@ func wireApp(srv *conf.Server, dataConf *conf.Data, authConf *conf.Auth, minioCo
 		service.NewAvatarService,
 		service.NewMessageService,
 		service.NewUploadService,
+		service.NewAdminService,
+		service.NewWalletService,
+		service.NewPetService,
+		service.NewPetHealthService,
+		service.NewReminderService,
+		service.NewMemoryService,
 
 		// server
+		server.NewAuthenticator,
 		server.NewHTTPServer,
 		server.NewGRPCServer,
 
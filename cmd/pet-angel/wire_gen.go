@@ -9,47 +9,114 @@ package main
 import (
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
+	"pet-angel/internal/auth"
 	"pet-angel/internal/biz"
 	"pet-angel/internal/conf"
 	"pet-angel/internal/data"
+	"pet-angel/internal/notify"
+	"pet-angel/internal/oauth"
 	"pet-angel/internal/server"
 	"pet-angel/internal/service"
 )
 
 import (
 	_ "go.uber.org/automaxprocs"
+	_ "time/tzdata"
 )
 
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(srv *conf.Server, dataConf *conf.Data, authConf *conf.Auth, minioConf *conf.Minio, storageConf *conf.Storage, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(srv *conf.Server, dataConf *conf.Data, authConf *conf.Auth, minioConf *conf.Minio, storageConf *conf.Storage, notifyConf *conf.Notify, rewardsConf *conf.Rewards, logger log.Logger) (*kratos.App, func(), error) {
+	keyring, err := auth.NewKeyring(authConf)
+	if err != nil {
+		return nil, nil, err
+	}
 	dataData, cleanup, err := data.NewData(dataConf, logger)
 	if err != nil {
 		return nil, nil, err
 	}
+	tokenDenylist := data.NewTokenDenylist(dataData)
+	sessionRepo := data.NewSessionRepo(dataData)
+	sessionTracker := biz.NewSessionTracker(sessionRepo, logger)
+	authenticator := server.NewAuthenticator(keyring, tokenDenylist, sessionTracker)
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
 	authRepo := data.NewAuthRepo(dataData)
-	authUsecase := biz.NewAuthUsecase(authRepo, authConf)
-	authService := service.NewAuthService(authUsecase, authConf, logger)
+	passwordResetRepo := data.NewPasswordResetRepo(dataData)
+	loginAttemptRepo := data.NewLoginAttemptRepo(dataData)
+	notifier := notify.NewNotifier(notifyConf, logger)
+	authUsecase := biz.NewAuthUsecase(authRepo, sessionRepo, tokenDenylist, passwordResetRepo, loginAttemptRepo, notifier, keyring, authConf, logger)
+	accountRepo := data.NewAccountRepo(dataData)
+	identityRepo := data.NewIdentityRepo(dataData)
+	localUploadStore := data.NewLocalUploadStore(storageConf)
+	accountUsecase := biz.NewAccountUsecase(authUsecase, accountRepo, identityRepo, localUploadStore, logger)
+	oAuthProviders, err := oauth.NewProviders(authConf)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	oAuthUsecase := biz.NewOAuthUsecase(authUsecase, identityRepo, accountRepo, oAuthProviders, logger)
+	loginCodeRepo := data.NewLoginCodeRepo(dataData)
+	smsGateway := notify.NewSmsGateway(logger)
+	phoneLoginUsecase := biz.NewPhoneLoginUsecase(authUsecase, loginCodeRepo, smsGateway, authConf, logger)
+	trustedProxies, err := auth.NewTrustedProxies(authConf)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	authService := service.NewAuthService(authUsecase, accountUsecase, oAuthUsecase, phoneLoginUsecase, trustedProxies, logger)
 	userRepoImpl := data.NewUserRepo(dataData)
 	userUsecase := biz.NewUserUsecase(userRepoImpl)
-	userService := service.NewUserService(userUsecase, authConf, logger)
+	userService := service.NewUserService(userUsecase, logger)
 	communityRepoImpl := data.NewCommunityRepo(dataData)
-	communityUsecase := biz.NewCommunityUsecase(communityRepoImpl)
-	communityService := service.NewCommunityService(communityUsecase, authConf, logger)
+	activityRewardRepo := data.NewActivityRewardRepo(dataData)
+	walletRepo := data.NewWalletRepo(dataData)
+	walletUsecase := biz.NewWalletUsecase(walletRepo, dataData, logger)
+	activityRewardUsecase := biz.NewActivityRewardUsecase(activityRewardRepo, walletUsecase, dataData, rewardsConf, logger)
+	petLevelRepo := data.NewPetLevelRepo(dataData)
+	petRepo := data.NewPetRepo(dataData)
+	petLevelUsecase := biz.NewPetLevelUsecase(petLevelRepo, petRepo, walletUsecase, dataData, rewardsConf, logger)
+	eventBus := biz.NewEventBus(activityRewardUsecase, petLevelUsecase, logger)
+	communityUsecase := biz.NewCommunityUsecase(communityRepoImpl, eventBus)
+	catalogRepo := data.NewCatalogRepo(dataData)
+	catalogUsecase := biz.NewCatalogUsecase(catalogRepo)
+	communityService := service.NewCommunityService(communityUsecase, catalogUsecase, logger)
 	avatarRepo := data.NewAvatarRepo(dataData)
-	avatarUsecase := biz.NewAvatarUsecase(avatarRepo)
-	avatarService := service.NewAvatarService(avatarUsecase, authConf, logger)
+	petUsecase := biz.NewPetUsecase(petRepo, avatarRepo, petLevelUsecase, dataData)
+	promptRepo := data.NewPromptRepo(dataData)
+	petStateRepo := data.NewPetStateRepo(dataData)
+	petStateUsecase := biz.NewPetStateUsecase(petStateRepo, petRepo, dataData)
+	petMemoryRepo := data.NewPetMemoryRepo(dataData)
+	memorySummarizer := data.NewMemorySummarizer()
+	petMemoryUsecase := biz.NewPetMemoryUsecase(petMemoryRepo, memorySummarizer, petUsecase, dataData, logger)
+	promptUsecase := biz.NewPromptUsecase(promptRepo, userRepoImpl, avatarRepo, petUsecase, petStateUsecase, petMemoryUsecase, dataData, logger)
+	avatarUsecase := biz.NewAvatarUsecase(avatarRepo, petUsecase, petLevelUsecase, eventBus, promptUsecase)
+	inventoryRepo := data.NewInventoryRepo(dataData)
+	inventoryUsecase := biz.NewInventoryUsecase(inventoryRepo, avatarRepo, walletUsecase, petStateUsecase, eventBus, dataData)
+	avatarService := service.NewAvatarService(avatarUsecase, inventoryUsecase, petStateUsecase, petLevelUsecase, catalogUsecase, logger)
 	messageRepoImpl := data.NewMessageRepo(dataData)
-	messageUsecase := biz.NewMessageUsecase(messageRepoImpl)
-	messageService := service.NewMessageService(messageUsecase, authConf, logger)
+	messageUsecase := biz.NewMessageUsecase(messageRepoImpl, petUsecase, walletUsecase, dataData)
+	messageService := service.NewMessageService(messageUsecase, promptUsecase, logger)
 	uploadService := service.NewUploadService(storageConf, logger)
-	grpcServer := server.NewGRPCServer(srv, greeterService, authService, userService, communityService, avatarService, messageService, uploadService, logger)
-	httpServer := server.NewHTTPServer(srv, storageConf, greeterService, authService, userService, communityService, avatarService, messageService, uploadService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	adminService := service.NewAdminService(catalogUsecase, promptUsecase, logger)
+	checkInRepo := data.NewCheckInRepo(dataData)
+	checkInUsecase := biz.NewCheckInUsecase(checkInRepo, walletUsecase, dataData, rewardsConf, logger)
+	walletService := service.NewWalletService(walletUsecase, checkInUsecase, logger)
+	petService := service.NewPetService(petUsecase, logger)
+	petHealthRepo := data.NewPetHealthRepo(dataData)
+	petHealthUsecase := biz.NewPetHealthUsecase(petHealthRepo, petUsecase, localUploadStore, dataData)
+	petHealthService := service.NewPetHealthService(petHealthUsecase, logger)
+	reminderRepo := data.NewReminderRepo(dataData)
+	reminderUsecase := biz.NewReminderUsecase(reminderRepo, petUsecase, messageRepoImpl, dataData, logger)
+	reminderService := service.NewReminderService(reminderUsecase, logger)
+	memoryService := service.NewMemoryService(petMemoryUsecase, logger)
+	grpcServer := server.NewGRPCServer(srv, authenticator, greeterService, authService, userService, communityService, avatarService, messageService, uploadService, adminService, walletService, petService, petHealthService, reminderService, memoryService, logger)
+	httpServer := server.NewHTTPServer(srv, authenticator, storageConf, greeterService, authService, userService, communityService, avatarService, messageService, uploadService, adminService, walletService, petService, petHealthService, reminderService, memoryService, logger)
+	reminderScheduler := biz.NewReminderScheduler(reminderUsecase, logger)
+	memoryWorker := biz.NewMemoryWorker(petMemoryUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, sessionTracker, reminderScheduler, memoryWorker)
 	return app, func() {
 		cleanup()
 	}, nil
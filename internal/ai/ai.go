@@ -15,10 +15,29 @@ type Config struct {
 	Temperature float32 `json:"temperature" yaml:"temperature"`
 }
 
-// Client is the generic AI chat interface
+// Message roles (OpenAI-compatible)
+const (
+	RoleSystem    = "system"
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+)
+
+// Message is a single chat message sent to the model
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// Prompt builds a single-turn conversation: system prompt + one user message
+func Prompt(systemPrompt, userContent string) []Message {
+	return []Message{{Role: RoleSystem, Content: systemPrompt}, {Role: RoleUser, Content: userContent}}
+}
+
+// Client is the generic AI chat interface; msgs is the full conversation in order
+// (usually a system prompt followed by alternating user/assistant turns)
 type Client interface {
-	Chat(ctx context.Context, systemPrompt, userContent string) (string, error)
-	Stream(ctx context.Context, systemPrompt, userContent string, onDelta func(string) error) (string, error)
+	Chat(ctx context.Context, msgs []Message) (string, error)
+	Stream(ctx context.Context, msgs []Message, onDelta func(string) error) (string, error)
 }
 
 var defaultClient Client
@@ -34,15 +34,11 @@ func NewClient(cfg Config) Client {
 	return &sfClient{http: &http.Client{Timeout: 120 * time.Second}, cfg: cfg}
 }
 
-type sfMsg struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
-}
 type sfReq struct {
-	Model     string  `json:"model"`
-	Messages  []sfMsg `json:"messages"`
-	Stream    bool    `json:"stream,omitempty"`
-	MaxTokens int     `json:"max_tokens,omitempty"`
+	Model     string    `json:"model"`
+	Messages  []Message `json:"messages"`
+	Stream    bool      `json:"stream,omitempty"`
+	MaxTokens int       `json:"max_tokens,omitempty"`
 }
 type sfResp struct {
 	Choices []struct {
@@ -53,8 +49,8 @@ type sfResp struct {
 }
 
 // Chat non-streaming
-func (c *sfClient) Chat(ctx context.Context, systemPrompt, userContent string) (string, error) {
-	body := &sfReq{Model: c.cfg.Model, Messages: []sfMsg{{Role: "system", Content: systemPrompt}, {Role: "user", Content: userContent}}, MaxTokens: c.cfg.MaxTokens}
+func (c *sfClient) Chat(ctx context.Context, msgs []Message) (string, error) {
+	body := &sfReq{Model: c.cfg.Model, Messages: msgs, MaxTokens: c.cfg.MaxTokens}
 	buf, _ := json.Marshal(body)
 
 	// 使用独立的上下文，避免HTTP请求的超时限制
@@ -65,9 +61,6 @@ func (c *sfClient) Chat(ctx context.Context, systemPrompt, userContent string) (
 	req.Header.Set("Authorization", "Bearer "+c.cfg.APIKey)
 	req.Header.Set("Content-Type", "application/json")
 
-	// 打印请求信息用于调试
-	fmt.Printf("AI Chat Request: URL=%s, Model=%s, APIKey=%s...\n", req.URL.String(), c.cfg.Model, c.cfg.APIKey[:10])
-
 	resp, err := c.http.Do(req)
 	if err != nil {
 		fmt.Printf("AI Chat HTTP Error: %v\n", err)
@@ -98,8 +91,8 @@ func (c *sfClient) Chat(ctx context.Context, systemPrompt, userContent string) (
 }
 
 // Stream streaming; onDelta will be called with each text delta
-func (c *sfClient) Stream(ctx context.Context, systemPrompt, userContent string, onDelta func(string) error) (string, error) {
-	body := &sfReq{Model: c.cfg.Model, Messages: []sfMsg{{Role: "system", Content: systemPrompt}, {Role: "user", Content: userContent}}, Stream: true, MaxTokens: c.cfg.MaxTokens}
+func (c *sfClient) Stream(ctx context.Context, msgs []Message, onDelta func(string) error) (string, error) {
+	body := &sfReq{Model: c.cfg.Model, Messages: msgs, Stream: true, MaxTokens: c.cfg.MaxTokens}
 	buf, _ := json.Marshal(body)
 
 	// 使用独立的上下文，避免HTTP请求的超时限制
@@ -110,9 +103,6 @@ func (c *sfClient) Stream(ctx context.Context, systemPrompt, userContent string,
 	req.Header.Set("Authorization", "Bearer "+c.cfg.APIKey)
 	req.Header.Set("Content-Type", "application/json")
 
-	// 打印请求信息用于调试
-	fmt.Printf("AI Stream Request: URL=%s, Model=%s, APIKey=%s...\n", req.URL.String(), c.cfg.Model, c.cfg.APIKey[:10])
-
 	resp, err := c.http.Do(req)
 	if err != nil {
 		fmt.Printf("AI Stream HTTP Error: %v\n", err)
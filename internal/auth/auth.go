@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	jwtutil "pet-angel/internal/util/jwt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrUnauthorized 未登录或 token 无效（HTTP 401）
+var ErrUnauthorized = errors.Unauthorized("UNAUTHORIZED", "login required")
+
+// ErrForbidden 已登录但角色不足（HTTP 403）
+var ErrForbidden = errors.Forbidden("FORBIDDEN", "permission denied")
+
+// Principal 当前请求的登录主体
+// 由 server 层鉴权中间件写入 context，service 层只读取，不再自行解析 Authorization
+type Principal struct {
+	UserID    int64     // 登录用户ID
+	SessionID int64     // 登录会话ID（旧 token 无此字段时为 0）
+	TokenID   string    // access token 的 jti（用于登出/吊销）
+	ExpiresAt time.Time // access token 过期时间
+	Roles     []string  // 角色（签发时写入 token，角色变更在刷新后生效）
+}
+
+// HasAnyRole 是否拥有 roles 中任一角色
+func (p *Principal) HasAnyRole(roles ...string) bool {
+	for _, have := range p.Roles {
+		for _, want := range roles {
+			if have == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+// NewContext 将登录主体写入 context
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext 读取登录主体
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// UserID 返回当前登录用户ID；未登录返回 ErrUnauthorized
+func UserID(ctx context.Context) (int64, error) {
+	p, ok := FromContext(ctx)
+	if !ok || p.UserID <= 0 {
+		return 0, ErrUnauthorized
+	}
+	return p.UserID, nil
+}
+
+// ViewerID 返回当前查看者ID，匿名访问时为 0（用于公开接口的“是否点赞/关注”等视图态）
+func ViewerID(ctx context.Context) int64 {
+	if p, ok := FromContext(ctx); ok {
+		return p.UserID
+	}
+	return 0
+}
+
+// Authenticate 校验 Authorization 头（Bearer <token>）并返回登录主体
+func Authenticate(keys *jwtutil.Keyring, header string) (*Principal, error) {
+	tok, err := jwtutil.FromAuthHeader(header)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := keys.Parse(tok)
+	if err != nil {
+		return nil, err
+	}
+	if claims.UserID <= 0 {
+		return nil, ErrUnauthorized
+	}
+	p := &Principal{UserID: claims.UserID, SessionID: claims.SessionID, TokenID: claims.ID, Roles: claims.Roles}
+	if claims.ExpiresAt != nil {
+		p.ExpiresAt = claims.ExpiresAt.Time
+	}
+	return p, nil
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"pet-angel/internal/conf"
+)
+
+// TrustedProxies 可信反向代理列表，用于识别真实客户端 IP
+// 转发头可由客户端任意伪造，只有直连对端是可信代理时才采用；否则以连接对端地址为准
+type TrustedProxies struct {
+	nets []*net.IPNet
+}
+
+// NewTrustedProxies 按配置（IP 或 CIDR）构建可信代理列表
+func NewTrustedProxies(c *conf.Auth) (*TrustedProxies, error) {
+	t := &TrustedProxies{}
+	for _, s := range c.GetTrustedProxies() {
+		s = strings.TrimSpace(s)
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("trusted_proxies: invalid ip %q", s)
+			}
+			if ip.To4() != nil {
+				s += "/32"
+			} else {
+				s += "/128"
+			}
+		}
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("trusted_proxies: %w", err)
+		}
+		t.nets = append(t.nets, n)
+	}
+	return t, nil
+}
+
+// Trusted 地址是否为可信代理
+func (t *TrustedProxies) Trusted(ip string) bool {
+	if t == nil {
+		return false
+	}
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, n := range t.nets {
+		if n.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP 计算客户端 IP
+// - remoteAddr 为连接对端地址（host:port 或 IP）；对端不可信时直接返回对端 IP，忽略转发头
+// - 对端可信时从右向左遍历 X-Forwarded-For，跳过可信代理，取第一个不可信的地址；没有 X-Forwarded-For 时使用 X-Real-IP
+func (t *TrustedProxies) ClientIP(remoteAddr, forwardedFor, realIP string) string {
+	ip := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		ip = host
+	}
+	if !t.Trusted(ip) {
+		return ip
+	}
+	if forwardedFor != "" {
+		hops := strings.Split(forwardedFor, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if net.ParseIP(hop) == nil {
+				// 无法解析的地址不可信，停在上一跳
+				return ip
+			}
+			ip = hop
+			if !t.Trusted(hop) {
+				return hop
+			}
+		}
+		return ip
+	}
+	if r := strings.TrimSpace(realIP); net.ParseIP(r) != nil {
+		return r
+	}
+	return ip
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+
+	"pet-angel/internal/conf"
+	jwtutil "pet-angel/internal/util/jwt"
+)
+
+// NewKeyring 按配置构建 JWT 密钥环（签发与校验共用，全局唯一）
+// jwt_secret 作为最旧的 HS256 密钥（kid 为空）保留，保证从单密钥迁移到 jwt_keys 时已签发的 token 继续有效
+func NewKeyring(c *conf.Auth) (*jwtutil.Keyring, error) {
+	var keys []*jwtutil.Key
+	if c.GetJwtSecret() != "" {
+		k, err := jwtutil.NewHMACKey("", c.GetJwtSecret())
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	for _, kc := range c.GetJwtKeys() {
+		k, err := loadKey(kc)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	ring, err := jwtutil.NewKeyring(keys...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ring.SigningKey(); err != nil {
+		return nil, err
+	}
+	return ring, nil
+}
+
+func loadKey(c *conf.JwtKey) (*jwtutil.Key, error) {
+	if c.GetKid() == "" {
+		return nil, fmt.Errorf("jwt key: kid is required")
+	}
+	alg := c.GetAlg()
+	if alg == "" {
+		alg = jwtutil.AlgHS256
+	}
+	if alg == jwtutil.AlgHS256 {
+		return jwtutil.NewHMACKey(c.GetKid(), c.GetSecret())
+	}
+	priv, err := readPEM(c.GetPrivateKeyFile())
+	if err != nil {
+		return nil, fmt.Errorf("jwt key %q: %w", c.GetKid(), err)
+	}
+	pub, err := readPEM(c.GetPublicKeyFile())
+	if err != nil {
+		return nil, fmt.Errorf("jwt key %q: %w", c.GetKid(), err)
+	}
+	return jwtutil.NewPEMKey(c.GetKid(), alg, priv, pub)
+}
+
+func readPEM(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	return os.ReadFile(path)
+}
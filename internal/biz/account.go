@@ -0,0 +1,425 @@
+package biz
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrUploadNotLocal URL 不指向本地上传目录（外链/种子数据等），导出时跳过
+var ErrUploadNotLocal = errors.New("upload is not stored locally")
+
+// AccountExport 个人数据导出内容（ZIP 中各 JSON 文件）
+type AccountExport struct {
+	Pets          []*ExportPet             `json:"pets"`
+	Messages      []*ExportMessage         `json:"messages"`
+	Posts         []*ExportPost            `json:"posts"`
+	Comments      []*ExportComment         `json:"comments"`
+	Likes         []*ExportLike            `json:"likes"`
+	Following     []*ExportFollow          `json:"following"`
+	UnlockRecords []*ExportUnlock          `json:"unlock_records"`
+	Coins         []*ExportCoinTransaction `json:"coin_transactions"`
+	Identities    []*ExportIdentity        `json:"identities"`
+	PetHealth     *ExportPetHealth         `json:"pet_health"`
+	Reminders     []*ExportReminder        `json:"reminders"`
+	Memories      []*ExportMemory          `json:"memories"`
+}
+
+// ExportProfile 用户资料（不含密码哈希；宠物字段为当前宠物）
+type ExportProfile struct {
+	UserID      int64     `json:"user_id"`
+	Username    string    `json:"username"`
+	Nickname    string    `json:"nickname"`
+	Phone       string    `json:"phone"`
+	Avatar      string    `json:"avatar"`
+	ActivePetID int64     `json:"active_pet_id"`
+	ModelID     int64     `json:"model_id"`
+	ModelURL    string    `json:"model_url"`
+	PetName     string    `json:"pet_name"`
+	PetAvatar   string    `json:"pet_avatar"`
+	PetSex      int32     `json:"pet_sex"`
+	Kind        string    `json:"kind"`
+	Weight      int32     `json:"weight"`
+	Hobby       string    `json:"hobby"`
+	Description string    `json:"description"`
+	Coins       int32     `json:"coins"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ExportPet 宠物档案
+type ExportPet struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Avatar    string    `json:"avatar"`
+	Sex       int32     `json:"sex"` // 0未知 1男 2女
+	Kind      string    `json:"kind"`
+	Weight    int32     `json:"weight"`
+	Hobby     string    `json:"hobby"`
+	ModelID   int64     `json:"model_id"`
+	ModelURL  string    `json:"model_url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportPetHealth 宠物健康档案（体重、疫苗/驱虫、就诊记录）
+type ExportPetHealth struct {
+	Weights     []*ExportWeight     `json:"weights"`
+	CareRecords []*ExportCareRecord `json:"care_records"`
+	VetVisits   []*ExportVetVisit   `json:"vet_visits"`
+}
+
+// ExportWeight 体重记录
+type ExportWeight struct {
+	ID         int64     `json:"id"`
+	PetID      int64     `json:"pet_id"`
+	WeightKg   float64   `json:"weight_kg"`
+	MeasuredOn string    `json:"measured_on"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ExportCareRecord 疫苗/驱虫记录
+type ExportCareRecord struct {
+	ID        int64     `json:"id"`
+	PetID     int64     `json:"pet_id"`
+	Type      string    `json:"type"`
+	Name      string    `json:"name"`
+	GivenOn   string    `json:"given_on"`
+	NextDueOn string    `json:"next_due_on"`
+	Clinic    string    `json:"clinic"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportVetVisit 就诊记录
+type ExportVetVisit struct {
+	ID          int64     `json:"id"`
+	PetID       int64     `json:"pet_id"`
+	VisitedOn   string    `json:"visited_on"`
+	Clinic      string    `json:"clinic"`
+	Reason      string    `json:"reason"`
+	Diagnosis   string    `json:"diagnosis"`
+	Notes       string    `json:"notes"`
+	Attachments []string  `json:"attachments"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ExportReminder 护理提醒
+type ExportReminder struct {
+	ID        int64     `json:"id"`
+	PetID     int64     `json:"pet_id"`
+	Kind      string    `json:"kind"`
+	Title     string    `json:"title"`
+	Note      string    `json:"note"`
+	RRule     string    `json:"rrule"`
+	Timezone  string    `json:"timezone"`
+	StartAt   string    `json:"start_at"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportMemory 宠物长期记忆
+type ExportMemory struct {
+	ID        int64     `json:"id"`
+	PetID     int64     `json:"pet_id"`
+	Kind      string    `json:"kind"` // summary/fact
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportMessage 聊天记录/小纸条
+type ExportMessage struct {
+	ID          int64     `json:"id"`
+	PetID       int64     `json:"pet_id"`       // 聊天对象宠物（小纸条为 0）
+	Sender      int32     `json:"sender"`       // 0用户 1AI
+	MessageType int32     `json:"message_type"` // 0聊天 1小纸条 2提醒
+	IsLocked    bool      `json:"is_locked"`
+	Content     string    `json:"content"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ExportPost 发布的帖子
+type ExportPost struct {
+	ID           int64     `json:"id"`
+	CategoryID   int64     `json:"category_id"`
+	Title        string    `json:"title"`
+	Content      string    `json:"content"`
+	Type         int32     `json:"type"` // 0图文 1视频
+	ImageURLs    []string  `json:"image_urls"`
+	VideoURL     string    `json:"video_url"`
+	CoverURL     string    `json:"cover_url"`
+	Locate       string    `json:"locate"`
+	Tags         string    `json:"tags"`
+	LikedCount   int32     `json:"liked_count"`
+	CommentCount int32     `json:"comment_count"`
+	IsPrivate    bool      `json:"is_private"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ExportComment 发表的评论
+type ExportComment struct {
+	ID         int64     `json:"id"`
+	PostID     int64     `json:"post_id"`
+	Content    string    `json:"content"`
+	LikedCount int32     `json:"liked_count"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ExportLike 点赞记录
+type ExportLike struct {
+	TargetType int32     `json:"target_type"` // 0帖子 1评论
+	TargetID   int64     `json:"target_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ExportFollow 关注的用户
+type ExportFollow struct {
+	UserID    int64     `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportUnlock 小纸条解锁记录
+type ExportUnlock struct {
+	MessageID  int64     `json:"message_id"`
+	CoinsSpent int32     `json:"coins_spent"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ExportCoinTransaction 金币流水
+type ExportCoinTransaction struct {
+	Amount         int32     `json:"amount"`
+	Balance        *int32    `json:"balance"` // 历史迁移流水为 null
+	Reason         string    `json:"reason"`
+	RefType        string    `json:"ref_type"`
+	RefID          int64     `json:"ref_id"`
+	CounterpartyID int64     `json:"counterparty_id"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ExportIdentity 绑定的第三方账号
+type ExportIdentity struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	Nickname  string    `json:"nickname"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AccountRepo 账号级数据仓储
+// Delete: 单个事务内删除用户及其宠物、聊天、帖子（连同帖子下的评论与点赞）、评论、点赞、关注、解锁记录、金币流水、第三方绑定、会话与重置验证码，
+// 并修正他人内容上的 liked_count/comment_count；用户不存在返回 ErrUserNotFound
+// Export: 读取用户产生的全部数据（资料由 AuthRepo 提供）
+type AccountRepo interface {
+	Delete(ctx context.Context, userID int64) error
+	Export(ctx context.Context, userID int64) (*AccountExport, error)
+}
+
+// UploadStore 已上传文件的读取
+// Open: 按公开 URL 打开文件，返回 local_root 下的相对路径；非本地上传返回 ErrUploadNotLocal
+type UploadStore interface {
+	Open(ctx context.Context, url string) (name string, rc io.ReadCloser, err error)
+}
+
+// RecentLoginWindow 无密码账号注销前须重新登录，当前会话创建时间不早于此窗口
+const RecentLoginWindow = 5 * time.Minute
+
+// AccountUsecase 注销账号与个人数据导出
+type AccountUsecase struct {
+	auth       *AuthUsecase
+	repo       AccountRepo
+	identities IdentityRepo
+	uploads    UploadStore
+	log        *log.Helper
+}
+
+func NewAccountUsecase(auth *AuthUsecase, repo AccountRepo, identities IdentityRepo, uploads UploadStore, logger log.Logger) *AccountUsecase {
+	return &AccountUsecase{auth: auth, repo: repo, identities: identities, uploads: uploads, log: log.NewHelper(logger)}
+}
+
+// DeleteAccount 注销账号：先吊销全部会话使 token 立即失效，再删除账号数据
+//   - 传入 password 时校验密码
+//   - 未传 password 时，仅允许没有可用密码的账号（手机验证码注册，或绑定了第三方账号、可通过第三方重新登录）
+//     以“刚刚重新登录”确认身份：sessionID 对应的会话须在 RecentLoginWindow 内创建，否则返回 ErrRecentLoginRequired
+func (uc *AccountUsecase) DeleteAccount(ctx context.Context, userID, sessionID int64, password string) error {
+	u, err := uc.auth.repo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if password != "" {
+		if ok, _ := verifyPassword(u.Password, password); !ok {
+			return ErrInvalidPassword
+		}
+	} else {
+		if u.Password != "" {
+			idents, err := uc.identities.ListByUser(ctx, userID)
+			if err != nil {
+				return err
+			}
+			if len(idents) == 0 {
+				return ErrInvalidPassword
+			}
+		}
+		if err := uc.checkRecentLogin(ctx, userID, sessionID); err != nil {
+			return err
+		}
+	}
+	if err := uc.auth.revokeSessions(ctx, userID, 0); err != nil {
+		return err
+	}
+	return uc.repo.Delete(ctx, userID)
+}
+
+// checkRecentLogin 当前会话须属于该用户、未吊销，且在 RecentLoginWindow 内登录
+func (uc *AccountUsecase) checkRecentLogin(ctx context.Context, userID, sessionID int64) error {
+	if sessionID <= 0 {
+		return ErrRecentLoginRequired
+	}
+	s, err := uc.auth.sessions.GetByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return ErrRecentLoginRequired
+		}
+		return err
+	}
+	if s.UserID != userID || s.RevokedAt != nil || time.Since(s.CreatedAt) > RecentLoginWindow {
+		return ErrRecentLoginRequired
+	}
+	return nil
+}
+
+// ExportMyData 将个人数据写为 ZIP：
+//
+//	profile.json / pets.json / messages.json / posts.json / comments.json / likes.json / following.json / unlock_records.json /
+//	coin_transactions.json / identities.json / pet_health.json / reminders.json / memories.json
+//	files/<local_root 下的相对路径>  头像、各宠物头像、帖子与就诊记录引用的本地上传文件
+//	files_missing.json               引用了但未能导出的文件 URL（外链或已被删除）
+func (uc *AccountUsecase) ExportMyData(ctx context.Context, userID int64, w io.Writer) error {
+	u, err := uc.auth.repo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	data, err := uc.repo.Export(ctx, userID)
+	if err != nil {
+		return err
+	}
+	zw := zip.NewWriter(w)
+	entries := []struct {
+		name string
+		v    interface{}
+	}{
+		{"profile.json", exportProfile(u)},
+		{"pets.json", data.Pets},
+		{"messages.json", data.Messages},
+		{"posts.json", data.Posts},
+		{"comments.json", data.Comments},
+		{"likes.json", data.Likes},
+		{"following.json", data.Following},
+		{"unlock_records.json", data.UnlockRecords},
+		{"coin_transactions.json", data.Coins},
+		{"identities.json", data.Identities},
+		{"pet_health.json", data.PetHealth},
+		{"reminders.json", data.Reminders},
+		{"memories.json", data.Memories},
+	}
+	for _, e := range entries {
+		if err := writeZipJSON(zw, e.name, e.v); err != nil {
+			return err
+		}
+	}
+	missing := []string{}
+	for _, url := range uploadURLs(u, data.Pets, data.Posts, data.PetHealth.VetVisits) {
+		if err := uc.copyUpload(ctx, zw, url); err != nil {
+			if !errors.Is(err, ErrUploadNotLocal) {
+				uc.log.WithContext(ctx).Warnf("export user %d: skip %s: %v", userID, url, err)
+			}
+			missing = append(missing, url)
+		}
+	}
+	if err := writeZipJSON(zw, "files_missing.json", missing); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func (uc *AccountUsecase) copyUpload(ctx context.Context, zw *zip.Writer, url string) error {
+	name, rc, err := uc.uploads.Open(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	f, err := zw.Create("files/" + name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, rc)
+	return err
+}
+
+func writeZipJSON(zw *zip.Writer, name string, v interface{}) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func exportProfile(u *User) *ExportProfile {
+	return &ExportProfile{
+		UserID:      u.Id,
+		Username:    u.Username,
+		Nickname:    u.Nickname,
+		Phone:       u.Phone,
+		Avatar:      u.Avatar,
+		ActivePetID: u.ActivePetID,
+		ModelID:     u.ModelID,
+		ModelURL:    u.ModelURL,
+		PetName:     u.PetName,
+		PetAvatar:   u.PetAvatar,
+		PetSex:      u.PetSex,
+		Kind:        u.Kind,
+		Weight:      u.Weight,
+		Hobby:       u.Hobby,
+		Description: u.Description,
+		Coins:       u.Coins,
+		Role:        u.Role,
+		CreatedAt:   u.CreatedAt,
+	}
+}
+
+// uploadURLs 用户资料、宠物档案、帖子与就诊记录中引用的文件 URL（去重，保持出现顺序）
+func uploadURLs(u *User, pets []*ExportPet, posts []*ExportPost, visits []*ExportVetVisit) []string {
+	var out []string
+	seen := map[string]bool{}
+	add := func(url string) {
+		url = strings.TrimSpace(url)
+		if url == "" || seen[url] {
+			return
+		}
+		seen[url] = true
+		out = append(out, url)
+	}
+	add(u.Avatar)
+	for _, p := range pets {
+		add(p.Avatar)
+	}
+	for _, p := range posts {
+		for _, url := range p.ImageURLs {
+			add(url)
+		}
+		add(p.VideoURL)
+		add(p.CoverURL)
+	}
+	for _, v := range visits {
+		for _, url := range v.Attachments {
+			add(url)
+		}
+	}
+	return out
+}
@@ -0,0 +1,196 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"pet-angel/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrActivityRewardExists 同一行为已奖励过（唯一约束冲突）
+	ErrActivityRewardExists = errors.Conflict("ACTIVITY_REWARD_EXISTS", "activity already rewarded")
+	// errActivityCapReached 当日奖励次数已达上限（仅用于结束事务，不会返回给调用方）
+	errActivityCapReached = errors.Conflict("ACTIVITY_CAP_REACHED", "daily activity reward cap reached")
+)
+
+// ActivityReward 一次活跃奖励（activity_rewards 表）
+// (UserID, Rule, DedupeKey) 唯一：同一行为只奖励一次（如反复取消/点赞同一帖子）
+type ActivityReward struct {
+	ID         int64     // 奖励ID
+	UserID     int64     // 获得奖励的用户
+	Rule       string    // 规则（事件类型）
+	DedupeKey  string    // 去重键
+	RewardDate string    // 奖励日期（规则时区，yyyy-MM-dd），用于每日上限
+	Coins      int32     // 奖励金币
+	CreatedAt  time.Time // 奖励时间
+}
+
+// ActivityRewardRepo 活跃奖励仓储
+// Exists: 去重键是否已奖励
+// CountOnDate: 用户某规则在某天的奖励次数
+// Create: 写入奖励记录并回填 ID；去重键冲突返回 ErrActivityRewardExists
+// PostAuthor: 帖子作者ID，帖子不存在返回 0
+type ActivityRewardRepo interface {
+	Exists(ctx context.Context, userID int64, rule, dedupeKey string) (bool, error)
+	CountOnDate(ctx context.Context, userID int64, rule, date string) (int32, error)
+	Create(ctx context.Context, r *ActivityReward) error
+	PostAuthor(ctx context.Context, postID int64) (int64, error)
+}
+
+// activityRule 生效的奖励规则
+type activityRule struct {
+	coins           int32
+	dailyCap        int32
+	minContentRunes int
+}
+
+// ActivityRewardUsecase 活跃奖励规则引擎：订阅社区与聊天事件，按规则发放金币
+// 防刷：自己的帖子点赞/评论不奖励；同一行为按去重键只奖励一次；每人每规则每日有次数上限
+type ActivityRewardUsecase struct {
+	repo   ActivityRewardRepo
+	wallet *WalletUsecase
+	tx     Transaction
+	log    *log.Helper
+
+	rules map[string]activityRule
+	loc   *time.Location
+}
+
+func NewActivityRewardUsecase(repo ActivityRewardRepo, wallet *WalletUsecase, tx Transaction, cfg *conf.Rewards, logger log.Logger) *ActivityRewardUsecase {
+	uc := &ActivityRewardUsecase{
+		repo:   repo,
+		wallet: wallet,
+		tx:     tx,
+		log:    log.NewHelper(logger),
+		rules: map[string]activityRule{
+			EventPostCreated:    {coins: 10, dailyCap: 3},
+			EventPostLiked:      {coins: 1, dailyCap: 20},
+			EventCommentCreated: {coins: 2, dailyCap: 10, minContentRunes: 5},
+			EventPetChatted:     {coins: 5, dailyCap: 1},
+		},
+	}
+	c := cfg.GetActivity()
+	if len(c.GetRules()) > 0 {
+		uc.rules = make(map[string]activityRule, len(c.GetRules()))
+		for _, r := range c.GetRules() {
+			uc.rules[r.GetEvent()] = activityRule{coins: r.GetCoins(), dailyCap: r.GetDailyCap(), minContentRunes: int(r.GetMinContentRunes())}
+		}
+	}
+	name := c.GetTimezone()
+	if name == "" {
+		name = "Asia/Shanghai"
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		uc.log.Warnf("activity rewards: invalid timezone %q, falling back to UTC: %v", name, err)
+		loc = time.UTC
+	}
+	uc.loc = loc
+	return uc
+}
+
+// Handle 处理一条事件：确定受益人与去重键，满足规则时入账
+func (uc *ActivityRewardUsecase) Handle(ctx context.Context, e *ActivityEvent) error {
+	rule, ok := uc.rules[e.Type]
+	if !ok || rule.coins <= 0 {
+		return nil
+	}
+	date := e.At.In(uc.loc).Format(checkInDateLayout)
+	g := &ActivityReward{UserID: e.UserID, Rule: e.Type, RewardDate: date, Coins: rule.coins, CreatedAt: e.At}
+	var ref CoinRef
+	switch e.Type {
+	case EventPostCreated:
+		if e.PostID == 0 {
+			return nil
+		}
+		g.DedupeKey = "post:" + strconv.FormatInt(e.PostID, 10)
+		ref = CoinRef{Type: CoinRefPost, ID: e.PostID}
+	case EventPostLiked:
+		// 奖励帖子作者；自己点赞不奖励；同一用户对同一帖子只奖励一次
+		author, err := uc.repo.PostAuthor(ctx, e.PostID)
+		if err != nil {
+			return err
+		}
+		if author == 0 || author == e.UserID {
+			return nil
+		}
+		g.UserID = author
+		g.DedupeKey = "post:" + strconv.FormatInt(e.PostID, 10) + ":liker:" + strconv.FormatInt(e.UserID, 10)
+		ref = CoinRef{Type: CoinRefPost, ID: e.PostID}
+	case EventCommentCreated:
+		// 评论自己的帖子不奖励；每个帖子只奖励一次
+		if utf8.RuneCountInString(e.Content) < rule.minContentRunes {
+			return nil
+		}
+		author, err := uc.repo.PostAuthor(ctx, e.PostID)
+		if err != nil {
+			return err
+		}
+		if author == 0 || author == e.UserID {
+			return nil
+		}
+		g.DedupeKey = "comment:post:" + strconv.FormatInt(e.PostID, 10)
+		ref = CoinRef{Type: CoinRefPost, ID: e.PostID}
+	case EventPetChatted:
+		// 每天首次聊天
+		g.DedupeKey = "chat:" + date
+		ref = CoinRef{Type: CoinRefMessage, ID: e.MessageID}
+	default:
+		return nil
+	}
+	return uc.grant(ctx, g, rule, ref)
+}
+
+// grant 先做无锁预检查，再在事务内锁定用户余额行后复核上限，写入奖励记录后才入账
+// （检查都在入账之前，内存模式下事务不会回滚也不会多发金币）
+func (uc *ActivityRewardUsecase) grant(ctx context.Context, g *ActivityReward, rule activityRule, ref CoinRef) error {
+	if ok, err := uc.allowed(ctx, g, rule); err != nil || !ok {
+		return err
+	}
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		// 锁定用户行，同一用户的奖励在此串行化，上限检查不会被并发请求突破
+		if err := uc.wallet.Lock(ctx, g.UserID); err != nil {
+			return err
+		}
+		ok, err := uc.allowed(ctx, g, rule)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errActivityCapReached
+		}
+		if err := uc.repo.Create(ctx, g); err != nil {
+			return err
+		}
+		_, err = uc.wallet.Credit(ctx, g.UserID, g.Coins, CoinReasonReward, ref)
+		return err
+	})
+	if errors.Is(err, errActivityCapReached) || errors.Is(err, ErrActivityRewardExists) {
+		return nil
+	}
+	if err == nil {
+		uc.log.WithContext(ctx).Infof("activity reward: user=%d rule=%s key=%s coins=%d", g.UserID, g.Rule, g.DedupeKey, g.Coins)
+	}
+	return err
+}
+
+func (uc *ActivityRewardUsecase) allowed(ctx context.Context, g *ActivityReward, rule activityRule) (bool, error) {
+	exists, err := uc.repo.Exists(ctx, g.UserID, g.Rule, g.DedupeKey)
+	if err != nil || exists {
+		return false, err
+	}
+	if rule.dailyCap <= 0 {
+		return true, nil
+	}
+	n, err := uc.repo.CountOnDate(ctx, g.UserID, g.Rule, g.RewardDate)
+	if err != nil {
+		return false, err
+	}
+	return n < rule.dailyCap, nil
+}
@@ -2,12 +2,16 @@ package biz
 
 import (
 	"context"
+	"errors"
+	"regexp"
+	"strings"
 	"time"
+	"unicode"
 
 	"pet-angel/internal/conf"
 	jwtutil "pet-angel/internal/util/jwt"
 
-	"golang.org/x/crypto/bcrypt"
+	"github.com/go-kratos/kratos/v2/log"
 )
 
 // AuthRepo 抽象
@@ -17,9 +21,15 @@ import (
 type AuthRepo interface {
 	GetByUsername(ctx context.Context, username string) (*User, error)
 	GetByID(ctx context.Context, userID int64) (*User, error)
+	// GetByPhone 按手机号（E.164）查询，未绑定返回 ErrUserNotFound
+	GetByPhone(ctx context.Context, phone string) (*User, error)
+	// Create 创建用户及其第一只宠物（User 中的宠物字段，设为当前宠物）；Password 为空时不设置密码；用户名冲突返回 ErrUserAlreadyExists，手机号冲突返回 ErrPhoneAlreadyExists
 	Create(ctx context.Context, user *User) (int64, error)
+	// UpdateInfo 更新资料（零值字段不修改；宠物字段写入当前宠物，尚无宠物时新建；金币只能通过 WalletRepo 变更）
 	UpdateInfo(ctx context.Context, user *User) error
-	UpdateCoins(ctx context.Context, userID int64, delta int32) error
+	// UpdatePassword 写入新密码（由 repo 做 bcrypt 哈希）
+	UpdatePassword(ctx context.Context, userID int64, password string) error
+	UpdateRole(ctx context.Context, userID int64, role string) error
 
 	GetModelPath(ctx context.Context, modelID int64) (string, error)
 }
@@ -27,55 +37,156 @@ type AuthRepo interface {
 // AuthUsecase 用例
 
 type AuthUsecase struct {
-	repo AuthRepo
-	cfg  *conf.Auth
+	repo     AuthRepo
+	sessions SessionRepo
+	denylist TokenDenylist
+	resets   PasswordResetRepo
+	notifier Notifier
+	guard    *loginGuard
+	keys     *jwtutil.Keyring
+	cfg      *conf.Auth
+	log      *log.Helper
 }
 
-func NewAuthUsecase(repo AuthRepo, cfg *conf.Auth) *AuthUsecase {
-	return &AuthUsecase{repo: repo, cfg: cfg}
+func NewAuthUsecase(repo AuthRepo, sessions SessionRepo, denylist TokenDenylist, resets PasswordResetRepo, attempts LoginAttemptRepo, notifier Notifier, keys *jwtutil.Keyring, cfg *conf.Auth, logger log.Logger) *AuthUsecase {
+	return &AuthUsecase{
+		repo:     repo,
+		sessions: sessions,
+		denylist: denylist,
+		resets:   resets,
+		notifier: notifier,
+		guard:    newLoginGuard(attempts, cfg.GetLoginThrottle()),
+		keys:     keys,
+		cfg:      cfg,
+		log:      log.NewHelper(logger),
+	}
+}
+
+var usernamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{2,31}$`)
+
+// ValidateUsername 用户名规则：3-32 位字母/数字/下划线，须以字母开头
+func ValidateUsername(username string) error {
+	if !usernamePattern.MatchString(username) {
+		return ErrInvalidUsername
+	}
+	return nil
+}
+
+// ValidatePassword 密码强度：8-64 位，至少包含一个字母与一个数字
+func ValidatePassword(password string) error {
+	if len(password) < 8 || len(password) > 64 {
+		return ErrWeakPassword
+	}
+	var hasLetter, hasDigit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	if !hasLetter || !hasDigit {
+		return ErrWeakPassword
+	}
+	return nil
 }
 
-// Login 用户名+密码登录
-func (uc *AuthUsecase) Login(ctx context.Context, username, password string) (user *User, token string, expiresIn int32, err error) {
+// Register 注册新用户并签发令牌对
+func (uc *AuthUsecase) Register(ctx context.Context, username, password, nickname string, client ClientInfo) (*User, *TokenPair, error) {
+	username = strings.TrimSpace(username)
+	if err := ValidateUsername(username); err != nil {
+		return nil, nil, err
+	}
+	if err := ValidatePassword(password); err != nil {
+		return nil, nil, err
+	}
+	if _, err := uc.repo.GetByUsername(ctx, username); err == nil {
+		return nil, nil, ErrUserAlreadyExists
+	} else if !errors.Is(err, ErrUserNotFound) {
+		return nil, nil, err
+	}
+	if nickname = strings.TrimSpace(nickname); nickname == "" {
+		nickname = username
+	}
+	u, err := uc.create(ctx, username, password, nickname)
+	if err != nil {
+		return nil, nil, err
+	}
+	pair, err := uc.startSession(ctx, u, client)
+	if err != nil {
+		return nil, nil, err
+	}
+	return u, pair, nil
+}
+
+// Login 用户名+密码登录，每次成功登录创建一个会话（记录 UA/IP）
+// 用户不存在返回 ErrUserNotFound（开启 auto_register 时自动注册）；密码错误返回 ErrInvalidCredentials；
+// 同一用户名或 IP 失败次数过多时返回 ErrLoginLocked
+func (uc *AuthUsecase) Login(ctx context.Context, username, password string, client ClientInfo) (*User, *TokenPair, error) {
+	// 计数存储不可用时放行（仅记录告警），避免 Redis 故障导致全员无法登录
+	if wait, err := uc.guard.check(ctx, username, client.IP); err != nil {
+		uc.log.WithContext(ctx).Warnf("login guard check failed: %v", err)
+	} else if wait > 0 {
+		return nil, nil, ErrLoginLocked(wait)
+	}
 	u, err := uc.repo.GetByUsername(ctx, username)
 	if err != nil {
-		// 用户不存在则创建
-		nu := &User{
-			Username:  username,
-			Password:  password,
-			Nickname:  username,
-			ModelURL:  "/models/Dog_1.glb",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+		// 仅在“确实不存在”且开启 demo 自动注册时创建用户；其它错误（如数据库异常）直接返回
+		if !errors.Is(err, ErrUserNotFound) {
+			return nil, nil, err
 		}
-		id, cErr := uc.repo.Create(ctx, nu)
-		if cErr != nil {
-			return nil, "", 0, cErr
+		if !uc.cfg.GetAutoRegister() {
+			uc.loginFailed(ctx, username, client.IP)
+			return nil, nil, err
 		}
-		nu.Id = id
-		u = nu
-	}
-	// 校验密码（支持 bcrypt 哈希）
-	if bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)) != nil {
-		// 兼容极端情况：历史明文存储
-		if u.Password != password {
-			return nil, "", 0, ErrInvalidPassword
+		if u, err = uc.create(ctx, username, password, username); err != nil {
+			return nil, nil, err
 		}
 	}
-	// 签发 JWT
-	var ttl time.Duration = time.Hour * 72
-	if uc.cfg != nil && uc.cfg.JwtTtl != nil {
-		ttl = uc.cfg.JwtTtl.AsDuration()
+	ok, legacy := verifyPassword(u.Password, password)
+	if !ok {
+		uc.loginFailed(ctx, username, client.IP)
+		return nil, nil, ErrInvalidCredentials
 	}
-	secret := ""
-	if uc.cfg != nil {
-		secret = uc.cfg.JwtSecret
+	if err := uc.guard.succeed(ctx, username); err != nil {
+		uc.log.WithContext(ctx).Warnf("login guard reset failed: %v", err)
 	}
-	jwtStr, exp, jerr := jwtutil.Sign(secret, u.Id, ttl)
-	if jerr != nil {
-		return nil, "", 0, jerr
+	// 历史明文密码（种子数据等）登录成功后自动升级为 bcrypt；失败不影响本次登录
+	if legacy {
+		if err := uc.repo.UpdatePassword(ctx, u.Id, password); err != nil {
+			uc.log.WithContext(ctx).Warnf("rehash legacy password for user %d failed: %v", u.Id, err)
+		}
 	}
-	return u, jwtStr, int32(time.Until(exp).Seconds()), nil
+	pair, err := uc.startSession(ctx, u, client)
+	if err != nil {
+		return nil, nil, err
+	}
+	return u, pair, nil
+}
+
+func (uc *AuthUsecase) loginFailed(ctx context.Context, username, ip string) {
+	if err := uc.guard.fail(ctx, username, ip); err != nil {
+		uc.log.WithContext(ctx).Warnf("login guard record failure failed: %v", err)
+	}
+}
+
+// create 写入新用户（密码由 repo 做 bcrypt 哈希）
+func (uc *AuthUsecase) create(ctx context.Context, username, password, nickname string) (*User, error) {
+	u := &User{
+		Username:  username,
+		Password:  password,
+		Nickname:  nickname,
+		ModelURL:  DefaultPetModelURL,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	id, err := uc.repo.Create(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	u.Id = id
+	return u, nil
 }
 
 func (uc *AuthUsecase) GetUserInfo(ctx context.Context, userID int64) (*User, error) {
@@ -3,36 +3,52 @@ package biz
 import (
 	"context"
 	"time"
+	"unicode/utf8"
+
+	"pet-angel/internal/ai"
+)
+
+// 聊天上下文：带给模型的最近聊天轮数（一问一答为一轮）与历史消息的 token 预算
+const (
+	ChatHistoryTurns       = 10
+	ChatHistoryTokenBudget = 2000
 )
 
 // PetModel 业务实体（对应表 pet_models）
 // 使用整数与字符串，避免 JSON/ENUM；时间统一为 datetime 字符串在 service 层格式化
 type PetModel struct {
-	ID        int64     // 模型ID
-	Name      string    // 模型名称
-	Path      string    // 资源URL
-	ModelType int32     // 0=猫 1=狗
-	IsDefault bool      // 是否默认
-	SortOrder int32     // 排序
-	CreatedAt time.Time // 创建时间
+	ID          int64     // 模型ID
+	Name        string    // 模型名称
+	Path        string    // 资源URL
+	ModelType   int32     // 0=猫 1=狗
+	IsDefault   bool      // 是否默认
+	SortOrder   int32     // 排序
+	UnlockLevel int32     // 解锁所需宠物等级（0/1 表示不限）
+	UnlockCoins int32     // 解锁所需金币（0 表示免费）
+	CreatedAt   time.Time // 创建时间
+
+	Locked bool // 对当前用户是否未解锁（仅查询时填充）
 }
 
 // Item 业务实体（对应表 items）
 type Item struct {
-	ID          int64     // 道具ID
-	Name        string    // 名称
-	Description string    // 描述
-	IconPath    string    // 图标URL
-	CoinCost    int32     // 消耗金币
-	CreatedAt   time.Time // 创建时间
+	ID          int64        // 道具ID
+	Name        string       // 名称
+	Description string       // 描述
+	IconPath    string       // 图标URL
+	CoinCost    int32        // 消耗金币
+	SortOrder   int32        // 排序
+	Effects     []ItemEffect // 使用效果
+	CreatedAt   time.Time    // 创建时间
 }
 
 // Message 业务实体（对应表 messages）
 type ChatMsg struct {
 	ID          int64     // 消息ID
 	UserID      int64     // 用户ID
+	PetID       int64     // 聊天对象宠物ID
 	Sender      int32     // 0用户 1AI
-	MessageType int32     // 0聊天 1小纸条
+	MessageType int32     // 0聊天 1小纸条 2提醒
 	IsLocked    bool      // 锁定
 	UnlockCoins int32     // 解锁金币
 	Content     string    // 内容
@@ -42,42 +58,102 @@ type ChatMsg struct {
 // AvatarRepo 数据仓储接口（GORM 实现）
 type AvatarRepo interface {
 	ListPetModels(ctx context.Context) ([]*PetModel, error)
-	PetModelExists(ctx context.Context, modelID int64) (bool, error)
-	SetUserModel(ctx context.Context, userID, modelID int64) error
+	// 获取单个模型，不存在返回 ErrAvatarNotFound
+	GetPetModel(ctx context.Context, modelID int64) (*PetModel, error)
+	// 更新宠物模型（同时刷新 model_url）
+	SetPetModel(ctx context.Context, petID, modelID int64) error
 
 	ListItems(ctx context.Context) ([]*Item, error)
-	UseItem(ctx context.Context, userID, itemID int64) (remainingCoins int32, err error)
+	GetItem(ctx context.Context, itemID int64) (*Item, error)
 
-	CreateChat(ctx context.Context, userID int64, content string) (*ChatMsg, error)
-	CreateAIChat(ctx context.Context, userID int64, content string) (*ChatMsg, error)
+	// 聊天消息均归属于一只宠物（petID 为 0 表示用户尚无宠物）
+	CreateChat(ctx context.Context, userID, petID int64, content string) (*ChatMsg, error)
+	// 以 system 为系统提示、带上最近的聊天记录调用 AI 并落库回复
+	CreateAIChat(ctx context.Context, userID int64, pet *Pet, system, content string) (*ChatMsg, error)
 	// 直接写入一条 AI 消息（用于流式完成后落库）
-	CreateAIMessage(ctx context.Context, userID int64, content string) (*ChatMsg, error)
-	// 获取最新的AI消息
-	GetLatestAIMessage(ctx context.Context, userID int64) (*ChatMsg, error)
+	CreateAIMessage(ctx context.Context, userID, petID int64, content string) (*ChatMsg, error)
+	// 获取与某只宠物聊天中最新的AI消息
+	GetLatestAIMessage(ctx context.Context, userID, petID int64) (*ChatMsg, error)
+	// 与某只宠物最近的 limit 条聊天消息（message_type=0，含用户与 AI），按时间倒序
+	ListChatHistory(ctx context.Context, userID, petID int64, limit int) ([]*ChatMsg, error)
 }
 
 // AvatarUsecase 业务用例
 type AvatarUsecase struct {
-	repo AvatarRepo
+	repo    AvatarRepo
+	pets    *PetUsecase
+	levels  *PetLevelUsecase
+	events  *EventBus
+	prompts *PromptUsecase
+}
+
+func NewAvatarUsecase(repo AvatarRepo, pets *PetUsecase, levels *PetLevelUsecase, events *EventBus, prompts *PromptUsecase) *AvatarUsecase {
+	return &AvatarUsecase{repo: repo, pets: pets, levels: levels, events: events, prompts: prompts}
 }
 
-func NewAvatarUsecase(repo AvatarRepo) *AvatarUsecase { return &AvatarUsecase{repo: repo} }
+// ActivePet 当前宠物（聊天对象），尚无宠物时返回 nil
+func (uc *AvatarUsecase) ActivePet(ctx context.Context, userID int64) (*Pet, error) {
+	return uc.pets.Active(ctx, userID)
+}
+
+func petID(p *Pet) int64 {
+	if p == nil {
+		return 0
+	}
+	return p.ID
+}
 
-// GetModels 列出所有模型
-func (uc *AvatarUsecase) GetModels(ctx context.Context) ([]*PetModel, error) {
-	return uc.repo.ListPetModels(ctx)
+// createChat 写入与当前宠物的用户消息并发布聊天事件
+func (uc *AvatarUsecase) createChat(ctx context.Context, userID int64, pet *Pet, content string) (*ChatMsg, error) {
+	msg, err := uc.repo.CreateChat(ctx, userID, petID(pet), content)
+	if err != nil || msg == nil {
+		return msg, err
+	}
+	uc.events.Publish(ctx, &ActivityEvent{Type: EventPetChatted, UserID: userID, PetID: petID(pet), MessageID: msg.ID, Content: content})
+	return msg, nil
 }
 
-// SetPetModel 设置用户当前模型
+// GetModels 列出所有模型，并标记对 viewerID 是否已解锁（未登录为 0）
+func (uc *AvatarUsecase) GetModels(ctx context.Context, viewerID int64) ([]*PetModel, error) {
+	list, err := uc.repo.ListPetModels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.levels.MarkLocked(ctx, viewerID, list...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// SetPetModel 设置当前宠物的模型；未解锁的模型返回 ErrAvatarLocked，尚无宠物返回 ErrPetNotFound
 func (uc *AvatarUsecase) SetPetModel(ctx context.Context, userID, modelID int64) error {
-	exists, err := uc.repo.PetModelExists(ctx, modelID)
+	m, err := uc.repo.GetPetModel(ctx, modelID)
 	if err != nil {
 		return err
 	}
-	if !exists {
-		return ErrAvatarNotFound
+	if err := uc.levels.MarkLocked(ctx, userID, m); err != nil {
+		return err
+	}
+	if m.Locked {
+		return ErrAvatarLocked
+	}
+	pet, err := uc.pets.Active(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if pet == nil {
+		return ErrPetNotFound
+	}
+	return uc.repo.SetPetModel(ctx, pet.ID, modelID)
+}
+
+// UnlockPetModel 用金币解锁模型（需先达到等级要求），返回金币余额
+func (uc *AvatarUsecase) UnlockPetModel(ctx context.Context, userID, modelID int64) (int32, error) {
+	m, err := uc.repo.GetPetModel(ctx, modelID)
+	if err != nil {
+		return 0, err
 	}
-	return uc.repo.SetUserModel(ctx, userID, modelID)
+	return uc.levels.UnlockModel(ctx, userID, m)
 }
 
 // GetItems 列出所有道具
@@ -85,24 +161,19 @@ func (uc *AvatarUsecase) GetItems(ctx context.Context) ([]*Item, error) {
 	return uc.repo.ListItems(ctx)
 }
 
-// UseItem 使用道具并扣金币
-func (uc *AvatarUsecase) UseItem(ctx context.Context, userID, itemID int64) (int32, error) {
-	remaining, err := uc.repo.UseItem(ctx, userID, itemID)
+// Chat 与当前宠物聊天（同步返回该条消息）
+func (uc *AvatarUsecase) Chat(ctx context.Context, userID int64, content string) (*ChatMsg, error) {
+	pet, err := uc.pets.Active(ctx, userID)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return remaining, nil
-}
-
-// Chat 发送聊天消息（同步返回该条消息）
-func (uc *AvatarUsecase) Chat(ctx context.Context, userID int64, content string) (*ChatMsg, error) {
 	// 1) 先写入用户消息
-	userMsg, err := uc.repo.CreateChat(ctx, userID, content)
+	userMsg, err := uc.createChat(ctx, userID, pet, content)
 	if err != nil {
 		return nil, err
 	}
 	// 2) 生成 AI 回复（由 data 层调用 AI 客户端并落库）
-	_, err = uc.repo.CreateAIChat(ctx, userID, content)
+	_, err = uc.repo.CreateAIChat(ctx, userID, pet, uc.SystemPrompt(ctx, userID, pet, content), content)
 	if err != nil {
 		// AI调用失败时，返回用户消息，不阻塞
 		return userMsg, nil
@@ -111,43 +182,115 @@ func (uc *AvatarUsecase) Chat(ctx context.Context, userID int64, content string)
 	return userMsg, nil
 }
 
-// GetChatWithAI 获取聊天消息和对应的AI回复
+// GetChatWithAI 与当前宠物聊天，返回聊天消息和对应的AI回复
 func (uc *AvatarUsecase) GetChatWithAI(ctx context.Context, userID int64, content string) (*ChatMsg, *ChatMsg, error) {
+	pet, err := uc.pets.Active(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
 	// 1) 先写入用户消息
-	userMsg, err := uc.repo.CreateChat(ctx, userID, content)
+	userMsg, err := uc.createChat(ctx, userID, pet, content)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// 2) 生成 AI 回复
-	aiMsg, err := uc.repo.CreateAIChat(ctx, userID, content)
+	aiMsg, err := uc.repo.CreateAIChat(ctx, userID, pet, uc.SystemPrompt(ctx, userID, pet, content), content)
 	if err != nil {
 		// AI调用失败时，创建一个兜底AI消息
 		fallbackContent := "我在呢，会一直陪着你~ 有什么想和我分享的吗？"
-		aiMsg, _ = uc.repo.CreateAIMessage(ctx, userID, fallbackContent)
+		aiMsg, _ = uc.repo.CreateAIMessage(ctx, userID, petID(pet), fallbackContent)
 		return userMsg, aiMsg, nil
 	}
 
 	// 确保AI消息不为空
 	if aiMsg == nil || aiMsg.Content == "" {
 		fallbackContent := "我在呢，会一直陪着你~ 有什么想和我分享的吗？"
-		aiMsg, _ = uc.repo.CreateAIMessage(ctx, userID, fallbackContent)
+		aiMsg, _ = uc.repo.CreateAIMessage(ctx, userID, petID(pet), fallbackContent)
 	}
 
 	return userMsg, aiMsg, nil
 }
 
 // SaveAIMessage 将一段 AI 文本回复直接写库（供流式完成后调用）
-func (uc *AvatarUsecase) SaveAIMessage(ctx context.Context, userID int64, content string) (*ChatMsg, error) {
-	return uc.repo.CreateAIMessage(ctx, userID, content)
+func (uc *AvatarUsecase) SaveAIMessage(ctx context.Context, userID int64, pet *Pet, content string) (*ChatMsg, error) {
+	return uc.repo.CreateAIMessage(ctx, userID, petID(pet), content)
 }
 
-// SaveUserMessage 仅保存用户消息（不触发 AI 回复）
-func (uc *AvatarUsecase) SaveUserMessage(ctx context.Context, userID int64, content string) (*ChatMsg, error) {
-	return uc.repo.CreateChat(ctx, userID, content)
+// SaveUserMessage 仅保存用户与宠物的消息（不触发 AI 回复）
+func (uc *AvatarUsecase) SaveUserMessage(ctx context.Context, userID int64, pet *Pet, content string) (*ChatMsg, error) {
+	return uc.createChat(ctx, userID, pet, content)
 }
 
-// GetLatestAIMessage 获取最新的AI消息
+// GetLatestAIMessage 获取与当前宠物聊天中最新的AI消息
 func (uc *AvatarUsecase) GetLatestAIMessage(ctx context.Context, userID int64) (*ChatMsg, error) {
-	return uc.repo.GetLatestAIMessage(ctx, userID)
+	pet, err := uc.pets.Active(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.GetLatestAIMessage(ctx, userID, petID(pet))
+}
+
+// SystemPrompt 与宠物聊天的系统提示（按宠物模型选用的模板渲染，content 为本轮输入，用于挑选相关记忆）
+func (uc *AvatarUsecase) SystemPrompt(ctx context.Context, userID int64, pet *Pet, content string) string {
+	return uc.prompts.ChatPrompt(ctx, userID, pet, content)
+}
+
+// ChatHistory 与当前宠物的聊天上下文（按时间正序，已包含刚写入的用户消息），供流式聊天组装模型请求
+func (uc *AvatarUsecase) ChatHistory(ctx context.Context, userID int64, pet *Pet) ([]*ChatMsg, error) {
+	list, err := uc.repo.ListChatHistory(ctx, userID, petID(pet), ChatHistoryTurns*2)
+	if err != nil {
+		return nil, err
+	}
+	return TrimChatHistory(list, ChatHistoryTokenBudget), nil
+}
+
+// TrimChatHistory 从最新的消息（list 按时间倒序）往前保留，直到超出 token 预算，返回按时间正序的结果；
+// 最新一条（本轮用户输入）总是保留
+func TrimChatHistory(list []*ChatMsg, budget int) []*ChatMsg {
+	n, used := 0, 0
+	for n < len(list) {
+		used += EstimateTokens(list[n].Content)
+		if n > 0 && used > budget {
+			break
+		}
+		n++
+	}
+	out := make([]*ChatMsg, n)
+	for i := 0; i < n; i++ {
+		out[i] = list[n-1-i]
+	}
+	return out
+}
+
+// ChatMessages 组装模型请求：系统提示 + 聊天历史（按时间正序，见 TrimChatHistory）；
+// 历史末尾不是本轮输入时（如写入失败）补上本轮输入。同步与流式聊天共用
+func ChatMessages(system string, history []*ChatMsg, content string) []ai.Message {
+	msgs := make([]ai.Message, 0, len(history)+2)
+	msgs = append(msgs, ai.Message{Role: ai.RoleSystem, Content: system})
+	for _, m := range history {
+		role := ai.RoleAssistant
+		if m.Sender == 0 {
+			role = ai.RoleUser
+		}
+		msgs = append(msgs, ai.Message{Role: role, Content: m.Content})
+	}
+	if n := len(history); n == 0 || history[n-1].Sender != 0 || history[n-1].Content != content {
+		msgs = append(msgs, ai.Message{Role: ai.RoleUser, Content: content})
+	}
+	return msgs
+}
+
+// EstimateTokens 粗略估算文本的 token 数：中文等非 ASCII 字符按 1 个字 1 个 token，ASCII 按 4 个字符 1 个 token，
+// 另加每条消息约 4 个 token 的格式开销（不依赖具体模型的分词器）
+func EstimateTokens(s string) int {
+	ascii, other := 0, 0
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			ascii++
+		} else {
+			other++
+		}
+	}
+	return other + (ascii+3)/4 + 4
 }
@@ -0,0 +1,176 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 目录名（catalog_versions.catalog）
+const (
+	CatalogPetModels  = "pet_models"
+	CatalogItems      = "items"
+	CatalogCategories = "categories"
+)
+
+// 目录管理错误
+var (
+	ErrCatalogNotFound = errors.NotFound("CATALOG_NOT_FOUND", "catalog entry not found")
+	ErrCatalogInUse    = errors.Conflict("CATALOG_IN_USE", "catalog entry is still referenced")
+)
+
+// ErrInvalidCatalog 目录字段校验失败（HTTP 400）
+func ErrInvalidCatalog(format string, args ...interface{}) error {
+	return errors.BadRequest("INVALID_CATALOG", fmt.Sprintf(format, args...))
+}
+
+const maxSortOrder = 999999
+
+// CatalogRepo 目录管理仓储（GORM 实现）
+// 每个写操作与对应目录的版本号 +1 在同一事务中完成，返回新版本号
+// SavePetModel: ID 为 0 时新增；IsDefault=true 时同类型其它模型取消默认
+// Delete*: 记录不存在返回 ErrCatalogNotFound，仍被引用返回 ErrCatalogInUse
+// Reorder: 将 ids 的 sort_order 依次设为 1..n，任一 ID 不存在返回 ErrCatalogNotFound
+type CatalogRepo interface {
+	Version(ctx context.Context, catalog string) (int64, error)
+
+	SavePetModel(ctx context.Context, m *PetModel) (int64, error)
+	DeletePetModel(ctx context.Context, id int64) (int64, error)
+
+	SaveItem(ctx context.Context, it *Item) (int64, error)
+	DeleteItem(ctx context.Context, id int64) (int64, error)
+
+	SaveCategory(ctx context.Context, c *Category) (int64, error)
+	DeleteCategory(ctx context.Context, id int64) (int64, error)
+
+	Reorder(ctx context.Context, catalog string, ids []int64) (int64, error)
+}
+
+// CatalogUsecase 共享目录（宠物模型/道具/帖子分类）的管理与版本查询
+type CatalogUsecase struct {
+	repo CatalogRepo
+}
+
+func NewCatalogUsecase(repo CatalogRepo) *CatalogUsecase { return &CatalogUsecase{repo: repo} }
+
+// Version 当前目录版本号（未修改过为 0）
+func (uc *CatalogUsecase) Version(ctx context.Context, catalog string) (int64, error) {
+	return uc.repo.Version(ctx, catalog)
+}
+
+// SavePetModel 新增或修改宠物模型，返回新的目录版本号
+func (uc *CatalogUsecase) SavePetModel(ctx context.Context, m *PetModel) (int64, error) {
+	m.Name, m.Path = strings.TrimSpace(m.Name), strings.TrimSpace(m.Path)
+	if err := checkText("name", m.Name, 1, 100); err != nil {
+		return 0, err
+	}
+	if err := checkText("path", m.Path, 1, 255); err != nil {
+		return 0, err
+	}
+	if m.ModelType != 0 && m.ModelType != 1 {
+		return 0, ErrInvalidCatalog("model_type must be 0 (cat) or 1 (dog)")
+	}
+	if m.UnlockLevel < 0 || m.UnlockLevel > MaxPetLevel {
+		return 0, ErrInvalidCatalog("unlock_level must be between 0 and %d", MaxPetLevel)
+	}
+	if m.UnlockCoins < 0 {
+		return 0, ErrInvalidCatalog("unlock_coins must be >= 0")
+	}
+	if m.IsDefault && (m.UnlockLevel > 1 || m.UnlockCoins > 0) {
+		return 0, ErrInvalidCatalog("default model cannot have unlock conditions")
+	}
+	if err := checkSortOrder(m.SortOrder); err != nil {
+		return 0, err
+	}
+	return uc.repo.SavePetModel(ctx, m)
+}
+
+// DeletePetModel 删除宠物模型
+func (uc *CatalogUsecase) DeletePetModel(ctx context.Context, id int64) (int64, error) {
+	return uc.repo.DeletePetModel(ctx, id)
+}
+
+// SaveItem 新增或修改道具
+func (uc *CatalogUsecase) SaveItem(ctx context.Context, it *Item) (int64, error) {
+	it.Name = strings.TrimSpace(it.Name)
+	it.Description, it.IconPath = strings.TrimSpace(it.Description), strings.TrimSpace(it.IconPath)
+	if err := checkText("name", it.Name, 1, 100); err != nil {
+		return 0, err
+	}
+	if err := checkText("description", it.Description, 0, 255); err != nil {
+		return 0, err
+	}
+	if err := checkText("icon_path", it.IconPath, 0, 255); err != nil {
+		return 0, err
+	}
+	if it.CoinCost < 0 {
+		return 0, ErrInvalidCatalog("coin_cost must not be negative")
+	}
+	if err := ValidateItemEffects(it.Effects); err != nil {
+		return 0, err
+	}
+	if err := checkSortOrder(it.SortOrder); err != nil {
+		return 0, err
+	}
+	return uc.repo.SaveItem(ctx, it)
+}
+
+// DeleteItem 删除道具
+func (uc *CatalogUsecase) DeleteItem(ctx context.Context, id int64) (int64, error) {
+	return uc.repo.DeleteItem(ctx, id)
+}
+
+// SaveCategory 新增或修改帖子分类
+func (uc *CatalogUsecase) SaveCategory(ctx context.Context, c *Category) (int64, error) {
+	c.Name = strings.TrimSpace(c.Name)
+	if err := checkText("name", c.Name, 1, 50); err != nil {
+		return 0, err
+	}
+	if err := checkSortOrder(c.SortOrder); err != nil {
+		return 0, err
+	}
+	return uc.repo.SaveCategory(ctx, c)
+}
+
+// DeleteCategory 删除帖子分类
+func (uc *CatalogUsecase) DeleteCategory(ctx context.Context, id int64) (int64, error) {
+	return uc.repo.DeleteCategory(ctx, id)
+}
+
+// Reorder 按 ids 顺序重排目录
+func (uc *CatalogUsecase) Reorder(ctx context.Context, catalog string, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, ErrInvalidCatalog("ids must not be empty")
+	}
+	if len(ids) > maxSortOrder {
+		return 0, ErrInvalidCatalog("too many ids")
+	}
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if id <= 0 || seen[id] {
+			return 0, ErrInvalidCatalog("ids must be positive and unique")
+		}
+		seen[id] = true
+	}
+	return uc.repo.Reorder(ctx, catalog, ids)
+}
+
+func checkText(field, v string, min, max int) error {
+	if n := utf8.RuneCountInString(v); n < min || n > max {
+		if min > 0 {
+			return ErrInvalidCatalog("%s must be %d-%d characters", field, min, max)
+		}
+		return ErrInvalidCatalog("%s must be at most %d characters", field, max)
+	}
+	return nil
+}
+
+func checkSortOrder(v int32) error {
+	if v < 0 || v > maxSortOrder {
+		return ErrInvalidCatalog("sort_order must be between 0 and %d", maxSortOrder)
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"pet-angel/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrInvalidTimezone 时区不是合法的 IANA 名称
+	ErrInvalidTimezone = errors.BadRequest("INVALID_TIMEZONE", "invalid timezone")
+	// ErrCheckInExists 当天签到记录已存在（唯一约束冲突，由并发请求产生）
+	ErrCheckInExists = errors.Conflict("CHECK_IN_EXISTS", "already checked in today")
+)
+
+// checkInDateLayout 签到日期格式（用户时区的自然日）
+const checkInDateLayout = "2006-01-02"
+
+// CheckIn 一次签到（check_ins 表）
+type CheckIn struct {
+	ID        int64     // 签到ID
+	UserID    int64     // 用户ID
+	Date      string    // 签到日期（用户时区，yyyy-MM-dd）
+	Streak    int32     // 连续签到天数（含当天）
+	Reward    int32     // 奖励金币
+	Timezone  string    // 签到时使用的时区
+	CreatedAt time.Time // 签到时间
+}
+
+// CheckInResult 签到结果
+type CheckInResult struct {
+	*CheckIn
+	Coins            int32 // 签到后余额
+	AlreadyCheckedIn bool  // 今天此前已签到，本次未发放奖励
+}
+
+// CheckInDay 签到日历中的一天
+type CheckInDay struct {
+	Date      string
+	CheckedIn bool
+	Reward    int32
+}
+
+// CheckInStatus 签到状态
+type CheckInStatus struct {
+	Today          string
+	Streak         int32 // 当前连续签到天数（断签为 0）
+	CheckedInToday bool
+	NextReward     int32 // 下一次签到的奖励
+	Calendar       []*CheckInDay
+}
+
+// CheckInRepo 签到记录仓储
+// Last: 最近一次签到，没有返回 nil, nil
+// Create: 写入签到并回填 ID；(user_id, date) 已存在返回 ErrCheckInExists
+// List: 日期在 [from, to] 内的签到（按日期升序）
+type CheckInRepo interface {
+	Last(ctx context.Context, userID int64) (*CheckIn, error)
+	Create(ctx context.Context, c *CheckIn) error
+	List(ctx context.Context, userID int64, from, to string) ([]*CheckIn, error)
+}
+
+// CheckInUsecase 每日签到：每个用户每个自然日（按请求时区）奖励一次，奖励按连续天数查表
+// 防刷：签到日期只能晚于上一次签到日期，切换时区无法回到已签到过的日期重复领取
+type CheckInUsecase struct {
+	repo   CheckInRepo
+	wallet *WalletUsecase
+	tx     Transaction
+	log    *log.Helper
+
+	rewards    []int32
+	defaultLoc *time.Location
+}
+
+func NewCheckInUsecase(repo CheckInRepo, wallet *WalletUsecase, tx Transaction, cfg *conf.Rewards, logger log.Logger) *CheckInUsecase {
+	uc := &CheckInUsecase{
+		repo:    repo,
+		wallet:  wallet,
+		tx:      tx,
+		log:     log.NewHelper(logger),
+		rewards: []int32{5, 10, 15, 20, 25, 30, 50},
+	}
+	c := cfg.GetCheckIn()
+	if len(c.GetStreakRewards()) > 0 {
+		uc.rewards = c.GetStreakRewards()
+	}
+	name := c.GetDefaultTimezone()
+	if name == "" {
+		name = "Asia/Shanghai"
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		uc.log.Warnf("check-in: invalid default timezone %q, falling back to UTC: %v", name, err)
+		loc = time.UTC
+	}
+	uc.defaultLoc = loc
+	return uc
+}
+
+// rewardFor 连续第 streak 天的奖励，超出奖励表长度按最后一项
+func (uc *CheckInUsecase) rewardFor(streak int32) int32 {
+	i := int(streak) - 1
+	if i >= len(uc.rewards) {
+		i = len(uc.rewards) - 1
+	}
+	if i < 0 {
+		i = 0
+	}
+	return uc.rewards[i]
+}
+
+func (uc *CheckInUsecase) location(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return uc.defaultLoc, nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	// LoadLocation 接受 "Local"，其含义取决于服务器配置，不允许客户端使用
+	if err != nil || timezone == "Local" {
+		return nil, ErrInvalidTimezone
+	}
+	return loc, nil
+}
+
+// CheckIn 签到：当天首次调用发放奖励，之后（包括并发请求）返回当天的签到结果
+func (uc *CheckInUsecase) CheckIn(ctx context.Context, userID int64, timezone string) (*CheckInResult, error) {
+	loc, err := uc.location(timezone)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().In(loc)
+	today := now.Format(checkInDateLayout)
+	yesterday := now.AddDate(0, 0, -1).Format(checkInDateLayout)
+
+	var res *CheckInResult
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		last, err := uc.repo.Last(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if last != nil && last.Date >= today {
+			res = &CheckInResult{CheckIn: last, AlreadyCheckedIn: true}
+			return nil
+		}
+		streak := int32(1)
+		if last != nil && last.Date == yesterday {
+			streak = last.Streak + 1
+		}
+		c := &CheckIn{UserID: userID, Date: today, Streak: streak, Reward: uc.rewardFor(streak), Timezone: loc.String(), CreatedAt: now}
+		if err := uc.repo.Create(ctx, c); err != nil {
+			return err
+		}
+		res = &CheckInResult{CheckIn: c}
+		if c.Reward <= 0 {
+			res.Coins, err = uc.wallet.Balance(ctx, userID)
+			return err
+		}
+		t, err := uc.wallet.Credit(ctx, userID, c.Reward, CoinReasonReward, CoinRef{Type: CoinRefCheckIn, ID: c.ID})
+		if err != nil {
+			return err
+		}
+		res.Coins = t.Balance
+		return nil
+	})
+	if errors.Is(err, ErrCheckInExists) {
+		// 并发请求已完成当天签到
+		last, lerr := uc.repo.Last(ctx, userID)
+		if lerr != nil {
+			return nil, lerr
+		}
+		if last == nil {
+			return nil, err
+		}
+		res, err = &CheckInResult{CheckIn: last, AlreadyCheckedIn: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res.AlreadyCheckedIn {
+		if res.Coins, err = uc.wallet.Balance(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// Status 连续签到天数与本月签到日历（按用户时区）
+func (uc *CheckInUsecase) Status(ctx context.Context, userID int64, timezone string) (*CheckInStatus, error) {
+	loc, err := uc.location(timezone)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().In(loc)
+	today := now.Format(checkInDateLayout)
+	yesterday := now.AddDate(0, 0, -1).Format(checkInDateLayout)
+
+	last, err := uc.repo.Last(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	st := &CheckInStatus{Today: today}
+	if last != nil && last.Date >= yesterday {
+		st.Streak = last.Streak
+		st.CheckedInToday = last.Date >= today
+	}
+	st.NextReward = uc.rewardFor(st.Streak + 1)
+
+	first := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+	end := first.AddDate(0, 1, -1)
+	list, err := uc.repo.List(ctx, userID, first.Format(checkInDateLayout), end.Format(checkInDateLayout))
+	if err != nil {
+		return nil, err
+	}
+	byDate := make(map[string]*CheckIn, len(list))
+	for _, c := range list {
+		byDate[c.Date] = c
+	}
+	for d := first; !d.After(end); d = d.AddDate(0, 0, 1) {
+		day := &CheckInDay{Date: d.Format(checkInDateLayout)}
+		if c, ok := byDate[day.Date]; ok {
+			day.CheckedIn, day.Reward = true, c.Reward
+		}
+		st.Calendar = append(st.Calendar, day)
+	}
+	return st, nil
+}
@@ -10,8 +10,9 @@ import (
 // 仅包含社区模块所需字段
 
 type Category struct {
-	ID   int64  // 分类ID
-	Name string // 分类名称
+	ID        int64  // 分类ID
+	Name      string // 分类名称
+	SortOrder int32  // 排序
 }
 
 // CommunityPost 帖子聚合视图
@@ -67,10 +68,13 @@ type CommunityRepo interface {
 // CommunityUsecase 社区用例
 
 type CommunityUsecase struct {
-	repo CommunityRepo
+	repo   CommunityRepo
+	events *EventBus
 }
 
-func NewCommunityUsecase(repo CommunityRepo) *CommunityUsecase { return &CommunityUsecase{repo: repo} }
+func NewCommunityUsecase(repo CommunityRepo, events *EventBus) *CommunityUsecase {
+	return &CommunityUsecase{repo: repo, events: events}
+}
 
 func (uc *CommunityUsecase) GetCategories(ctx context.Context) ([]*Category, error) {
 	return uc.repo.ListCategories(ctx)
@@ -85,11 +89,20 @@ func (uc *CommunityUsecase) GetPostDetail(ctx context.Context, viewerID, postID
 }
 
 func (uc *CommunityUsecase) CreatePost(ctx context.Context, userID int64, p *CommunityPost) (int64, error) {
-	return uc.repo.CreatePost(ctx, userID, p)
+	id, err := uc.repo.CreatePost(ctx, userID, p)
+	if err != nil {
+		return 0, err
+	}
+	uc.events.Publish(ctx, &ActivityEvent{Type: EventPostCreated, UserID: userID, PostID: id})
+	return id, nil
 }
 
 func (uc *CommunityUsecase) LikePost(ctx context.Context, userID, postID int64) error {
-	return uc.repo.LikePost(ctx, userID, postID)
+	if err := uc.repo.LikePost(ctx, userID, postID); err != nil {
+		return err
+	}
+	uc.events.Publish(ctx, &ActivityEvent{Type: EventPostLiked, UserID: userID, PostID: postID})
+	return nil
 }
 
 func (uc *CommunityUsecase) UnlikePost(ctx context.Context, userID, postID int64) error {
@@ -101,7 +114,12 @@ func (uc *CommunityUsecase) GetCommentList(ctx context.Context, viewerID, postID
 }
 
 func (uc *CommunityUsecase) CreateComment(ctx context.Context, userID, postID int64, content string) (int64, error) {
-	return uc.repo.CreateComment(ctx, userID, postID, content)
+	id, err := uc.repo.CreateComment(ctx, userID, postID, content)
+	if err != nil {
+		return 0, err
+	}
+	uc.events.Publish(ctx, &ActivityEvent{Type: EventCommentCreated, UserID: userID, PostID: postID, Content: content})
+	return id, nil
 }
 
 func (uc *CommunityUsecase) LikeComment(ctx context.Context, userID, commentID int64) error {
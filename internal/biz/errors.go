@@ -4,9 +4,13 @@ import "errors"
 
 // 用户相关错误
 var (
-	ErrUserAlreadyExists = errors.New("user already exists")
-	ErrInvalidPassword   = errors.New("invalid password")
-	ErrCannotFollowSelf  = errors.New("cannot follow self")
+	ErrUserAlreadyExists  = errors.New("user already exists")
+	ErrPhoneAlreadyExists = errors.New("phone already bound to another user")
+	ErrInvalidPassword    = errors.New("invalid password")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidUsername    = errors.New("username must be 3-32 letters, digits or underscores and start with a letter")
+	ErrWeakPassword       = errors.New("password must be 8-64 characters and contain both letters and digits")
+	ErrCannotFollowSelf   = errors.New("cannot follow self")
 )
 
 // 宠物相关错误
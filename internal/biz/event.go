@@ -0,0 +1,67 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 领域事件类型
+const (
+	EventPostCreated    = "post_created"    // 发布帖子
+	EventPostLiked      = "post_liked"      // 点赞帖子
+	EventCommentCreated = "comment_created" // 发表评论
+	EventPetChatted     = "pet_chatted"     // 与宠物聊天（用户发出消息）
+	EventItemUsed       = "item_used"       // 使用道具（喂食/玩耍等）
+)
+
+// ActivityEvent 用户行为产生的领域事件（业务操作成功后发布）
+type ActivityEvent struct {
+	Type      string    // 见 Event* 常量
+	UserID    int64     // 触发者
+	PetID     int64     // 相关宠物（聊天对象/使用道具的宠物）；0 表示触发者的当前宠物
+	PostID    int64     // 帖子相关事件的帖子ID
+	MessageID int64     // 聊天事件的用户消息ID
+	ItemID    int64     // 使用道具事件的道具ID
+	Content   string    // 评论/聊天内容
+	At        time.Time // 发生时间
+}
+
+// EventHandler 事件订阅者；返回的错误只记录日志，不影响已完成的业务操作
+type EventHandler func(ctx context.Context, e *ActivityEvent) error
+
+// EventBus 进程内领域事件总线：订阅者按注册顺序在发布方的请求内同步处理
+// 为 nil 时 Publish 不做任何事，便于单测中省略
+type EventBus struct {
+	handlers []EventHandler
+	log      *log.Helper
+}
+
+// NewEventBus 创建事件总线并注册订阅者
+func NewEventBus(rewards *ActivityRewardUsecase, levels *PetLevelUsecase, logger log.Logger) *EventBus {
+	b := &EventBus{log: log.NewHelper(logger)}
+	b.Subscribe(rewards.Handle)
+	b.Subscribe(levels.Handle)
+	return b
+}
+
+// Subscribe 注册订阅者
+func (b *EventBus) Subscribe(h EventHandler) {
+	b.handlers = append(b.handlers, h)
+}
+
+// Publish 发布事件
+func (b *EventBus) Publish(ctx context.Context, e *ActivityEvent) {
+	if b == nil {
+		return
+	}
+	if e.At.IsZero() {
+		e.At = time.Now()
+	}
+	for _, h := range b.handlers {
+		if err := h(ctx, e); err != nil {
+			b.log.WithContext(ctx).Errorf("event %s user=%d: handler failed: %v", e.Type, e.UserID, err)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+)
+
+// maxPurchaseQuantity 单次购买数量上限
+const maxPurchaseQuantity = 99
+
+// InventoryItem 背包中的道具（user_items 表）
+type InventoryItem struct {
+	Item      *Item     // 道具信息
+	Quantity  int32     // 持有数量
+	UpdatedAt time.Time // 最近变动时间
+}
+
+// ItemPurchase 道具购买记录（item_purchases 表）
+type ItemPurchase struct {
+	ID        int64     // 购买记录ID
+	UserID    int64     // 用户ID
+	ItemID    int64     // 道具ID
+	ItemName  string    // 道具名称（仅查询时填充）
+	Quantity  int32     // 购买数量
+	UnitCost  int32     // 购买时单价
+	TotalCost int32     // 总花费
+	CoinTxID  int64     // 对应的金币流水ID（免费道具为 0）
+	CreatedAt time.Time // 购买时间
+}
+
+// InventoryRepo 背包与购买记录仓储
+// Add: 增加持有数量（不存在则创建），返回变动后数量
+// Consume: 扣减持有数量，不足返回 ErrPropNotOwned，返回变动后数量
+// List: 持有数量大于 0 的道具（按道具排序序号）
+// CreatePurchase: 写入购买记录并回填 ID
+// ListPurchases: 按 ID 倒序返回 ID < beforeID（为 0 时不限）的购买记录，并填充 ItemName
+type InventoryRepo interface {
+	Add(ctx context.Context, userID, itemID int64, quantity int32) (int32, error)
+	Consume(ctx context.Context, userID, itemID int64, quantity int32) (int32, error)
+	List(ctx context.Context, userID int64) ([]*InventoryItem, error)
+	CreatePurchase(ctx context.Context, p *ItemPurchase) error
+	ListPurchases(ctx context.Context, userID, beforeID int64, limit int) ([]*ItemPurchase, error)
+}
+
+// InventoryUsecase 道具背包：购买时扣金币入包，使用时消耗背包数量并作用于宠物状态
+type InventoryUsecase struct {
+	repo   InventoryRepo
+	items  AvatarRepo
+	wallet *WalletUsecase
+	pets   *PetStateUsecase
+	events *EventBus
+	tx     Transaction
+}
+
+func NewInventoryUsecase(repo InventoryRepo, items AvatarRepo, wallet *WalletUsecase, pets *PetStateUsecase, events *EventBus, tx Transaction) *InventoryUsecase {
+	return &InventoryUsecase{repo: repo, items: items, wallet: wallet, pets: pets, events: events, tx: tx}
+}
+
+// PurchaseResult 购买结果
+type PurchaseResult struct {
+	Purchase *ItemPurchase
+	Owned    int32 // 购买后持有数量
+	Coins    int32 // 购买后余额
+}
+
+// Purchase 购买 quantity（默认 1）个道具：扣金币、入背包、写购买记录在同一事务内完成
+func (uc *InventoryUsecase) Purchase(ctx context.Context, userID, itemID int64, quantity int32) (*PurchaseResult, error) {
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity < 0 || quantity > maxPurchaseQuantity {
+		return nil, ErrInvalidParameter
+	}
+	it, err := uc.items.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	total := int64(it.CoinCost) * int64(quantity)
+	if it.CoinCost < 0 || total > math.MaxInt32 {
+		return nil, ErrInvalidParameter
+	}
+	p := &ItemPurchase{UserID: userID, ItemID: it.ID, ItemName: it.Name, Quantity: quantity, UnitCost: it.CoinCost, TotalCost: int32(total), CreatedAt: time.Now()}
+	res := &PurchaseResult{Purchase: p}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if p.TotalCost > 0 {
+			t, err := uc.wallet.Debit(ctx, userID, p.TotalCost, CoinReasonItemPurchase, CoinRef{Type: CoinRefItem, ID: it.ID})
+			if err != nil {
+				return err
+			}
+			p.CoinTxID, res.Coins = t.ID, t.Balance
+		} else {
+			coins, err := uc.wallet.Balance(ctx, userID)
+			if err != nil {
+				return err
+			}
+			res.Coins = coins
+		}
+		owned, err := uc.repo.Add(ctx, userID, it.ID, quantity)
+		if err != nil {
+			return err
+		}
+		res.Owned = owned
+		return uc.repo.CreatePurchase(ctx, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListMine 我的背包
+func (uc *InventoryUsecase) ListMine(ctx context.Context, userID int64) ([]*InventoryItem, error) {
+	return uc.repo.List(ctx, userID)
+}
+
+// ListPurchases 购买记录（游标分页），返回下一页游标（为空表示没有更多）
+func (uc *InventoryUsecase) ListPurchases(ctx context.Context, userID int64, cursor string, pageSize int32) ([]*ItemPurchase, string, error) {
+	beforeID, limit, err := parseIDCursor(cursor, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	list, err := uc.repo.ListPurchases(ctx, userID, beforeID, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	next := ""
+	if len(list) > limit {
+		list = list[:limit]
+		next = strconv.FormatInt(list[len(list)-1].ID, 10)
+	}
+	return list, next, nil
+}
+
+// UseResult 使用道具结果
+type UseResult struct {
+	Remaining int32     // 该道具剩余数量
+	State     *PetState // 使用后的宠物状态
+}
+
+// Use 对宠物使用一个道具（petID 为 0 时为当前宠物）：消耗背包数量（不扣金币）并叠加道具效果
+// 未持有返回 ErrPropNotOwned，尚无宠物返回 ErrPetNotFound
+func (uc *InventoryUsecase) Use(ctx context.Context, userID, petID, itemID int64) (*UseResult, error) {
+	it, err := uc.items.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	res := &UseResult{}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		remaining, err := uc.repo.Consume(ctx, userID, itemID, 1)
+		if err != nil {
+			return err
+		}
+		state, err := uc.pets.ApplyEffects(ctx, userID, petID, it.Effects)
+		if err != nil {
+			return err
+		}
+		res.Remaining, res.State = remaining, state
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.events.Publish(ctx, &ActivityEvent{Type: EventItemUsed, UserID: userID, PetID: res.State.PetID, ItemID: itemID})
+	return res, nil
+}
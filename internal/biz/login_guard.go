@@ -0,0 +1,142 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"pet-angel/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrLoginLocked 登录失败次数过多被临时锁定（HTTP 429），metadata.retry_after 为建议重试等待秒数
+func ErrLoginLocked(retryAfter time.Duration) error {
+	secs := int64(math.Ceil(retryAfter.Seconds()))
+	if secs < 1 {
+		secs = 1
+	}
+	return errors.New(429, "LOGIN_LOCKED", "too many failed login attempts, please retry later").
+		WithMetadata(map[string]string{"retry_after": strconv.FormatInt(secs, 10)})
+}
+
+// LoginAttempt 某个维度（用户名/IP）的登录失败状态
+type LoginAttempt struct {
+	Failures    int32     // 窗口内连续失败次数
+	LockedUntil time.Time // 锁定截止时间（零值表示未锁定）
+}
+
+// LoginAttemptRepo 登录失败计数存储（Redis 或进程内 LRU）
+// RecordFailure: 失败次数 +1 并以 window 为有效期（滑动），返回累计次数
+// Lock: 锁定 d 时长，同时保证失败计数至少保留到解锁后一个窗口（用于指数退避）
+// Reset: 清除计数与锁定
+type LoginAttemptRepo interface {
+	Get(ctx context.Context, key string) (*LoginAttempt, error)
+	RecordFailure(ctx context.Context, key string, window time.Duration) (int32, error)
+	Lock(ctx context.Context, key string, d, window time.Duration) error
+	Reset(ctx context.Context, key string) error
+}
+
+// loginGuard 登录防暴力破解：按用户名与 IP 两个维度计数，超过阈值后按指数退避锁定
+type loginGuard struct {
+	repo       LoginAttemptRepo
+	maxPerUser int32
+	maxPerIP   int32
+	window     time.Duration
+	base       time.Duration
+	max        time.Duration
+}
+
+func newLoginGuard(repo LoginAttemptRepo, c *conf.LoginThrottle) *loginGuard {
+	g := &loginGuard{
+		repo:       repo,
+		maxPerUser: 5,
+		maxPerIP:   20,
+		window:     15 * time.Minute,
+		base:       30 * time.Second,
+		max:        time.Hour,
+	}
+	if c == nil {
+		return g
+	}
+	if c.GetMaxFailuresPerUser() > 0 {
+		g.maxPerUser = c.GetMaxFailuresPerUser()
+	}
+	if c.GetMaxFailuresPerIp() > 0 {
+		g.maxPerIP = c.GetMaxFailuresPerIp()
+	}
+	if c.GetFailureWindow() != nil {
+		g.window = c.GetFailureWindow().AsDuration()
+	}
+	if c.GetBaseLockout() != nil {
+		g.base = c.GetBaseLockout().AsDuration()
+	}
+	if c.GetMaxLockout() != nil {
+		g.max = c.GetMaxLockout().AsDuration()
+	}
+	return g
+}
+
+type guardKey struct {
+	key       string
+	threshold int32
+}
+
+func (g *loginGuard) keys(username, ip string) []guardKey {
+	keys := []guardKey{{key: "user:" + strings.ToLower(strings.TrimSpace(username)), threshold: g.maxPerUser}}
+	if ip != "" {
+		keys = append(keys, guardKey{key: "ip:" + ip, threshold: g.maxPerIP})
+	}
+	return keys
+}
+
+// check 返回各维度中最长的剩余锁定时长（0 表示未锁定）
+func (g *loginGuard) check(ctx context.Context, username, ip string) (time.Duration, error) {
+	now := time.Now()
+	var wait time.Duration
+	for _, k := range g.keys(username, ip) {
+		a, err := g.repo.Get(ctx, k.key)
+		if err != nil {
+			return 0, err
+		}
+		if d := a.LockedUntil.Sub(now); d > wait {
+			wait = d
+		}
+	}
+	return wait, nil
+}
+
+// fail 记录一次失败；达到阈值后锁定，时长 = base * 2^(超出阈值的次数)，不超过 max
+func (g *loginGuard) fail(ctx context.Context, username, ip string) error {
+	for _, k := range g.keys(username, ip) {
+		n, err := g.repo.RecordFailure(ctx, k.key, g.window)
+		if err != nil {
+			return err
+		}
+		if n < k.threshold {
+			continue
+		}
+		if err := g.repo.Lock(ctx, k.key, g.lockout(n-k.threshold), g.window); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// succeed 登录成功清零用户名维度（IP 维度不清零，避免用一个自有账号洗掉对其它账号的猜测记录）
+func (g *loginGuard) succeed(ctx context.Context, username string) error {
+	return g.repo.Reset(ctx, g.keys(username, "")[0].key)
+}
+
+func (g *loginGuard) lockout(over int32) time.Duration {
+	d := g.base
+	for i := int32(0); i < over && d < g.max; i++ {
+		d *= 2
+	}
+	if d > g.max {
+		d = g.max
+	}
+	return d
+}
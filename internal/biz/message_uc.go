@@ -5,12 +5,16 @@ import (
 	"time"
 )
 
+// MessageTypeReminder 护理提醒到点时宠物发来的消息（聊天记录之外的消息类型：1 小纸条）
+const MessageTypeReminder int32 = 2
+
 // Message 业务实体（复用消息表结构）
 type Message struct {
 	ID          int64     // 消息ID
 	UserID      int64     // 用户ID
+	PetID       int64     // 聊天对象宠物ID（小纸条为 0）
 	Sender      int32     // 0用户 1AI
-	MessageType int32     // 0聊天 1小纸条
+	MessageType int32     // 0聊天 1小纸条 2提醒
 	IsLocked    bool      // 是否锁定
 	UnlockCoins int32     // 解锁所需金币
 	Content     string    // 内容
@@ -18,28 +22,38 @@ type Message struct {
 }
 
 // MessageRepo 数据仓储接口
-// ListMessages: 返回总数与列表（倒序分页）
-// UnlockMessage: 事务性解锁，扣金币，返回剩余金币与最新消息
+// ListMessages: 返回总数与列表（倒序分页）；聊天与提醒只含 petID 这只宠物的记录，小纸条不区分宠物
+// LockNote: 锁定并读取用户的小纸条（须在事务内调用），不存在返回 ErrMessageNotFound
+// MarkUnlocked: 标记已解锁并写入解锁记录
 // GetMessageByID: 获取单条（用于服务端再取）
+// CreateMessage: 写入一条消息并回填 ID（可在事务内调用）
 
 type MessageRepo interface {
-	ListMessages(ctx context.Context, userID int64, onlyNotes bool, page, pageSize int32) (total int32, list []*Message, err error)
-	UnlockMessage(ctx context.Context, userID, messageID int64) (remainingCoins int32, msg *Message, err error)
+	ListMessages(ctx context.Context, userID, petID int64, onlyNotes bool, page, pageSize int32) (total int32, list []*Message, err error)
+	LockNote(ctx context.Context, userID, messageID int64) (*Message, error)
+	MarkUnlocked(ctx context.Context, userID, messageID int64, coins int32) error
 	GetMessageByID(ctx context.Context, userID, messageID int64) (*Message, error)
 	// 生成一条“AI 小纸条”（锁定），仅写库，不解锁
 	CreateLockedNote(ctx context.Context, userID int64, unlockCoins int32, content string) (int64, error)
+	CreateMessage(ctx context.Context, m *Message) error
 }
 
 // MessageUsecase 用例
 
 type MessageUsecase struct {
-	repo MessageRepo
+	repo   MessageRepo
+	pets   *PetUsecase
+	wallet *WalletUsecase
+	tx     Transaction
 }
 
-func NewMessageUsecase(repo MessageRepo) *MessageUsecase { return &MessageUsecase{repo: repo} }
+func NewMessageUsecase(repo MessageRepo, pets *PetUsecase, wallet *WalletUsecase, tx Transaction) *MessageUsecase {
+	return &MessageUsecase{repo: repo, pets: pets, wallet: wallet, tx: tx}
+}
 
-// GetList 获取消息列表
-func (uc *MessageUsecase) GetList(ctx context.Context, userID int64, onlyNotes bool, page, pageSize int32) (int32, []*Message, error) {
+// GetList 获取消息列表：与某只宠物的聊天记录与提醒（petID 为 0 时为当前宠物）及小纸条
+// 宠物不属于该用户返回 ErrPetNotFound
+func (uc *MessageUsecase) GetList(ctx context.Context, userID, petID int64, onlyNotes bool, page, pageSize int32) (int32, []*Message, error) {
 	if page <= 0 {
 		page = 1
 	}
@@ -49,12 +63,49 @@ func (uc *MessageUsecase) GetList(ctx context.Context, userID int64, onlyNotes b
 	if pageSize > 100 {
 		pageSize = 100
 	}
-	return uc.repo.ListMessages(ctx, userID, onlyNotes, page, pageSize)
+	if !onlyNotes {
+		pet, err := uc.pets.Resolve(ctx, userID, petID)
+		if err != nil {
+			return 0, nil, err
+		}
+		petID = 0
+		if pet != nil {
+			petID = pet.ID
+		}
+	}
+	return uc.repo.ListMessages(ctx, userID, petID, onlyNotes, page, pageSize)
 }
 
-// Unlock 解锁小纸条
+// Unlock 解锁小纸条：同一事务内锁定纸条、扣金币并标记解锁，返回剩余金币与最新消息
+// 已解锁的纸条重复解锁不再扣费
 func (uc *MessageUsecase) Unlock(ctx context.Context, userID, messageID int64) (int32, *Message, error) {
-	remaining, msg, err := uc.repo.UnlockMessage(ctx, userID, messageID)
+	var remaining int32
+	var msg *Message
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		m, err := uc.repo.LockNote(ctx, userID, messageID)
+		if err != nil {
+			return err
+		}
+		msg = m
+		if !m.IsLocked {
+			remaining, err = uc.wallet.Balance(ctx, userID)
+			return err
+		}
+		if m.UnlockCoins > 0 {
+			t, err := uc.wallet.Debit(ctx, userID, m.UnlockCoins, CoinReasonNoteUnlock, CoinRef{Type: CoinRefMessage, ID: m.ID})
+			if err != nil {
+				return err
+			}
+			remaining = t.Balance
+		} else if remaining, err = uc.wallet.Balance(ctx, userID); err != nil {
+			return err
+		}
+		if err := uc.repo.MarkUnlocked(ctx, userID, m.ID, m.UnlockCoins); err != nil {
+			return err
+		}
+		m.IsLocked = false
+		return nil
+	})
 	if err != nil {
 		return 0, nil, err
 	}
@@ -0,0 +1,207 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrUnknownOAuthProvider 渠道未配置
+	ErrUnknownOAuthProvider = errors.BadRequest("UNKNOWN_OAUTH_PROVIDER", "unknown oauth provider")
+	// ErrOAuthExchange 授权码无效、已过期或渠道校验失败
+	ErrOAuthExchange = errors.Unauthorized("OAUTH_EXCHANGE_FAILED", "invalid or expired authorization code")
+	// ErrIdentityLinked 外部账号已绑定其它用户
+	ErrIdentityLinked = errors.Conflict("IDENTITY_ALREADY_LINKED", "external account is linked to another user")
+	// ErrProviderLinked 当前用户已绑定该渠道的其它外部账号
+	ErrProviderLinked = errors.Conflict("PROVIDER_ALREADY_LINKED", "provider is already linked to this user")
+	// ErrIdentityNotFound 外部账号未绑定
+	ErrIdentityNotFound = errors.NotFound("IDENTITY_NOT_FOUND", "identity not found")
+)
+
+// OAuthProviderNamePattern 渠道名规则（同时用于生成用户名前缀）
+var OAuthProviderNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,15}$`)
+
+// ExternalIdentity 渠道返回的外部账号
+type ExternalIdentity struct {
+	Subject  string // 渠道内唯一ID（openid/sub）
+	Nickname string // 昵称（可空）
+	Avatar   string // 头像URL（可空）
+}
+
+// OAuthProvider 第三方登录渠道：用客户端拿到的授权码换取外部账号
+// 授权码无效或已过期返回 ErrOAuthExchange
+type OAuthProvider interface {
+	Exchange(ctx context.Context, code, redirectURI string) (*ExternalIdentity, error)
+}
+
+// OAuthProviders 渠道名 -> 实现
+type OAuthProviders map[string]OAuthProvider
+
+// UserIdentity 用户绑定的外部账号（user_identities）
+// 同一外部账号只能绑定一个用户；同一用户每个渠道最多绑定一个外部账号
+type UserIdentity struct {
+	ID          int64     // 记录ID
+	UserID      int64     // 用户ID
+	Provider    string    // 渠道名
+	Subject     string    // 渠道内唯一ID
+	Nickname    string    // 绑定时的外部昵称
+	Avatar      string    // 绑定时的外部头像
+	CreatedAt   time.Time // 绑定时间
+	LastLoginAt time.Time // 最近一次通过该渠道登录的时间
+}
+
+// IdentityRepo 外部账号绑定仓储
+// Get: 不存在返回 ErrIdentityNotFound
+// Create: 外部账号已绑定返回 ErrIdentityLinked，该用户已绑定同渠道返回 ErrProviderLinked
+type IdentityRepo interface {
+	Get(ctx context.Context, provider, subject string) (*UserIdentity, error)
+	Create(ctx context.Context, i *UserIdentity) (int64, error)
+	ListByUser(ctx context.Context, userID int64) ([]*UserIdentity, error)
+	TouchLogin(ctx context.Context, id int64, at time.Time) error
+}
+
+// OAuthUsecase 第三方登录与账号绑定
+type OAuthUsecase struct {
+	auth       *AuthUsecase
+	identities IdentityRepo
+	accounts   AccountRepo
+	providers  OAuthProviders
+	log        *log.Helper
+}
+
+func NewOAuthUsecase(auth *AuthUsecase, identities IdentityRepo, accounts AccountRepo, providers OAuthProviders, logger log.Logger) *OAuthUsecase {
+	return &OAuthUsecase{auth: auth, identities: identities, accounts: accounts, providers: providers, log: log.NewHelper(logger)}
+}
+
+func (uc *OAuthUsecase) exchange(ctx context.Context, provider, code, redirectURI string) (*ExternalIdentity, error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return nil, ErrUnknownOAuthProvider
+	}
+	if code == "" {
+		return nil, ErrOAuthExchange
+	}
+	ext, err := p.Exchange(ctx, code, redirectURI)
+	if err != nil {
+		return nil, err
+	}
+	if ext.Subject == "" || len(ext.Subject) > 128 {
+		return nil, ErrOAuthExchange
+	}
+	ext.Nickname, ext.Avatar = truncate(ext.Nickname, 100), truncate(ext.Avatar, 255)
+	return ext, nil
+}
+
+// Login 第三方登录：外部账号已绑定则登录对应用户，否则自动注册新用户并绑定
+// 新用户的用户名为 "<渠道名>_<随机串>"、密码为随机值（不可用于密码登录）；created 表示本次新注册
+func (uc *OAuthUsecase) Login(ctx context.Context, provider, code, redirectURI string, client ClientInfo) (u *User, pair *TokenPair, created bool, err error) {
+	ext, err := uc.exchange(ctx, provider, code, redirectURI)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	ident, err := uc.identities.Get(ctx, provider, ext.Subject)
+	switch {
+	case err == nil:
+		if u, err = uc.auth.repo.GetByID(ctx, ident.UserID); err != nil {
+			return nil, nil, false, err
+		}
+		if err := uc.identities.TouchLogin(ctx, ident.ID, time.Now()); err != nil {
+			uc.log.WithContext(ctx).Warnf("touch identity %d failed: %v", ident.ID, err)
+		}
+	case errors.Is(err, ErrIdentityNotFound):
+		if u, err = uc.register(ctx, provider, ext); err != nil {
+			return nil, nil, false, err
+		}
+		created = true
+	default:
+		return nil, nil, false, err
+	}
+	pair, err = uc.auth.startSession(ctx, u, client)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return u, pair, created, nil
+}
+
+// register 为未绑定的外部账号创建用户并绑定（不设密码，与验证码注册一致）
+// 并发登录同一外部账号时只有一个绑定成功，失败方的新用户会被回收，并登录到胜出的用户
+func (uc *OAuthUsecase) register(ctx context.Context, provider string, ext *ExternalIdentity) (*User, error) {
+	var u *User
+	var err error
+	for i := 0; i < 3; i++ {
+		nickname := truncate(ext.Nickname, 50)
+		username := provider + "_" + randomHex(5)
+		if nickname == "" {
+			nickname = username
+		}
+		if u, err = uc.auth.create(ctx, username, "", nickname); !errors.Is(err, ErrUserAlreadyExists) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ext.Avatar != "" {
+		u.Avatar = ext.Avatar
+		if err := uc.auth.repo.UpdateInfo(ctx, &User{Id: u.Id, Avatar: ext.Avatar}); err != nil {
+			uc.log.WithContext(ctx).Warnf("set avatar for user %d failed: %v", u.Id, err)
+		}
+	}
+	now := time.Now()
+	_, err = uc.identities.Create(ctx, &UserIdentity{
+		UserID: u.Id, Provider: provider, Subject: ext.Subject,
+		Nickname: ext.Nickname, Avatar: ext.Avatar, CreatedAt: now, LastLoginAt: now,
+	})
+	if errors.Is(err, ErrIdentityLinked) {
+		ident, gerr := uc.identities.Get(ctx, provider, ext.Subject)
+		if gerr != nil {
+			return nil, gerr
+		}
+		if derr := uc.accounts.Delete(ctx, u.Id); derr != nil {
+			uc.log.WithContext(ctx).Warnf("discard duplicate oauth user %d failed: %v", u.Id, derr)
+		}
+		return uc.auth.repo.GetByID(ctx, ident.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// Link 为当前用户绑定新的外部账号（已绑定到本人时视为成功）
+func (uc *OAuthUsecase) Link(ctx context.Context, userID int64, provider, code, redirectURI string) error {
+	ext, err := uc.exchange(ctx, provider, code, redirectURI)
+	if err != nil {
+		return err
+	}
+	if ident, err := uc.identities.Get(ctx, provider, ext.Subject); err == nil {
+		if ident.UserID == userID {
+			return nil
+		}
+		return ErrIdentityLinked
+	} else if !errors.Is(err, ErrIdentityNotFound) {
+		return err
+	}
+	_, err = uc.identities.Create(ctx, &UserIdentity{
+		UserID: userID, Provider: provider, Subject: ext.Subject,
+		Nickname: ext.Nickname, Avatar: ext.Avatar, CreatedAt: time.Now(),
+	})
+	return err
+}
+
+// ListIdentities 当前用户绑定的外部账号
+func (uc *OAuthUsecase) ListIdentities(ctx context.Context, userID int64) ([]*UserIdentity, error) {
+	return uc.identities.ListByUser(ctx, userID)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,181 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrInvalidResetCode 重置验证码错误、已使用、已过期或尝试次数过多
+var ErrInvalidResetCode = errors.BadRequest("INVALID_RESET_CODE", "invalid or expired reset code")
+
+const (
+	resetCodeMaxAttempts = 5           // 单个验证码最多尝试次数
+	resetCodeResendAfter = time.Minute // 同一用户重复申请的最小间隔
+)
+
+// Notification 待发送的通知（验证码等）
+type Notification struct {
+	UserID   int64  // 接收用户ID
+	Username string // 接收用户名
+	Subject  string // 标题
+	Content  string // 正文
+}
+
+// Notifier 通知发送通道（站内信/短信/邮件等），本地开发可使用日志或文件实现
+type Notifier interface {
+	Send(ctx context.Context, n *Notification) error
+}
+
+// PasswordReset 密码重置验证码（库中只保存 bcrypt 哈希）
+type PasswordReset struct {
+	ID        int64      // 记录ID
+	UserID    int64      // 用户ID
+	CodeHash  string     // 验证码哈希
+	Attempts  int32      // 已尝试次数
+	ExpiresAt time.Time  // 过期时间
+	UsedAt    *time.Time // 使用时间（nil 表示未使用）
+	CreatedAt time.Time  // 创建时间
+}
+
+// PasswordResetRepo 重置验证码仓储
+// Create: 写入新验证码，同时作废该用户此前未使用的验证码
+// GetActive: 用户最新一条未使用且未过期的验证码，不存在返回 ErrInvalidResetCode
+// TakeAttempt: 条件原子地占用一次尝试（attempts<max 时 +1），次数已用完返回 ErrInvalidResetCode；须在比对验证码之前调用
+// Consume: 条件标记已使用（仅未使用时成功），保证单次有效
+type PasswordResetRepo interface {
+	Create(ctx context.Context, r *PasswordReset) (int64, error)
+	GetActive(ctx context.Context, userID int64, now time.Time) (*PasswordReset, error)
+	TakeAttempt(ctx context.Context, id int64, max int32) error
+	Consume(ctx context.Context, id int64) error
+}
+
+// verifyPassword 校验密码，返回是否为需要升级的历史明文
+// 库中为 bcrypt 哈希时只做哈希比对；非哈希值视为历史明文（种子数据等），做常量时间比对
+func verifyPassword(stored, password string) (ok, legacy bool) {
+	if isBcryptHash(stored) {
+		return bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) == nil, false
+	}
+	ok = stored != "" && subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1
+	return ok, ok
+}
+
+func isBcryptHash(s string) bool {
+	_, err := bcrypt.Cost([]byte(s))
+	return err == nil
+}
+
+// ChangePassword 修改密码（需校验旧密码），成功后吊销该用户的其它会话
+func (uc *AuthUsecase) ChangePassword(ctx context.Context, userID, currentSessionID int64, oldPassword, newPassword string) error {
+	u, err := uc.repo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if ok, _ := verifyPassword(u.Password, oldPassword); !ok {
+		return ErrInvalidPassword
+	}
+	if err := ValidatePassword(newPassword); err != nil {
+		return err
+	}
+	if err := uc.repo.UpdatePassword(ctx, userID, newPassword); err != nil {
+		return err
+	}
+	return uc.revokeSessions(ctx, userID, currentSessionID)
+}
+
+// RequestPasswordReset 申请重置验证码并通过 Notifier 发送
+// 用户不存在或申请过于频繁时静默成功，避免被用来探测用户名
+func (uc *AuthUsecase) RequestPasswordReset(ctx context.Context, username string) error {
+	u, err := uc.repo.GetByUsername(ctx, strings.TrimSpace(username))
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+	now := time.Now()
+	if last, err := uc.resets.GetActive(ctx, u.Id, now); err == nil && now.Sub(last.CreatedAt) < resetCodeResendAfter {
+		return nil
+	}
+	code, err := newNumericCode(6)
+	if err != nil {
+		return err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	ttl := uc.resetCodeTTL()
+	if _, err := uc.resets.Create(ctx, &PasswordReset{
+		UserID:    u.Id,
+		CodeHash:  string(hash),
+		ExpiresAt: now.Add(ttl),
+	}); err != nil {
+		return err
+	}
+	return uc.notifier.Send(ctx, &Notification{
+		UserID:   u.Id,
+		Username: u.Username,
+		Subject:  "密码重置验证码",
+		Content:  fmt.Sprintf("您的密码重置验证码为 %s，%d 分钟内有效。如非本人操作请忽略。", code, int(ttl.Minutes())),
+	})
+}
+
+// ResetPassword 使用验证码重置密码，成功后吊销该用户全部会话
+func (uc *AuthUsecase) ResetPassword(ctx context.Context, username, code, newPassword string) error {
+	if err := ValidatePassword(newPassword); err != nil {
+		return err
+	}
+	u, err := uc.repo.GetByUsername(ctx, strings.TrimSpace(username))
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return ErrInvalidResetCode
+		}
+		return err
+	}
+	r, err := uc.resets.GetActive(ctx, u.Id, time.Now())
+	if err != nil {
+		return err
+	}
+	// 先原子地占用一次尝试再比对，并发请求无法绕过次数上限
+	if err := uc.resets.TakeAttempt(ctx, r.ID, resetCodeMaxAttempts); err != nil {
+		return err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(r.CodeHash), []byte(code)) != nil {
+		return ErrInvalidResetCode
+	}
+	if err := uc.resets.Consume(ctx, r.ID); err != nil {
+		return err
+	}
+	if err := uc.repo.UpdatePassword(ctx, u.Id, newPassword); err != nil {
+		return err
+	}
+	return uc.revokeSessions(ctx, u.Id, 0)
+}
+
+func (uc *AuthUsecase) resetCodeTTL() time.Duration {
+	if uc.cfg != nil && uc.cfg.ResetCodeTtl != nil {
+		return uc.cfg.ResetCodeTtl.AsDuration()
+	}
+	return 15 * time.Minute
+}
+
+// newNumericCode 生成 n 位数字验证码
+func newNumericCode(n int) (string, error) {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		d, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(byte('0' + d.Int64()))
+	}
+	return b.String(), nil
+}
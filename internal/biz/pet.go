@@ -0,0 +1,289 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// MaxPetsPerUser 每个用户最多可添加的宠物数
+const MaxPetsPerUser = 10
+
+// DefaultPetModelURL 未选择模型时的默认模型
+const DefaultPetModelURL = "/models/Dog_1.glb"
+
+// ErrPetLimitReached 宠物数量已达上限
+var ErrPetLimitReached = errors.BadRequest("PET_LIMIT_REACHED", fmt.Sprintf("at most %d pets per user", MaxPetsPerUser))
+
+// ErrInvalidPet 宠物字段校验失败（HTTP 400）
+func ErrInvalidPet(format string, args ...interface{}) error {
+	return errors.BadRequest("INVALID_PET", fmt.Sprintf(format, args...))
+}
+
+// Pet 业务实体（对应表 pets），一个用户可以有多只宠物，其中一只为当前宠物（users.active_pet_id）
+type Pet struct {
+	ID        int64     // 宠物ID
+	UserID    int64     // 主人
+	Name      string    // 名称
+	Avatar    string    // 头像URL
+	Sex       int32     // 性别 0未知/1男/2女
+	Kind      string    // 品类
+	Weight    int32     // 体重（kg）
+	Hobby     string    // 爱好
+	ModelID   int64     // 模型ID（0 表示未选择）
+	ModelURL  string    // 模型URL（与 pet_models.path 一致）
+	CreatedAt time.Time // 创建时间
+	UpdatedAt time.Time // 更新时间
+
+	Active bool // 是否为当前宠物（仅查询时填充）
+}
+
+// PetRepo 宠物仓储
+// List: 用户的全部宠物（按创建顺序）
+// Get: 用户的某只宠物，不存在或不属于该用户返回 ErrPetNotFound
+// Active: 当前宠物，尚无宠物时返回 nil
+// Create: 写入宠物并回填 ID
+// Update: 覆盖宠物资料（不修改主人）
+// Delete: 删除宠物及其聊天记录
+// SetActive: 设置当前宠物（petID 为 0 表示无当前宠物）
+type PetRepo interface {
+	List(ctx context.Context, userID int64) ([]*Pet, error)
+	Get(ctx context.Context, userID, petID int64) (*Pet, error)
+	Active(ctx context.Context, userID int64) (*Pet, error)
+	Create(ctx context.Context, p *Pet) error
+	Update(ctx context.Context, p *Pet) error
+	Delete(ctx context.Context, userID, petID int64) error
+	SetActive(ctx context.Context, userID, petID int64) error
+}
+
+// PetUsecase 多宠物管理：增删改查与切换当前宠物
+// 当前宠物决定用户资料中的宠物字段、聊天记录与 AI 人设
+type PetUsecase struct {
+	repo   PetRepo
+	models AvatarRepo
+	levels *PetLevelUsecase
+	tx     Transaction
+}
+
+func NewPetUsecase(repo PetRepo, models AvatarRepo, levels *PetLevelUsecase, tx Transaction) *PetUsecase {
+	return &PetUsecase{repo: repo, models: models, levels: levels, tx: tx}
+}
+
+// List 用户的全部宠物，并标记当前宠物
+func (uc *PetUsecase) List(ctx context.Context, userID int64) ([]*Pet, error) {
+	list, err := uc.repo.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	active, err := uc.repo.Active(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range list {
+		p.Active = active != nil && p.ID == active.ID
+	}
+	return list, nil
+}
+
+// Active 当前宠物，尚无宠物时返回 nil
+func (uc *PetUsecase) Active(ctx context.Context, userID int64) (*Pet, error) {
+	p, err := uc.repo.Active(ctx, userID)
+	if p != nil {
+		p.Active = true
+	}
+	return p, err
+}
+
+// Resolve 指定宠物（须属于该用户），petID 为 0 时取当前宠物（可能为 nil）
+func (uc *PetUsecase) Resolve(ctx context.Context, userID, petID int64) (*Pet, error) {
+	if petID == 0 {
+		return uc.Active(ctx, userID)
+	}
+	return uc.repo.Get(ctx, userID, petID)
+}
+
+// requirePet 指定宠物（须属于该用户），petID 为 0 时取当前宠物；尚无宠物返回 ErrPetNotFound
+func requirePet(ctx context.Context, repo PetRepo, userID, petID int64) (*Pet, error) {
+	if petID != 0 {
+		return repo.Get(ctx, userID, petID)
+	}
+	p, err := repo.Active(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, ErrPetNotFound
+	}
+	return p, nil
+}
+
+// Create 添加宠物；第一只宠物或 activate=true 时设为当前宠物
+func (uc *PetUsecase) Create(ctx context.Context, p *Pet, activate bool) (*Pet, error) {
+	if strings.TrimSpace(p.Name) == "" {
+		return nil, ErrInvalidPet("name is required")
+	}
+	if err := validatePet(p); err != nil {
+		return nil, err
+	}
+	if err := uc.resolveModel(ctx, p); err != nil {
+		return nil, err
+	}
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		list, err := uc.repo.List(ctx, p.UserID)
+		if err != nil {
+			return err
+		}
+		if len(list) >= MaxPetsPerUser {
+			return ErrPetLimitReached
+		}
+		now := time.Now()
+		p.CreatedAt, p.UpdatedAt = now, now
+		if err := uc.repo.Create(ctx, p); err != nil {
+			return err
+		}
+		if len(list) == 0 || activate {
+			p.Active = true
+			return uc.repo.SetActive(ctx, p.UserID, p.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Update 修改宠物资料（零值字段不修改）
+func (uc *PetUsecase) Update(ctx context.Context, in *Pet) (*Pet, error) {
+	p, err := uc.repo.Get(ctx, in.UserID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+	if in.Name != "" {
+		p.Name = in.Name
+	}
+	if in.Avatar != "" {
+		p.Avatar = in.Avatar
+	}
+	if in.Sex != 0 {
+		p.Sex = in.Sex
+	}
+	if in.Kind != "" {
+		p.Kind = in.Kind
+	}
+	if in.Weight != 0 {
+		p.Weight = in.Weight
+	}
+	if in.Hobby != "" {
+		p.Hobby = in.Hobby
+	}
+	if in.ModelID != 0 && in.ModelID != p.ModelID {
+		p.ModelID = in.ModelID
+		if err := uc.resolveModel(ctx, p); err != nil {
+			return nil, err
+		}
+	}
+	if err := validatePet(p); err != nil {
+		return nil, err
+	}
+	p.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, p); err != nil {
+		return nil, err
+	}
+	active, err := uc.repo.Active(ctx, p.UserID)
+	if err != nil {
+		return nil, err
+	}
+	p.Active = active != nil && active.ID == p.ID
+	return p, nil
+}
+
+// Delete 删除宠物及其聊天记录；删除的是当前宠物时切换到最早添加的另一只，返回新的当前宠物ID（无宠物为 0）
+func (uc *PetUsecase) Delete(ctx context.Context, userID, petID int64) (int64, error) {
+	var activeID int64
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.repo.Get(ctx, userID, petID); err != nil {
+			return err
+		}
+		active, err := uc.repo.Active(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if err := uc.repo.Delete(ctx, userID, petID); err != nil {
+			return err
+		}
+		if active != nil && active.ID != petID {
+			activeID = active.ID
+			return nil
+		}
+		list, err := uc.repo.List(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if len(list) > 0 {
+			activeID = list[0].ID
+		}
+		return uc.repo.SetActive(ctx, userID, activeID)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return activeID, nil
+}
+
+// SetActive 切换当前宠物
+func (uc *PetUsecase) SetActive(ctx context.Context, userID, petID int64) (*Pet, error) {
+	p, err := uc.repo.Get(ctx, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.SetActive(ctx, userID, petID); err != nil {
+		return nil, err
+	}
+	p.Active = true
+	return p, nil
+}
+
+// resolveModel 校验所选模型已对用户解锁并填充 ModelURL；未选择模型时使用默认模型
+func (uc *PetUsecase) resolveModel(ctx context.Context, p *Pet) error {
+	if p.ModelID == 0 {
+		if p.ModelURL == "" {
+			p.ModelURL = DefaultPetModelURL
+		}
+		return nil
+	}
+	m, err := uc.models.GetPetModel(ctx, p.ModelID)
+	if err != nil {
+		return err
+	}
+	if err := uc.levels.MarkLocked(ctx, p.UserID, m); err != nil {
+		return err
+	}
+	if m.Locked {
+		return ErrAvatarLocked
+	}
+	p.ModelURL = m.Path
+	return nil
+}
+
+func validatePet(p *Pet) error {
+	switch {
+	case utf8.RuneCountInString(p.Name) > 50:
+		return ErrInvalidPet("name must be at most 50 characters")
+	case p.Sex < 0 || p.Sex > 2:
+		return ErrInvalidPet("sex must be 0, 1 or 2")
+	case utf8.RuneCountInString(p.Kind) > 50:
+		return ErrInvalidPet("kind must be at most 50 characters")
+	case p.Weight < 0 || p.Weight > 1000:
+		return ErrInvalidPet("weight must be between 0 and 1000")
+	case utf8.RuneCountInString(p.Hobby) > 255:
+		return ErrInvalidPet("hobby must be at most 255 characters")
+	case len(p.Avatar) > 255:
+		return ErrInvalidPet("avatar must be at most 255 characters")
+	}
+	return nil
+}
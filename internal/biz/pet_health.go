@@ -0,0 +1,451 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrHealthRecordNotFound 健康记录不存在或不属于该用户
+	ErrHealthRecordNotFound = errors.NotFound("HEALTH_RECORD_NOT_FOUND", "health record not found")
+)
+
+// ErrInvalidHealthRecord 健康记录字段校验失败（HTTP 400）
+func ErrInvalidHealthRecord(format string, args ...interface{}) error {
+	return errors.BadRequest("INVALID_HEALTH_RECORD", fmt.Sprintf(format, args...))
+}
+
+// 预防护理类型
+const (
+	CareVaccination = "vaccination" // 疫苗
+	CareDeworming   = "deworming"   // 驱虫
+)
+
+const (
+	// healthDateLayout 健康记录日期格式（自然日，yyyy-MM-dd）
+	healthDateLayout = "2006-01-02"
+	// maxWeightKg 体重上限（kg）
+	maxWeightKg = 500
+	// maxVisitAttachments 单次就诊附件数上限
+	maxVisitAttachments = 9
+	// defaultTrendDays/maxTrendDays 体重趋势默认/最大天数
+	defaultTrendDays = 90
+	maxTrendDays     = 3650
+)
+
+// WeightEntry 一次体重记录（pet_weights 表）
+type WeightEntry struct {
+	ID         int64     // 记录ID
+	UserID     int64     // 用户ID
+	PetID      int64     // 宠物ID
+	WeightKg   float64   // 体重（kg，两位小数）
+	MeasuredOn string    // 测量日期 yyyy-MM-dd
+	Note       string    // 备注
+	CreatedAt  time.Time // 创建时间
+}
+
+// WeightPoint 体重趋势中的一个点（同一天多条记录取最后一条）
+type WeightPoint struct {
+	Date     string
+	WeightKg float64
+}
+
+// WeightTrend 体重趋势
+type WeightTrend struct {
+	From, To string         // 统计区间 [From, To]
+	Points   []*WeightPoint // 按日期升序
+	Latest   float64        // 区间内最新体重（无记录为 0）
+	Min, Max float64        // 区间内最小/最大体重
+	Change   float64        // 区间内首尾变化（最新 - 最早）
+}
+
+// CareRecord 疫苗/驱虫记录（pet_care_records 表）
+type CareRecord struct {
+	ID        int64     // 记录ID
+	UserID    int64     // 用户ID
+	PetID     int64     // 宠物ID
+	Type      string    // vaccination/deworming
+	Name      string    // 疫苗/药品名称
+	GivenOn   string    // 接种/驱虫日期 yyyy-MM-dd
+	NextDueOn string    // 下次到期日期 yyyy-MM-dd（为空表示无需复种）
+	Clinic    string    // 医院/机构
+	Note      string    // 备注
+	CreatedAt time.Time // 创建时间
+	UpdatedAt time.Time // 更新时间
+}
+
+// VetVisit 就诊记录（pet_vet_visits 表）
+type VetVisit struct {
+	ID          int64     // 记录ID
+	UserID      int64     // 用户ID
+	PetID       int64     // 宠物ID
+	VisitedOn   string    // 就诊日期 yyyy-MM-dd
+	Clinic      string    // 医院
+	Reason      string    // 就诊原因
+	Diagnosis   string    // 诊断
+	Notes       string    // 医嘱/备注
+	Attachments []string  // 附件 URL（病历、化验单等，经 UploadService 上传）
+	CreatedAt   time.Time // 创建时间
+	UpdatedAt   time.Time // 更新时间
+}
+
+// PetHealthRepo 宠物健康记录仓储（记录均按 user_id 校验归属）
+// ListWeights: 日期在 [from, to] 内的体重记录（按日期、ID 升序；from/to 为空表示不限）
+// LatestWeight: 最新一条体重记录（按日期、ID），没有返回 nil, nil
+// SetPetWeight: 同步宠物档案上的整数体重（pets.weight）
+// ListCare: 按接种日期倒序，typ 为空返回全部类型
+// ListVisits: 按就诊日期倒序
+// Get*/Update*/Delete*: 记录不存在或不属于该用户返回 ErrHealthRecordNotFound
+type PetHealthRepo interface {
+	CreateWeight(ctx context.Context, w *WeightEntry) error
+	GetWeight(ctx context.Context, userID, id int64) (*WeightEntry, error)
+	ListWeights(ctx context.Context, userID, petID int64, from, to string) ([]*WeightEntry, error)
+	LatestWeight(ctx context.Context, userID, petID int64) (*WeightEntry, error)
+	DeleteWeight(ctx context.Context, userID, id int64) error
+	SetPetWeight(ctx context.Context, petID int64, kg int32) error
+
+	CreateCare(ctx context.Context, c *CareRecord) error
+	GetCare(ctx context.Context, userID, id int64) (*CareRecord, error)
+	ListCare(ctx context.Context, userID, petID int64, typ string) ([]*CareRecord, error)
+	UpdateCare(ctx context.Context, c *CareRecord) error
+	DeleteCare(ctx context.Context, userID, id int64) error
+
+	CreateVisit(ctx context.Context, v *VetVisit) error
+	GetVisit(ctx context.Context, userID, id int64) (*VetVisit, error)
+	ListVisits(ctx context.Context, userID, petID int64) ([]*VetVisit, error)
+	UpdateVisit(ctx context.Context, v *VetVisit) error
+	DeleteVisit(ctx context.Context, userID, id int64) error
+}
+
+// PetHealthUsecase 宠物健康档案：体重记录与趋势、疫苗/驱虫记录、就诊记录
+// petID 为 0 时使用当前宠物
+type PetHealthUsecase struct {
+	repo    PetHealthRepo
+	pets    *PetUsecase
+	uploads UploadStore
+	tx      Transaction
+}
+
+func NewPetHealthUsecase(repo PetHealthRepo, pets *PetUsecase, uploads UploadStore, tx Transaction) *PetHealthUsecase {
+	return &PetHealthUsecase{repo: repo, pets: pets, uploads: uploads, tx: tx}
+}
+
+// pet 解析宠物（须属于该用户），尚无宠物时返回 ErrPetNotFound
+func (uc *PetHealthUsecase) pet(ctx context.Context, userID, petID int64) (*Pet, error) {
+	p, err := uc.pets.Resolve(ctx, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, ErrPetNotFound
+	}
+	return p, nil
+}
+
+// AddWeight 记录一次体重；若为最新记录，同步宠物档案体重（取整）
+func (uc *PetHealthUsecase) AddWeight(ctx context.Context, w *WeightEntry) (*WeightEntry, error) {
+	p, err := uc.pet(ctx, w.UserID, w.PetID)
+	if err != nil {
+		return nil, err
+	}
+	w.PetID = p.ID
+	if w.WeightKg <= 0 || w.WeightKg > maxWeightKg {
+		return nil, ErrInvalidHealthRecord("weight_kg must be greater than 0 and at most %d", maxWeightKg)
+	}
+	w.WeightKg = math.Round(w.WeightKg*100) / 100
+	if w.MeasuredOn == "" {
+		w.MeasuredOn = time.Now().Format(healthDateLayout)
+	}
+	if err := checkDate("measured_on", w.MeasuredOn, false); err != nil {
+		return nil, err
+	}
+	if err := checkLen("note", w.Note, 255); err != nil {
+		return nil, err
+	}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.CreateWeight(ctx, w); err != nil {
+			return err
+		}
+		return uc.syncPetWeight(ctx, w.UserID, w.PetID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// ListWeights 体重记录（日期在 [from, to] 内，为空表示不限）
+func (uc *PetHealthUsecase) ListWeights(ctx context.Context, userID, petID int64, from, to string) ([]*WeightEntry, error) {
+	p, err := uc.pet(ctx, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range []struct{ field, v string }{{"from", from}, {"to", to}} {
+		if d.v != "" {
+			if err := checkDate(d.field, d.v, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return uc.repo.ListWeights(ctx, userID, p.ID, from, to)
+}
+
+// DeleteWeight 删除体重记录，并按剩余的最新记录同步宠物档案体重
+func (uc *PetHealthUsecase) DeleteWeight(ctx context.Context, userID, id int64) error {
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		w, err := uc.repo.GetWeight(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.repo.DeleteWeight(ctx, userID, id); err != nil {
+			return err
+		}
+		return uc.syncPetWeight(ctx, userID, w.PetID)
+	})
+}
+
+// syncPetWeight 宠物档案体重 = 最新体重记录（取整）；没有记录时保持不变
+func (uc *PetHealthUsecase) syncPetWeight(ctx context.Context, userID, petID int64) error {
+	latest, err := uc.repo.LatestWeight(ctx, userID, petID)
+	if err != nil || latest == nil {
+		return err
+	}
+	return uc.repo.SetPetWeight(ctx, petID, int32(math.Round(latest.WeightKg)))
+}
+
+// WeightTrend 最近 days 天（含今天，默认 90 天）的体重序列，同一天多条记录取最后一条
+func (uc *PetHealthUsecase) WeightTrend(ctx context.Context, userID, petID int64, days int32) (*WeightTrend, error) {
+	p, err := uc.pet(ctx, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case days <= 0:
+		days = defaultTrendDays
+	case days > maxTrendDays:
+		days = maxTrendDays
+	}
+	today := time.Now()
+	t := &WeightTrend{
+		From:   today.AddDate(0, 0, -int(days-1)).Format(healthDateLayout),
+		To:     today.Format(healthDateLayout),
+		Points: []*WeightPoint{},
+	}
+	list, err := uc.repo.ListWeights(ctx, userID, p.ID, t.From, t.To)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range list {
+		if n := len(t.Points); n > 0 && t.Points[n-1].Date == w.MeasuredOn {
+			t.Points[n-1].WeightKg = w.WeightKg
+			continue
+		}
+		t.Points = append(t.Points, &WeightPoint{Date: w.MeasuredOn, WeightKg: w.WeightKg})
+	}
+	if len(t.Points) == 0 {
+		return t, nil
+	}
+	t.Min, t.Max = t.Points[0].WeightKg, t.Points[0].WeightKg
+	for _, pt := range t.Points {
+		t.Min = math.Min(t.Min, pt.WeightKg)
+		t.Max = math.Max(t.Max, pt.WeightKg)
+	}
+	t.Latest = t.Points[len(t.Points)-1].WeightKg
+	t.Change = math.Round((t.Latest-t.Points[0].WeightKg)*100) / 100
+	return t, nil
+}
+
+// CreateCare 添加疫苗/驱虫记录
+func (uc *PetHealthUsecase) CreateCare(ctx context.Context, c *CareRecord) (*CareRecord, error) {
+	p, err := uc.pet(ctx, c.UserID, c.PetID)
+	if err != nil {
+		return nil, err
+	}
+	c.PetID = p.ID
+	if err := validateCare(c); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	c.CreatedAt, c.UpdatedAt = now, now
+	if err := uc.repo.CreateCare(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ListCare 疫苗/驱虫记录，typ 为空返回全部
+func (uc *PetHealthUsecase) ListCare(ctx context.Context, userID, petID int64, typ string) ([]*CareRecord, error) {
+	if typ != "" && typ != CareVaccination && typ != CareDeworming {
+		return nil, ErrInvalidHealthRecord("type must be %s or %s", CareVaccination, CareDeworming)
+	}
+	p, err := uc.pet(ctx, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.ListCare(ctx, userID, p.ID, typ)
+}
+
+// UpdateCare 修改疫苗/驱虫记录（整条覆盖，宠物与类型不可修改）
+func (uc *PetHealthUsecase) UpdateCare(ctx context.Context, in *CareRecord) (*CareRecord, error) {
+	c, err := uc.repo.GetCare(ctx, in.UserID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+	c.Name, c.GivenOn, c.NextDueOn, c.Clinic, c.Note = in.Name, in.GivenOn, in.NextDueOn, in.Clinic, in.Note
+	if err := validateCare(c); err != nil {
+		return nil, err
+	}
+	c.UpdatedAt = time.Now()
+	if err := uc.repo.UpdateCare(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DeleteCare 删除疫苗/驱虫记录
+func (uc *PetHealthUsecase) DeleteCare(ctx context.Context, userID, id int64) error {
+	return uc.repo.DeleteCare(ctx, userID, id)
+}
+
+// CreateVisit 添加就诊记录
+func (uc *PetHealthUsecase) CreateVisit(ctx context.Context, v *VetVisit) (*VetVisit, error) {
+	p, err := uc.pet(ctx, v.UserID, v.PetID)
+	if err != nil {
+		return nil, err
+	}
+	v.PetID = p.ID
+	if err := uc.validateVisit(ctx, v); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	v.CreatedAt, v.UpdatedAt = now, now
+	if err := uc.repo.CreateVisit(ctx, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListVisits 就诊记录
+func (uc *PetHealthUsecase) ListVisits(ctx context.Context, userID, petID int64) ([]*VetVisit, error) {
+	p, err := uc.pet(ctx, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.ListVisits(ctx, userID, p.ID)
+}
+
+// UpdateVisit 修改就诊记录（整条覆盖，宠物不可修改）
+func (uc *PetHealthUsecase) UpdateVisit(ctx context.Context, in *VetVisit) (*VetVisit, error) {
+	v, err := uc.repo.GetVisit(ctx, in.UserID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+	v.VisitedOn, v.Clinic, v.Reason, v.Diagnosis, v.Notes, v.Attachments = in.VisitedOn, in.Clinic, in.Reason, in.Diagnosis, in.Notes, in.Attachments
+	if err := uc.validateVisit(ctx, v); err != nil {
+		return nil, err
+	}
+	v.UpdatedAt = time.Now()
+	if err := uc.repo.UpdateVisit(ctx, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// DeleteVisit 删除就诊记录（附件文件保留在上传目录）
+func (uc *PetHealthUsecase) DeleteVisit(ctx context.Context, userID, id int64) error {
+	return uc.repo.DeleteVisit(ctx, userID, id)
+}
+
+func validateCare(c *CareRecord) error {
+	if c.Type != CareVaccination && c.Type != CareDeworming {
+		return ErrInvalidHealthRecord("type must be %s or %s", CareVaccination, CareDeworming)
+	}
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" {
+		return ErrInvalidHealthRecord("name is required")
+	}
+	if err := checkDate("given_on", c.GivenOn, false); err != nil {
+		return err
+	}
+	if c.NextDueOn != "" {
+		if err := checkDate("next_due_on", c.NextDueOn, true); err != nil {
+			return err
+		}
+		// yyyy-MM-dd 可按字符串比较
+		if c.NextDueOn < c.GivenOn {
+			return ErrInvalidHealthRecord("next_due_on must not be before given_on")
+		}
+	}
+	for _, f := range []struct {
+		field, v string
+		max      int
+	}{{"name", c.Name, 100}, {"clinic", c.Clinic, 100}, {"note", c.Note, 500}} {
+		if err := checkLen(f.field, f.v, f.max); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateVisit 校验字段；附件必须是 UploadService 上传到本地存储的文件
+func (uc *PetHealthUsecase) validateVisit(ctx context.Context, v *VetVisit) error {
+	if err := checkDate("visited_on", v.VisitedOn, false); err != nil {
+		return err
+	}
+	for _, f := range []struct {
+		field, v string
+		max      int
+	}{{"clinic", v.Clinic, 100}, {"reason", v.Reason, 255}, {"diagnosis", v.Diagnosis, 2000}, {"notes", v.Notes, 2000}} {
+		if err := checkLen(f.field, f.v, f.max); err != nil {
+			return err
+		}
+	}
+	if len(v.Attachments) > maxVisitAttachments {
+		return ErrInvalidHealthRecord("at most %d attachments", maxVisitAttachments)
+	}
+	seen := map[string]bool{}
+	list := make([]string, 0, len(v.Attachments))
+	for _, url := range v.Attachments {
+		url = strings.TrimSpace(url)
+		if url == "" || seen[url] {
+			continue
+		}
+		if len(url) > 255 || strings.Contains(url, ",") {
+			return ErrInvalidHealthRecord("invalid attachment url %q", url)
+		}
+		_, rc, err := uc.uploads.Open(ctx, url)
+		if err != nil {
+			return ErrInvalidHealthRecord("attachment %q is not an uploaded file", url)
+		}
+		_ = rc.Close()
+		seen[url] = true
+		list = append(list, url)
+	}
+	v.Attachments = list
+	return nil
+}
+
+// checkDate 校验 yyyy-MM-dd 日期；future=false 时不得晚于明天（容忍客户端时区差）
+func checkDate(field, v string, future bool) error {
+	d, err := time.Parse(healthDateLayout, v)
+	if err != nil {
+		return ErrInvalidHealthRecord("%s must be a date in YYYY-MM-DD format", field)
+	}
+	if !future && d.After(time.Now().AddDate(0, 0, 1)) {
+		return ErrInvalidHealthRecord("%s must not be in the future", field)
+	}
+	return nil
+}
+
+func checkLen(field, v string, max int) error {
+	if utf8.RuneCountInString(v) > max {
+		return ErrInvalidHealthRecord("%s must be at most %d characters", field, max)
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pet-angel/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrModelAlreadyUnlocked 模型已解锁过（已有解锁记录或唯一约束冲突）
+var ErrModelAlreadyUnlocked = errors.Conflict("MODEL_ALREADY_UNLOCKED", "pet model already unlocked")
+
+// MaxPetLevel 宠物最高等级
+const MaxPetLevel = 50
+
+// PetXPForLevel 达到 level 所需的累计经验：50×L×(L-1)，即 Lv2=100、Lv3=300、Lv4=600、Lv5=1000……
+func PetXPForLevel(level int32) int64 {
+	if level <= 1 {
+		return 0
+	}
+	l := int64(level)
+	return 50 * l * (l - 1)
+}
+
+// PetLevelForXP 累计经验对应的等级（1 ~ MaxPetLevel）
+func PetLevelForXP(xp int64) int32 {
+	level := int32(1)
+	for level < MaxPetLevel && xp >= PetXPForLevel(level+1) {
+		level++
+	}
+	return level
+}
+
+// PetLevel 宠物等级与经验进度
+type PetLevel struct {
+	Level       int32 // 当前等级
+	XP          int64 // 累计经验
+	LevelXP     int64 // 当前等级起点的累计经验
+	NextLevelXP int64 // 升到下一级所需的累计经验（满级为 0）
+}
+
+// NewPetLevel 按累计经验计算等级进度
+func NewPetLevel(xp int64) *PetLevel {
+	l := &PetLevel{Level: PetLevelForXP(xp), XP: xp}
+	l.LevelXP = PetXPForLevel(l.Level)
+	if l.Level < MaxPetLevel {
+		l.NextLevelXP = PetXPForLevel(l.Level + 1)
+	}
+	return l
+}
+
+// PetXPLog 一次经验获取（pet_xp_logs 表），用于每日上限
+type PetXPLog struct {
+	ID        int64     // 记录ID
+	UserID    int64     // 用户ID
+	PetID     int64     // 获得经验的宠物
+	Source    string    // 来源（事件类型）
+	XP        int32     // 获得经验
+	XPDate    string    // 日期（规则时区，yyyy-MM-dd）
+	CreatedAt time.Time // 获得时间
+}
+
+// ModelUnlock 用金币解锁的模型（user_pet_models 表）
+type ModelUnlock struct {
+	UserID    int64     // 用户ID
+	ModelID   int64     // 模型ID
+	Coins     int32     // 花费金币
+	CreatedAt time.Time // 解锁时间
+}
+
+// PetLevelRepo 宠物经验仓储（累计经验按宠物，每日上限与模型解锁按用户）
+// GetXP: 宠物的累计经验，无记录返回 0
+// MaxXP: 用户名下宠物的最高累计经验，无记录返回 0
+// LockXP: 在事务内加锁读取宠物的累计经验（无记录时先创建），同一宠物的经验发放在此串行化
+// SumOnDate: 用户某来源在某天获得的经验合计（不区分宠物，避免多养宠物刷经验）
+// AddXP: 写入经验记录并累加到宠物的累计经验（须先 LockXP）
+// UnlockedModels: 用户已用金币解锁的模型ID
+// CreateModelUnlock: 写入解锁记录；重复解锁返回 ErrModelAlreadyUnlocked
+type PetLevelRepo interface {
+	GetXP(ctx context.Context, petID int64) (int64, error)
+	MaxXP(ctx context.Context, userID int64) (int64, error)
+	LockXP(ctx context.Context, userID, petID int64) (int64, error)
+	SumOnDate(ctx context.Context, userID int64, source, date string) (int32, error)
+	AddXP(ctx context.Context, l *PetXPLog) error
+	UnlockedModels(ctx context.Context, userID int64) ([]int64, error)
+	CreateModelUnlock(ctx context.Context, u *ModelUnlock) error
+}
+
+// petXPRule 生效的经验规则
+type petXPRule struct {
+	xp       int32
+	dailyCap int32
+}
+
+// PetLevelUsecase 宠物等级：订阅聊天、使用道具与社区事件为相关宠物（默认为发起者的当前宠物）累积经验，并判断模型是否解锁
+type PetLevelUsecase struct {
+	repo   PetLevelRepo
+	pets   PetRepo
+	wallet *WalletUsecase
+	tx     Transaction
+	log    *log.Helper
+
+	rules map[string]petXPRule
+	loc   *time.Location
+}
+
+func NewPetLevelUsecase(repo PetLevelRepo, pets PetRepo, wallet *WalletUsecase, tx Transaction, cfg *conf.Rewards, logger log.Logger) *PetLevelUsecase {
+	uc := &PetLevelUsecase{
+		repo:   repo,
+		pets:   pets,
+		wallet: wallet,
+		tx:     tx,
+		log:    log.NewHelper(logger),
+		rules: map[string]petXPRule{
+			EventPetChatted:     {xp: 2, dailyCap: 30},
+			EventItemUsed:       {xp: 5, dailyCap: 50},
+			EventPostCreated:    {xp: 10, dailyCap: 30},
+			EventCommentCreated: {xp: 3, dailyCap: 15},
+			EventPostLiked:      {xp: 1, dailyCap: 10},
+		},
+	}
+	c := cfg.GetPetXp()
+	if len(c.GetRules()) > 0 {
+		uc.rules = make(map[string]petXPRule, len(c.GetRules()))
+		for _, r := range c.GetRules() {
+			uc.rules[r.GetEvent()] = petXPRule{xp: r.GetXp(), dailyCap: r.GetDailyCap()}
+		}
+	}
+	name := c.GetTimezone()
+	if name == "" {
+		name = "Asia/Shanghai"
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		uc.log.Warnf("pet xp: invalid timezone %q, falling back to UTC: %v", name, err)
+		loc = time.UTC
+	}
+	uc.loc = loc
+	return uc
+}
+
+// Handle 处理一条事件：按规则为事件中的宠物（未指定时为发起者的当前宠物）累积经验，超出当日上限的部分不再发放；尚无宠物时忽略
+func (uc *PetLevelUsecase) Handle(ctx context.Context, e *ActivityEvent) error {
+	rule, ok := uc.rules[e.Type]
+	if !ok || rule.xp <= 0 || e.UserID == 0 {
+		return nil
+	}
+	p, err := requirePet(ctx, uc.pets, e.UserID, e.PetID)
+	if errors.Is(err, ErrPetNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	l := &PetXPLog{UserID: e.UserID, PetID: p.ID, Source: e.Type, XP: rule.xp, XPDate: e.At.In(uc.loc).Format(checkInDateLayout), CreatedAt: e.At}
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.repo.LockXP(ctx, l.UserID, l.PetID); err != nil {
+			return err
+		}
+		if rule.dailyCap > 0 {
+			got, err := uc.repo.SumOnDate(ctx, l.UserID, l.Source, l.XPDate)
+			if err != nil {
+				return err
+			}
+			if left := rule.dailyCap - got; left < l.XP {
+				l.XP = left
+			}
+			if l.XP <= 0 {
+				return nil
+			}
+		}
+		return uc.repo.AddXP(ctx, l)
+	})
+}
+
+// Get 宠物等级（petID 为 0 时取当前宠物），尚无宠物返回 ErrPetNotFound
+func (uc *PetLevelUsecase) Get(ctx context.Context, userID, petID int64) (*PetLevel, error) {
+	p, err := requirePet(ctx, uc.pets, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+	xp, err := uc.repo.GetXP(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	return NewPetLevel(xp), nil
+}
+
+// userLevel 用户名下宠物的最高等级，用于模型的等级解锁（模型解锁属于用户，新添加的宠物也可使用）
+func (uc *PetLevelUsecase) userLevel(ctx context.Context, userID int64) (*PetLevel, error) {
+	xp, err := uc.repo.MaxXP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return NewPetLevel(xp), nil
+}
+
+// MarkLocked 按用户宠物的最高等级与已解锁记录填充模型的 Locked；userID 为 0（未登录）时按 1 级且无解锁记录计算
+func (uc *PetLevelUsecase) MarkLocked(ctx context.Context, userID int64, models ...*PetModel) error {
+	level := int32(1)
+	unlocked := map[int64]bool{}
+	if userID > 0 {
+		l, err := uc.userLevel(ctx, userID)
+		if err != nil {
+			return err
+		}
+		level = l.Level
+		ids, err := uc.repo.UnlockedModels(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			unlocked[id] = true
+		}
+	}
+	for _, m := range models {
+		m.Locked = level < m.UnlockLevel || (m.UnlockCoins > 0 && !unlocked[m.ID])
+	}
+	return nil
+}
+
+// UnlockModel 用金币解锁模型，返回解锁后的金币余额
+// 未达到等级要求返回 ErrAvatarLocked；无需金币或已解锁过时不扣费
+func (uc *PetLevelUsecase) UnlockModel(ctx context.Context, userID int64, m *PetModel) (int32, error) {
+	l, err := uc.userLevel(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if l.Level < m.UnlockLevel {
+		return 0, ErrAvatarLocked
+	}
+	if m.UnlockCoins <= 0 {
+		return uc.wallet.Balance(ctx, userID)
+	}
+	var coins int32
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		// 锁定用户行后再检查是否已解锁，并发的解锁请求在此串行化，已解锁时不会扣费
+		if err := uc.wallet.Lock(ctx, userID); err != nil {
+			return err
+		}
+		unlocked, err := uc.unlocked(ctx, userID, m.ID)
+		if err != nil {
+			return err
+		}
+		if unlocked {
+			return ErrModelAlreadyUnlocked
+		}
+		t, err := uc.wallet.Debit(ctx, userID, m.UnlockCoins, CoinReasonModelUnlock, CoinRef{Type: CoinRefPetModel, ID: m.ID})
+		if err != nil {
+			return err
+		}
+		coins = t.Balance
+		return uc.repo.CreateModelUnlock(ctx, &ModelUnlock{UserID: userID, ModelID: m.ID, Coins: m.UnlockCoins, CreatedAt: time.Now()})
+	})
+	if errors.Is(err, ErrModelAlreadyUnlocked) {
+		return uc.wallet.Balance(ctx, userID)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return coins, nil
+}
+
+// unlocked 用户是否已用金币解锁过该模型
+func (uc *PetLevelUsecase) unlocked(ctx context.Context, userID, modelID int64) (bool, error) {
+	ids, err := uc.repo.UnlockedModels(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range ids {
+		if id == modelID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UnlockRequirement 模型解锁条件的展示文案，无条件时为空
+func (m *PetModel) UnlockRequirement() string {
+	switch {
+	case m.UnlockLevel > 1 && m.UnlockCoins > 0:
+		return fmt.Sprintf("Lv.%d 后花费 %d 金币解锁", m.UnlockLevel, m.UnlockCoins)
+	case m.UnlockLevel > 1:
+		return fmt.Sprintf("宠物达到 Lv.%d 解锁", m.UnlockLevel)
+	case m.UnlockCoins > 0:
+		return fmt.Sprintf("花费 %d 金币解锁", m.UnlockCoins)
+	}
+	return ""
+}
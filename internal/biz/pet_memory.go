@@ -0,0 +1,326 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrMemoryNotFound 记忆不存在或不属于该用户
+var ErrMemoryNotFound = errors.NotFound("MEMORY_NOT_FOUND", "memory not found")
+
+// 记忆类型
+const (
+	MemorySummary = "summary" // 一段较早聊天的摘要
+	MemoryFact    = "fact"    // 从聊天中提取的关于主人的事实
+)
+
+const (
+	// MemoryChunkMessages 每次整理的聊天条数：最近上下文窗口之外攒够这么多条才整理一次
+	MemoryChunkMessages = 20
+	// MaxMemoryFactsPerPet 每只宠物最多保留的事实数，超出时删除最早的
+	MaxMemoryFactsPerPet = 100
+	// MemoryTokenBudget 注入系统提示的记忆 token 预算
+	MemoryTokenBudget = 500
+	// memoryPromptSummaries 注入系统提示的最近摘要数
+	memoryPromptSummaries = 2
+	// memoryBatch 整理任务每轮处理的宠物数
+	memoryBatch = 20
+)
+
+// PetMemory 宠物的长期记忆（pet_memories 表）
+type PetMemory struct {
+	ID            int64     // 记忆ID
+	UserID        int64     // 用户ID
+	PetID         int64     // 宠物ID
+	Kind          string    // summary/fact
+	Content       string    // 内容
+	FromMessageID int64     // 来源聊天消息范围（起）
+	ToMessageID   int64     // 来源聊天消息范围（止）
+	CreatedAt     time.Time // 创建时间
+}
+
+// MemoryTarget 有待整理聊天的宠物
+type MemoryTarget struct {
+	UserID int64
+	PetID  int64
+}
+
+// MemoryDigest 一段聊天的整理结果
+type MemoryDigest struct {
+	Summary string   // 摘要
+	Facts   []string // 新的事实
+}
+
+// PetMemoryRepo 记忆仓储
+// List: petID 为 0 返回全部宠物，kind 为空返回全部类型（按 ID 倒序，即新的在前）
+// Delete: 不存在或不属于该用户返回 ErrMemoryNotFound
+// Cursor/SetCursor: 每只宠物已整理到的聊天消息ID（与记忆分开保存，删除记忆不会导致同一段聊天被重新整理）；
+// SetCursor 仅在游标仍为 prev 时推进，否则返回 false（已被其他实例整理）
+// Attempt: 记录最近一次整理尝试的时间（无论成败）
+// ListTargets: 游标之后的聊天消息（message_type=0）不少于 minMessages 条的宠物，最久未尝试的在前，最多 limit 个
+// ListMessagesAfter: 游标之后的聊天消息，按 ID 升序，最多 limit 条
+// PruneFacts: 只保留该宠物最新的 keep 条事实
+type PetMemoryRepo interface {
+	Create(ctx context.Context, mems []*PetMemory) error
+	List(ctx context.Context, userID, petID int64, kind string) ([]*PetMemory, error)
+	Delete(ctx context.Context, userID, id int64) error
+	Cursor(ctx context.Context, petID int64) (int64, error)
+	SetCursor(ctx context.Context, userID, petID, prev, messageID int64) (bool, error)
+	Attempt(ctx context.Context, userID, petID int64, at time.Time) error
+	ListTargets(ctx context.Context, minMessages, limit int) ([]*MemoryTarget, error)
+	ListMessagesAfter(ctx context.Context, userID, petID, afterID int64, limit int) ([]*ChatMsg, error)
+	PruneFacts(ctx context.Context, petID int64, keep int) error
+}
+
+// MemorySummarizer 调用 AI 将一段聊天整理为摘要与事实（known 为已记住的事实，避免重复提取）
+type MemorySummarizer interface {
+	Summarize(ctx context.Context, pet *Pet, known []string, msgs []*ChatMsg) (*MemoryDigest, error)
+}
+
+// PetMemoryUsecase 长期记忆：定期把最近上下文窗口之外的聊天整理为摘要和事实，聊天时挑选相关的记忆注入系统提示
+type PetMemoryUsecase struct {
+	repo       PetMemoryRepo
+	summarizer MemorySummarizer
+	pets       *PetUsecase
+	tx         Transaction
+	log        *log.Helper
+}
+
+func NewPetMemoryUsecase(repo PetMemoryRepo, summarizer MemorySummarizer, pets *PetUsecase, tx Transaction, logger log.Logger) *PetMemoryUsecase {
+	return &PetMemoryUsecase{repo: repo, summarizer: summarizer, pets: pets, tx: tx, log: log.NewHelper(logger)}
+}
+
+// List 记忆列表；petID 为 0 返回全部宠物
+func (uc *PetMemoryUsecase) List(ctx context.Context, userID, petID int64, kind string) ([]*PetMemory, error) {
+	if petID != 0 {
+		if _, err := uc.pets.Resolve(ctx, userID, petID); err != nil {
+			return nil, err
+		}
+	}
+	return uc.repo.List(ctx, userID, petID, kind)
+}
+
+// Delete 删除一条记忆（该段聊天不会再被整理出来）
+func (uc *PetMemoryUsecase) Delete(ctx context.Context, userID, id int64) error {
+	return uc.repo.Delete(ctx, userID, id)
+}
+
+// Prompt 与 query（本轮用户输入）相关的记忆，格式化为系统提示片段；尚无记忆时为空
+func (uc *PetMemoryUsecase) Prompt(ctx context.Context, userID int64, pet *Pet, query string) (string, error) {
+	if pet == nil {
+		return "", nil
+	}
+	list, err := uc.repo.List(ctx, userID, pet.ID, "")
+	if err != nil {
+		return "", err
+	}
+	return MemoryPrompt(list, query, MemoryTokenBudget), nil
+}
+
+// Consolidate 为每只攒够聊天的宠物整理一段聊天（最早的 MemoryChunkMessages 条），返回整理的宠物数；单只失败只记日志
+func (uc *PetMemoryUsecase) Consolidate(ctx context.Context) (int, error) {
+	targets, err := uc.repo.ListTargets(ctx, ChatHistoryTurns*2+MemoryChunkMessages, memoryBatch)
+	if err != nil {
+		return 0, err
+	}
+	done := 0
+	for _, t := range targets {
+		if err := uc.consolidate(ctx, t); err != nil {
+			uc.log.WithContext(ctx).Errorf("consolidate memories of pet %d failed: %v", t.PetID, err)
+			continue
+		}
+		done++
+	}
+	return done, nil
+}
+
+func (uc *PetMemoryUsecase) consolidate(ctx context.Context, t *MemoryTarget) error {
+	// 先记下尝试时间：整理失败的宠物排到队尾，不会一直占住每轮的名额
+	if err := uc.repo.Attempt(ctx, t.UserID, t.PetID, time.Now()); err != nil {
+		return err
+	}
+	pet, err := uc.pets.Resolve(ctx, t.UserID, t.PetID)
+	if err != nil {
+		return err
+	}
+	cursor, err := uc.repo.Cursor(ctx, t.PetID)
+	if err != nil {
+		return err
+	}
+	msgs, err := uc.repo.ListMessagesAfter(ctx, t.UserID, t.PetID, cursor, MemoryChunkMessages)
+	if err != nil || len(msgs) == 0 {
+		return err
+	}
+	facts, err := uc.repo.List(ctx, t.UserID, t.PetID, MemoryFact)
+	if err != nil {
+		return err
+	}
+	known := make([]string, 0, len(facts))
+	seen := make(map[string]bool, len(facts))
+	for _, f := range facts {
+		known = append(known, f.Content)
+		seen[f.Content] = true
+	}
+	d, err := uc.summarizer.Summarize(ctx, pet, known, msgs)
+	if err != nil {
+		return err
+	}
+	from, to := msgs[0].ID, msgs[len(msgs)-1].ID
+	mems := make([]*PetMemory, 0, len(d.Facts)+1)
+	if s := strings.TrimSpace(d.Summary); s != "" {
+		mems = append(mems, &PetMemory{UserID: t.UserID, PetID: t.PetID, Kind: MemorySummary, Content: s, FromMessageID: from, ToMessageID: to})
+	}
+	for _, f := range d.Facts {
+		f = strings.TrimSpace(f)
+		if f == "" || seen[f] {
+			continue
+		}
+		seen[f] = true
+		mems = append(mems, &PetMemory{UserID: t.UserID, PetID: t.PetID, Kind: MemoryFact, Content: f, FromMessageID: from, ToMessageID: to})
+	}
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		// 游标已被其他实例推进时放弃本次结果，避免同一段聊天写入重复的记忆
+		ok, err := uc.repo.SetCursor(ctx, t.UserID, t.PetID, cursor, to)
+		if err != nil || !ok {
+			return err
+		}
+		if len(mems) > 0 {
+			if err := uc.repo.Create(ctx, mems); err != nil {
+				return err
+			}
+		}
+		return uc.repo.PruneFacts(ctx, t.PetID, MaxMemoryFactsPerPet)
+	})
+}
+
+// MemoryPrompt 从记忆（按 ID 倒序）中挑选注入系统提示的内容：
+// 事实按与 query 的字词重合度排序（相同时新的优先），再加上最近的几段摘要，总量不超过 token 预算
+func MemoryPrompt(list []*PetMemory, query string, budget int) string {
+	var facts, summaries []*PetMemory
+	for _, m := range list {
+		switch m.Kind {
+		case MemoryFact:
+			facts = append(facts, m)
+		case MemorySummary:
+			if len(summaries) < memoryPromptSummaries {
+				summaries = append(summaries, m)
+			}
+		}
+	}
+	q := bigrams(query)
+	score := make(map[int64]int, len(facts))
+	for _, f := range facts {
+		for g := range bigrams(f.Content) {
+			if q[g] {
+				score[f.ID]++
+			}
+		}
+	}
+	sort.SliceStable(facts, func(i, j int) bool { return score[facts[i].ID] > score[facts[j].ID] })
+
+	used := 0
+	fit := func(s string) bool {
+		n := EstimateTokens(s)
+		if used+n > budget {
+			return false
+		}
+		used += n
+		return true
+	}
+	var keptSummaries, keptFacts []string
+	// 摘要从新到旧放入，输出时恢复时间顺序
+	for _, s := range summaries {
+		if fit(s.Content) {
+			keptSummaries = append([]string{s.Content}, keptSummaries...)
+		}
+	}
+	for _, f := range facts {
+		if fit(f.Content) {
+			keptFacts = append(keptFacts, f.Content)
+		}
+	}
+	var b strings.Builder
+	if len(keptFacts) > 0 {
+		b.WriteString("你记得关于主人的这些事：" + strings.Join(keptFacts, "；") + "。")
+	}
+	if len(keptSummaries) > 0 {
+		b.WriteString("你们之前聊过：" + strings.Join(keptSummaries, " ") + "。")
+	}
+	return b.String()
+}
+
+// bigrams 文本中相邻两个字组成的词（中文没有空格分词，按双字粗略匹配；ASCII 统一小写）
+func bigrams(s string) map[string]bool {
+	rs := []rune(strings.ToLower(s))
+	out := make(map[string]bool, len(rs))
+	for i := 0; i+1 < len(rs); i++ {
+		if isSpaceOrPunct(rs[i]) || isSpaceOrPunct(rs[i+1]) {
+			continue
+		}
+		out[string(rs[i:i+2])] = true
+	}
+	return out
+}
+
+func isSpaceOrPunct(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || strings.ContainsRune("，。！？、；：,.!?;:\"'“”‘’（）()~～", r)
+}
+
+// MemoryWorker 记忆整理任务：按固定周期把较早的聊天整理为长期记忆
+// 实现 kratos transport.Server，随应用启动/停止
+type MemoryWorker struct {
+	uc       *PetMemoryUsecase
+	interval time.Duration
+	log      *log.Helper
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func NewMemoryWorker(uc *PetMemoryUsecase, logger log.Logger) *MemoryWorker {
+	return &MemoryWorker{
+		uc:       uc,
+		interval: 5 * time.Minute,
+		log:      log.NewHelper(logger),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 启动整理循环（阻塞直到 Stop）
+func (w *MemoryWorker) Start(ctx context.Context) error {
+	defer close(w.done)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if n, err := w.uc.Consolidate(ctx); err != nil {
+				w.log.Errorf("consolidate memories failed: %v", err)
+			} else if n > 0 {
+				w.log.Infof("consolidated memories of %d pets", n)
+			}
+		case <-w.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop 停止整理循环，等待正在进行的一轮结束
+func (w *MemoryWorker) Stop(ctx context.Context) error {
+	w.stopOnce.Do(func() { close(w.stop) })
+	select {
+	case <-w.done:
+	case <-ctx.Done():
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 宠物状态属性（0-100，越高越好）
+const (
+	PetStatHunger      = "hunger"      // 饱食度
+	PetStatMood        = "mood"        // 心情
+	PetStatEnergy      = "energy"      // 精力
+	PetStatCleanliness = "cleanliness" // 清洁度
+)
+
+const (
+	petStatMax     = 100
+	petStatInitial = 80 // 首次查看时的初始值
+
+	maxItemEffects = 4 // 单个道具最多效果数
+)
+
+// petStatDecayPerHour 每小时自然衰减值（读取时按距上次更新的时长惰性计算）
+var petStatDecayPerHour = map[string]float64{
+	PetStatHunger:      4,
+	PetStatMood:        2,
+	PetStatEnergy:      3,
+	PetStatCleanliness: 2,
+}
+
+// ErrInvalidItemEffects 道具效果定义不合法
+func ErrInvalidItemEffects(msg string) error {
+	return errors.BadRequest("INVALID_ITEM_EFFECTS", msg)
+}
+
+// ItemEffect 道具使用效果，例如 {hunger, +30}
+type ItemEffect struct {
+	Stat  string // 见 PetStat* 常量
+	Delta int32  // 变化值（-100~100）
+}
+
+// ParseItemEffects 解析 items.effects，格式为逗号分隔的 "stat:+N"，例如 "hunger:+30,mood:+10"
+func ParseItemEffects(s string) ([]ItemEffect, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	var out []ItemEffect
+	for _, part := range strings.Split(s, ",") {
+		stat, delta, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok {
+			return nil, ErrInvalidItemEffects(fmt.Sprintf("effect %q must be stat:delta", part))
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(delta), 10, 32)
+		if err != nil {
+			return nil, ErrInvalidItemEffects(fmt.Sprintf("effect %q has invalid delta", part))
+		}
+		out = append(out, ItemEffect{Stat: strings.TrimSpace(stat), Delta: int32(n)})
+	}
+	if err := ValidateItemEffects(out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FormatItemEffects 序列化为 items.effects 存储格式
+func FormatItemEffects(effects []ItemEffect) string {
+	parts := make([]string, 0, len(effects))
+	for _, e := range effects {
+		parts = append(parts, fmt.Sprintf("%s:%+d", e.Stat, e.Delta))
+	}
+	return strings.Join(parts, ",")
+}
+
+// ValidateItemEffects 属性须合法且不重复，变化值在 -100~100 之间且不为 0
+func ValidateItemEffects(effects []ItemEffect) error {
+	if len(effects) > maxItemEffects {
+		return ErrInvalidItemEffects(fmt.Sprintf("at most %d effects per item", maxItemEffects))
+	}
+	seen := map[string]bool{}
+	for _, e := range effects {
+		if _, ok := petStatDecayPerHour[e.Stat]; !ok {
+			return ErrInvalidItemEffects("unknown stat " + strconv.Quote(e.Stat) + ", must be one of hunger, mood, energy, cleanliness")
+		}
+		if seen[e.Stat] {
+			return ErrInvalidItemEffects("duplicate stat " + strconv.Quote(e.Stat))
+		}
+		seen[e.Stat] = true
+		if e.Delta == 0 || e.Delta < -petStatMax || e.Delta > petStatMax {
+			return ErrInvalidItemEffects("delta must be between -100 and 100 and not 0")
+		}
+	}
+	return nil
+}
+
+// PetState 宠物状态（pet_states 表，每只宠物一行）；保存的是 UpdatedAt 时刻的值，当前值见 At
+type PetState struct {
+	PetID       int64
+	UserID      int64
+	Hunger      float64
+	Mood        float64
+	Energy      float64
+	Cleanliness float64
+	UpdatedAt   time.Time
+}
+
+// NewPetState 新宠物的初始状态
+func NewPetState(userID, petID int64, now time.Time) *PetState {
+	return &PetState{PetID: petID, UserID: userID, Hunger: petStatInitial, Mood: petStatInitial, Energy: petStatInitial, Cleanliness: petStatInitial, UpdatedAt: now}
+}
+
+func (s *PetState) stat(name string) *float64 {
+	switch name {
+	case PetStatHunger:
+		return &s.Hunger
+	case PetStatMood:
+		return &s.Mood
+	case PetStatEnergy:
+		return &s.Energy
+	case PetStatCleanliness:
+		return &s.Cleanliness
+	}
+	return nil
+}
+
+// At 返回按自然衰减推算到 now 的状态（不修改 s）
+func (s *PetState) At(now time.Time) *PetState {
+	out := *s
+	hours := now.Sub(s.UpdatedAt).Hours()
+	if hours <= 0 {
+		return &out
+	}
+	for name, rate := range petStatDecayPerHour {
+		v := out.stat(name)
+		*v = clampStat(*v - rate*hours)
+	}
+	out.UpdatedAt = now
+	return &out
+}
+
+// Apply 叠加道具效果（结果限制在 0-100）
+func (s *PetState) Apply(effects []ItemEffect) {
+	for _, e := range effects {
+		if v := s.stat(e.Stat); v != nil {
+			*v = clampStat(*v + float64(e.Delta))
+		}
+	}
+}
+
+func clampStat(v float64) float64 {
+	return math.Max(0, math.Min(petStatMax, v))
+}
+
+// Describe 以宠物第一人称描述当前状态，用于拼接聊天 system prompt
+func (s *PetState) Describe() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "（我当前的状态：饱食度%.0f、心情%.0f、精力%.0f、清洁度%.0f，满分100。）", s.Hunger, s.Mood, s.Energy, s.Cleanliness)
+	n := b.Len()
+	switch {
+	case s.Hunger < 30:
+		b.WriteString("我现在很饿，很想吃东西。")
+	case s.Hunger < 60:
+		b.WriteString("我有点饿了。")
+	}
+	switch {
+	case s.Mood < 30:
+		b.WriteString("我心情有些低落，需要主人陪伴。")
+	case s.Mood >= 80:
+		b.WriteString("我现在心情很好。")
+	}
+	if s.Energy < 30 {
+		b.WriteString("我很累，想休息一会儿。")
+	}
+	if s.Cleanliness < 30 {
+		b.WriteString("我身上脏脏的，想洗个澡。")
+	}
+	if b.Len() == n {
+		b.WriteString("我现在状态不错。")
+	}
+	b.WriteString("可以在合适的时候自然地提到自己的状态。")
+	return b.String()
+}
+
+// PetStateRepo 宠物状态仓储（按宠物ID）
+// Get: 读取保存的状态，不存在返回 nil, nil
+// Ensure: 不存在时写入初始状态（已存在则不变）
+// GetForUpdate: 在事务内加锁读取（须先 Ensure）
+// Save: 覆盖保存
+type PetStateRepo interface {
+	Get(ctx context.Context, petID int64) (*PetState, error)
+	Ensure(ctx context.Context, s *PetState) error
+	GetForUpdate(ctx context.Context, petID int64) (*PetState, error)
+	Save(ctx context.Context, s *PetState) error
+}
+
+// PetStateUsecase 宠物状态：每只宠物各自一份，读取时惰性衰减，使用道具时叠加效果
+type PetStateUsecase struct {
+	repo PetStateRepo
+	pets PetRepo
+	tx   Transaction
+}
+
+func NewPetStateUsecase(repo PetStateRepo, pets PetRepo, tx Transaction) *PetStateUsecase {
+	return &PetStateUsecase{repo: repo, pets: pets, tx: tx}
+}
+
+// Get 宠物当前状态（petID 为 0 时取当前宠物；尚未有记录时返回初始状态），尚无宠物返回 ErrPetNotFound
+func (uc *PetStateUsecase) Get(ctx context.Context, userID, petID int64) (*PetState, error) {
+	p, err := requirePet(ctx, uc.pets, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	s, err := uc.repo.Get(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return NewPetState(userID, p.ID, now), nil
+	}
+	return s.At(now), nil
+}
+
+// ApplyEffects 先结算衰减再叠加效果并保存，返回新状态（petID 为 0 时作用于当前宠物）
+func (uc *PetStateUsecase) ApplyEffects(ctx context.Context, userID, petID int64, effects []ItemEffect) (*PetState, error) {
+	p, err := requirePet(ctx, uc.pets, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+	var out *PetState
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		if err := uc.repo.Ensure(ctx, NewPetState(userID, p.ID, now)); err != nil {
+			return err
+		}
+		s, err := uc.repo.GetForUpdate(ctx, p.ID)
+		if err != nil {
+			return err
+		}
+		out = s.At(now)
+		out.Apply(effects)
+		return uc.repo.Save(ctx, out)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
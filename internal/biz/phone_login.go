@@ -0,0 +1,262 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"pet-angel/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	// ErrInvalidPhone 手机号格式错误
+	ErrInvalidPhone = errors.BadRequest("INVALID_PHONE", "invalid phone number")
+	// ErrInvalidLoginCode 登录验证码错误、已使用、已过期或尝试次数过多
+	ErrInvalidLoginCode = errors.BadRequest("INVALID_LOGIN_CODE", "invalid or expired login code")
+)
+
+// ErrLoginCodeThrottled 验证码发送过于频繁（HTTP 429），metadata.retry_after 为建议重试等待秒数
+func ErrLoginCodeThrottled(retryAfter time.Duration) error {
+	secs := int64(math.Ceil(retryAfter.Seconds()))
+	if secs < 1 {
+		secs = 1
+	}
+	return errors.New(429, "LOGIN_CODE_THROTTLED", "too many login codes requested, please retry later").
+		WithMetadata(map[string]string{"retry_after": strconv.FormatInt(secs, 10)})
+}
+
+// SmsGateway 短信发送通道（本地开发默认输出到日志）
+type SmsGateway interface {
+	Send(ctx context.Context, phone, content string) error
+}
+
+// LoginCode 手机登录验证码（只保存 bcrypt 哈希）
+type LoginCode struct {
+	Phone     string    // 手机号（E.164）
+	CodeHash  string    // 验证码哈希
+	Attempts  int32     // 已尝试次数
+	SentAt    time.Time // 发送时间
+	ExpiresAt time.Time // 过期时间
+}
+
+// LoginCodeRepo 登录验证码存储（Redis 或进程内 LRU）
+// Save: 写入验证码并覆盖该手机号此前的验证码，到期自动删除
+// Get: 不存在或已过期返回 ErrInvalidLoginCode
+// IncrAttempts: 尝试次数 +1，验证码不存在返回 ErrInvalidLoginCode
+// Consume: 仅当当前验证码哈希仍为 codeHash 时删除并返回 true，保证单次有效
+// Hit: 固定窗口计数 +1（首次计数时开始计时），返回窗口内累计次数与窗口剩余时长
+type LoginCodeRepo interface {
+	Save(ctx context.Context, c *LoginCode) error
+	Get(ctx context.Context, phone string) (*LoginCode, error)
+	IncrAttempts(ctx context.Context, phone string) (int32, error)
+	Consume(ctx context.Context, phone, codeHash string) (bool, error)
+	Hit(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error)
+}
+
+var (
+	cnMobilePattern = regexp.MustCompile(`^1[3-9]\d{9}$`)
+	e164Pattern     = regexp.MustCompile(`^\+[1-9]\d{6,14}$`)
+)
+
+// NormalizePhone 统一为 E.164 格式：11 位中国大陆手机号补 +86，其它须自带国家码
+func NormalizePhone(phone string) (string, error) {
+	p := strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(phone))
+	if strings.HasPrefix(p, "+86") && cnMobilePattern.MatchString(p[3:]) {
+		return p, nil
+	}
+	if cnMobilePattern.MatchString(p) {
+		return "+86" + p, nil
+	}
+	if e164Pattern.MatchString(p) && !strings.HasPrefix(p, "+86") {
+		return p, nil
+	}
+	return "", ErrInvalidPhone
+}
+
+// PhoneLoginUsecase 手机号 + 短信验证码登录（首次登录自动注册）
+type PhoneLoginUsecase struct {
+	auth  *AuthUsecase
+	codes LoginCodeRepo
+	sms   SmsGateway
+	log   *log.Helper
+
+	ttl         time.Duration
+	resendAfter time.Duration
+	maxAttempts int32
+	maxPerPhone int64
+	maxPerIP    int64
+	window      time.Duration
+}
+
+func NewPhoneLoginUsecase(auth *AuthUsecase, codes LoginCodeRepo, sms SmsGateway, cfg *conf.Auth, logger log.Logger) *PhoneLoginUsecase {
+	uc := &PhoneLoginUsecase{
+		auth:        auth,
+		codes:       codes,
+		sms:         sms,
+		log:         log.NewHelper(logger),
+		ttl:         5 * time.Minute,
+		resendAfter: time.Minute,
+		maxAttempts: 5,
+		maxPerPhone: 5,
+		maxPerIP:    20,
+		window:      time.Hour,
+	}
+	c := cfg.GetLoginCode()
+	if c == nil {
+		return uc
+	}
+	if c.GetTtl() != nil {
+		uc.ttl = c.GetTtl().AsDuration()
+	}
+	if c.GetResendInterval() != nil {
+		uc.resendAfter = c.GetResendInterval().AsDuration()
+	}
+	if c.GetMaxAttempts() > 0 {
+		uc.maxAttempts = c.GetMaxAttempts()
+	}
+	if c.GetMaxPerPhone() > 0 {
+		uc.maxPerPhone = int64(c.GetMaxPerPhone())
+	}
+	if c.GetMaxPerIp() > 0 {
+		uc.maxPerIP = int64(c.GetMaxPerIp())
+	}
+	if c.GetWindow() != nil {
+		uc.window = c.GetWindow().AsDuration()
+	}
+	return uc
+}
+
+// SendCode 生成验证码并通过短信发送，返回验证码有效期与再次发送需等待的时长
+// 限流：同一手机号两次发送至少间隔 resend_interval；窗口内按手机号与 IP 分别限制发送次数
+func (uc *PhoneLoginUsecase) SendCode(ctx context.Context, phone string, client ClientInfo) (ttl, resendAfter time.Duration, err error) {
+	phone, err = NormalizePhone(phone)
+	if err != nil {
+		return 0, 0, err
+	}
+	now := time.Now()
+	if last, err := uc.codes.Get(ctx, phone); err == nil {
+		if wait := last.SentAt.Add(uc.resendAfter).Sub(now); wait > 0 {
+			return 0, 0, ErrLoginCodeThrottled(wait)
+		}
+	} else if !errors.Is(err, ErrInvalidLoginCode) {
+		return 0, 0, err
+	}
+	// 先按 IP 计数（被手机号限额拒绝的请求同样消耗 IP 额度），再按手机号计数
+	if client.IP != "" {
+		if err := uc.hit(ctx, "ip:"+client.IP, uc.maxPerIP); err != nil {
+			return 0, 0, err
+		}
+	}
+	if err := uc.hit(ctx, "phone:"+phone, uc.maxPerPhone); err != nil {
+		return 0, 0, err
+	}
+
+	code, err := newNumericCode(6)
+	if err != nil {
+		return 0, 0, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := uc.codes.Save(ctx, &LoginCode{Phone: phone, CodeHash: string(hash), SentAt: now, ExpiresAt: now.Add(uc.ttl)}); err != nil {
+		return 0, 0, err
+	}
+	if err := uc.sms.Send(ctx, phone, fmt.Sprintf("您的登录验证码为 %s，%d 分钟内有效。如非本人操作请忽略。", code, int(uc.ttl.Minutes()))); err != nil {
+		return 0, 0, err
+	}
+	return uc.ttl, uc.resendAfter, nil
+}
+
+func (uc *PhoneLoginUsecase) hit(ctx context.Context, key string, max int64) error {
+	n, left, err := uc.codes.Hit(ctx, key, uc.window)
+	if err != nil {
+		return err
+	}
+	if n > max {
+		return ErrLoginCodeThrottled(left)
+	}
+	return nil
+}
+
+// Login 校验验证码并登录；手机号未注册时自动创建用户（无密码，可稍后通过重置密码设置）
+func (uc *PhoneLoginUsecase) Login(ctx context.Context, phone, code string, client ClientInfo) (u *User, pair *TokenPair, created bool, err error) {
+	phone, err = NormalizePhone(phone)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	c, err := uc.codes.Get(ctx, phone)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if c.Attempts >= uc.maxAttempts {
+		return nil, nil, false, ErrInvalidLoginCode
+	}
+	if bcrypt.CompareHashAndPassword([]byte(c.CodeHash), []byte(code)) != nil {
+		if _, err := uc.codes.IncrAttempts(ctx, phone); err != nil && !errors.Is(err, ErrInvalidLoginCode) {
+			uc.log.WithContext(ctx).Warnf("incr login code attempts failed: %v", err)
+		}
+		return nil, nil, false, ErrInvalidLoginCode
+	}
+	ok, err := uc.codes.Consume(ctx, phone, c.CodeHash)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if !ok {
+		// 并发请求已使用该验证码
+		return nil, nil, false, ErrInvalidLoginCode
+	}
+
+	u, err = uc.auth.repo.GetByPhone(ctx, phone)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrUserNotFound):
+		if u, err = uc.register(ctx, phone); err != nil {
+			return nil, nil, false, err
+		}
+		created = true
+	default:
+		return nil, nil, false, err
+	}
+	pair, err = uc.auth.startSession(ctx, u, client)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return u, pair, created, nil
+}
+
+// register 以与注册相同的默认值创建用户：用户名随机生成，密码为空（不可用于密码登录）
+// 同一手机号并发首次登录时由唯一索引兜底，失败方读取已创建的用户
+func (uc *PhoneLoginUsecase) register(ctx context.Context, phone string) (*User, error) {
+	for i := 0; i < 3; i++ {
+		now := time.Now()
+		u := &User{
+			Username:  "phone_" + randomHex(5),
+			Phone:     phone,
+			Nickname:  "用户" + phone[len(phone)-4:],
+			ModelURL:  DefaultPetModelURL,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		id, err := uc.auth.repo.Create(ctx, u)
+		if err == nil {
+			u.Id = id
+			return u, nil
+		}
+		if errors.Is(err, ErrPhoneAlreadyExists) {
+			return uc.auth.repo.GetByPhone(ctx, phone)
+		}
+		if !errors.Is(err, ErrUserAlreadyExists) {
+			return nil, err
+		}
+	}
+	return nil, ErrUserAlreadyExists
+}
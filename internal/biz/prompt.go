@@ -0,0 +1,381 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrPromptTemplateNotFound 提示词模板不存在
+var ErrPromptTemplateNotFound = errors.NotFound("PROMPT_TEMPLATE_NOT_FOUND", "prompt template not found")
+
+// ErrInvalidPromptTemplate 提示词模板校验失败（HTTP 400）
+func ErrInvalidPromptTemplate(format string, args ...interface{}) error {
+	return errors.BadRequest("INVALID_PROMPT_TEMPLATE", fmt.Sprintf(format, args...))
+}
+
+// 提示词场景
+const (
+	PromptSceneChat = "chat" // 与宠物聊天的系统提示
+	PromptSceneNote = "note" // 生成每日小纸条的系统提示
+)
+
+const (
+	// PromptAnyModelType 模板适用于所有模型类型
+	PromptAnyModelType int32 = -1
+	// maxPromptTemplateLen 模板最大长度（字）
+	maxPromptTemplateLen = 4000
+	// promptTimezone 计算“现在是早上/晚上”所用的时区
+	promptTimezone = "Asia/Shanghai"
+)
+
+// defaultPromptTemplates 数据库中没有启用的模板（或模板渲染失败）时使用的内置模板
+var defaultPromptTemplates = map[string]string{
+	PromptSceneChat: "你是一个治愈系的宠物数字伙伴，以第一人称‘我’的口吻，温柔简短地回复。" +
+		"{{with .PetName}}我的名字是{{.}}。{{end}}{{with .PetKind}}我是一只{{.}}。{{end}}{{with .PetHobby}}我喜欢{{.}}。{{end}}" +
+		"{{with .OwnerNickname}}主人的昵称是{{.}}。{{end}}现在是{{.TimeOfDay}}。" +
+		"{{with .State}}{{.}}{{end}}{{with .Memories}}{{.}}{{end}}",
+	PromptSceneNote: "你是治愈系宠物数字伙伴，第一人称‘我’，中文简短。{{with .PetName}}我的名字是{{.}}。{{end}}",
+}
+
+// PromptTemplate 提示词模板（prompt_templates 表）
+// 同一 场景+模型类型+模型 下的模板按版本递增保存，只有一个版本启用；启用旧版本即回滚
+type PromptTemplate struct {
+	ID        int64     // 模板ID
+	Scene     string    // 场景 chat/note
+	ModelType int32     // 适用的模型类型（pet_models.type），-1 为全部
+	ModelID   int64     // 适用的模型（pet_models.id），0 为不限；指定模型时优先于模型类型
+	Version   int32     // 版本号（同一适用范围内从 1 递增）
+	Content   string    // 模板内容（text/template，变量见 PromptVars）
+	Note      string    // 修改说明
+	Active    bool      // 是否启用
+	CreatedBy int64     // 创建人
+	CreatedAt time.Time // 创建时间
+}
+
+// PromptVars 模板变量
+type PromptVars struct {
+	PetName       string // 宠物名
+	PetKind       string // 品类
+	PetSex        string // 性别：男孩/女孩（未知为空）
+	PetHobby      string // 爱好
+	OwnerNickname string // 主人昵称
+	TimeOfDay     string // 时段：凌晨/早上/上午/中午/下午/傍晚/晚上
+	State         string // 宠物当前状态描述（仅聊天）
+	Memories      string // 与本轮输入相关的长期记忆（仅聊天）
+}
+
+// PromptPreview 预览结果
+type PromptPreview struct {
+	TemplateID int64  // 使用的模板（0 为内置模板或直接预览的内容）
+	Version    int32  // 模板版本
+	Prompt     string // 渲染结果
+}
+
+// PromptRepo 提示词模板仓储
+// List: scene 为空返回全部场景（按场景、适用范围、版本倒序）
+// ListActive: 某场景下所有启用的模板
+// Get: 不存在返回 ErrPromptTemplateNotFound
+// MaxVersion: 某适用范围内的最大版本号（尚无为 0）
+// Activate: 启用该模板，并停用同一适用范围内的其它版本
+type PromptRepo interface {
+	List(ctx context.Context, scene string) ([]*PromptTemplate, error)
+	ListActive(ctx context.Context, scene string) ([]*PromptTemplate, error)
+	Get(ctx context.Context, id int64) (*PromptTemplate, error)
+	Create(ctx context.Context, t *PromptTemplate) error
+	MaxVersion(ctx context.Context, scene string, modelType int32, modelID int64) (int32, error)
+	Activate(ctx context.Context, t *PromptTemplate) error
+}
+
+// PromptUsecase 提示词模板：按宠物的模型/模型类型选用模板并渲染系统提示，管理后台可新增版本、回滚与预览
+type PromptUsecase struct {
+	repo     PromptRepo
+	users    UserRepo
+	models   AvatarRepo
+	pets     *PetUsecase
+	states   *PetStateUsecase
+	memories *PetMemoryUsecase
+	tx       Transaction
+	loc      *time.Location
+	log      *log.Helper
+}
+
+func NewPromptUsecase(repo PromptRepo, users UserRepo, models AvatarRepo, pets *PetUsecase, states *PetStateUsecase, memories *PetMemoryUsecase, tx Transaction, logger log.Logger) *PromptUsecase {
+	uc := &PromptUsecase{repo: repo, users: users, models: models, pets: pets, states: states, memories: memories, tx: tx, log: log.NewHelper(logger)}
+	loc, err := time.LoadLocation(promptTimezone)
+	if err != nil {
+		uc.log.Warnf("prompt: invalid timezone %q, falling back to UTC: %v", promptTimezone, err)
+		loc = time.UTC
+	}
+	uc.loc = loc
+	return uc
+}
+
+// ChatPrompt 与宠物聊天的系统提示（包含该宠物的状态与和 query 相关的记忆）；查询失败的部分只记日志，不影响聊天
+func (uc *PromptUsecase) ChatPrompt(ctx context.Context, userID int64, pet *Pet, query string) string {
+	vars := uc.vars(ctx, userID, pet, time.Now())
+	if pet != nil {
+		if st, err := uc.states.Get(ctx, userID, pet.ID); err != nil {
+			uc.log.WithContext(ctx).Warnf("prompt: load pet state failed: %v", err)
+		} else {
+			vars.State = st.Describe()
+		}
+	}
+	if m, err := uc.memories.Prompt(ctx, userID, pet, query); err != nil {
+		uc.log.WithContext(ctx).Warnf("prompt: load pet memories failed: %v", err)
+	} else {
+		vars.Memories = m
+	}
+	return uc.render(ctx, PromptSceneChat, pet, vars)
+}
+
+// NotePrompt 为用户生成每日小纸条的系统提示（以当前宠物的口吻）
+func (uc *PromptUsecase) NotePrompt(ctx context.Context, userID int64) string {
+	pet, err := uc.pets.Active(ctx, userID)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("prompt: load active pet failed: %v", err)
+	}
+	return uc.render(ctx, PromptSceneNote, pet, uc.vars(ctx, userID, pet, time.Now()))
+}
+
+// render 选用模板并渲染；模板渲染失败时退回内置模板
+func (uc *PromptUsecase) render(ctx context.Context, scene string, pet *Pet, vars *PromptVars) string {
+	t, err := uc.pick(ctx, scene, pet)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("prompt: load %s templates failed: %v", scene, err)
+	}
+	if t != nil {
+		s, err := RenderPrompt(t.Content, vars)
+		if err == nil {
+			return s
+		}
+		uc.log.WithContext(ctx).Errorf("prompt: render template %d failed: %v", t.ID, err)
+	}
+	s, _ := RenderPrompt(defaultPromptTemplates[scene], vars)
+	return s
+}
+
+// pick 按 指定模型 > 模型类型 > 全部 的顺序选用启用中的模板；没有时返回 nil（使用内置模板）
+func (uc *PromptUsecase) pick(ctx context.Context, scene string, pet *Pet) (*PromptTemplate, error) {
+	list, err := uc.repo.ListActive(ctx, scene)
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+	var modelID int64
+	modelType := PromptAnyModelType
+	if pet != nil && pet.ModelID > 0 {
+		modelID = pet.ModelID
+		if m, err := uc.models.GetPetModel(ctx, pet.ModelID); err == nil {
+			modelType = m.ModelType
+		}
+	}
+	return PickPromptTemplate(list, modelID, modelType), nil
+}
+
+// PickPromptTemplate 从启用的模板中选出最匹配的一个（modelID 为 0、modelType 为 -1 表示未知）
+func PickPromptTemplate(list []*PromptTemplate, modelID int64, modelType int32) *PromptTemplate {
+	var byType, all *PromptTemplate
+	for _, t := range list {
+		switch {
+		case t.ModelID > 0:
+			if t.ModelID == modelID {
+				return t
+			}
+		case t.ModelType == PromptAnyModelType:
+			all = t
+		case t.ModelType == modelType:
+			byType = t
+		}
+	}
+	if byType != nil {
+		return byType
+	}
+	return all
+}
+
+// vars 模板变量（不含状态与记忆）
+func (uc *PromptUsecase) vars(ctx context.Context, userID int64, pet *Pet, now time.Time) *PromptVars {
+	v := &PromptVars{TimeOfDay: TimeOfDay(now.In(uc.loc))}
+	if pet != nil {
+		v.PetName, v.PetKind, v.PetHobby = pet.Name, pet.Kind, pet.Hobby
+		switch pet.Sex {
+		case 1:
+			v.PetSex = "男孩"
+		case 2:
+			v.PetSex = "女孩"
+		}
+	}
+	if u, err := uc.users.GetUserByID(ctx, userID); err != nil {
+		uc.log.WithContext(ctx).Warnf("prompt: load user %d failed: %v", userID, err)
+	} else if u != nil {
+		v.OwnerNickname = u.Nickname
+	}
+	return v
+}
+
+// TimeOfDay 时段称呼
+func TimeOfDay(t time.Time) string {
+	switch h := t.Hour(); {
+	case h < 5:
+		return "凌晨"
+	case h < 9:
+		return "早上"
+	case h < 11:
+		return "上午"
+	case h < 13:
+		return "中午"
+	case h < 17:
+		return "下午"
+	case h < 19:
+		return "傍晚"
+	default:
+		return "晚上"
+	}
+}
+
+// RenderPrompt 渲染模板；变量名写错（如 {{.PetAge}}）时返回错误
+func RenderPrompt(content string, vars *PromptVars) (string, error) {
+	t, err := template.New("prompt").Parse(content)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, vars); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+// samplePromptVars 保存模板前用于试渲染的变量
+var samplePromptVars = &PromptVars{
+	PetName: "咪咪", PetKind: "橘猫", PetSex: "女孩", PetHobby: "晒太阳", OwnerNickname: "小明", TimeOfDay: "晚上",
+	State: "我现在有点饿。", Memories: "你记得关于主人的这些事：主人周五有考试。",
+}
+
+// List 模板列表（含历史版本）
+func (uc *PromptUsecase) List(ctx context.Context, scene string) ([]*PromptTemplate, error) {
+	if scene != "" {
+		if err := checkPromptScene(scene); err != nil {
+			return nil, err
+		}
+	}
+	return uc.repo.List(ctx, scene)
+}
+
+// Create 保存新版本模板并立即启用（同一适用范围内版本号 +1，其它版本停用）
+func (uc *PromptUsecase) Create(ctx context.Context, t *PromptTemplate) (*PromptTemplate, error) {
+	if err := checkPromptScene(t.Scene); err != nil {
+		return nil, err
+	}
+	if t.ModelID < 0 {
+		return nil, ErrInvalidPromptTemplate("invalid model_id %d", t.ModelID)
+	}
+	if t.ModelID > 0 {
+		// 指定模型时不再区分类型
+		if _, err := uc.models.GetPetModel(ctx, t.ModelID); err != nil {
+			return nil, err
+		}
+		t.ModelType = PromptAnyModelType
+	} else if t.ModelType < PromptAnyModelType || t.ModelType > 1 {
+		return nil, ErrInvalidPromptTemplate("model_type must be -1 (all), 0 (cat) or 1 (dog)")
+	}
+	if strings.TrimSpace(t.Content) == "" || utf8.RuneCountInString(t.Content) > maxPromptTemplateLen {
+		return nil, ErrInvalidPromptTemplate("content must be 1-%d characters", maxPromptTemplateLen)
+	}
+	if utf8.RuneCountInString(t.Note) > 255 {
+		return nil, ErrInvalidPromptTemplate("note must be at most 255 characters")
+	}
+	if _, err := RenderPrompt(t.Content, samplePromptVars); err != nil {
+		return nil, ErrInvalidPromptTemplate("template: %v", err)
+	}
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		v, err := uc.repo.MaxVersion(ctx, t.Scene, t.ModelType, t.ModelID)
+		if err != nil {
+			return err
+		}
+		t.Version, t.Active = v+1, true
+		if err := uc.repo.Create(ctx, t); err != nil {
+			return err
+		}
+		return uc.repo.Activate(ctx, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Activate 启用某个版本（用于回滚），同一适用范围内的其它版本停用
+func (uc *PromptUsecase) Activate(ctx context.Context, id int64) (*PromptTemplate, error) {
+	t, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tx.InTx(ctx, func(ctx context.Context) error { return uc.repo.Activate(ctx, t) }); err != nil {
+		return nil, err
+	}
+	t.Active = true
+	return t, nil
+}
+
+// Preview 为某个用户渲染提示词：content 非空时渲染该内容，templateID 非 0 时渲染指定模板，否则渲染当前会选用的模板
+// petID 为 0 时使用该用户的当前宠物；query 为聊天场景下用于挑选记忆的本轮输入
+func (uc *PromptUsecase) Preview(ctx context.Context, userID int64, scene string, petID, templateID int64, content, query string) (*PromptPreview, error) {
+	if err := checkPromptScene(scene); err != nil {
+		return nil, err
+	}
+	pet, err := uc.pets.Resolve(ctx, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+	vars := uc.vars(ctx, userID, pet, time.Now())
+	if scene == PromptSceneChat {
+		if pet != nil {
+			if st, err := uc.states.Get(ctx, userID, pet.ID); err == nil {
+				vars.State = st.Describe()
+			}
+		}
+		if vars.Memories, err = uc.memories.Prompt(ctx, userID, pet, query); err != nil {
+			return nil, err
+		}
+	}
+	out := &PromptPreview{}
+	switch {
+	case content != "":
+	case templateID != 0:
+		t, err := uc.repo.Get(ctx, templateID)
+		if err != nil {
+			return nil, err
+		}
+		if t.Scene != scene {
+			return nil, ErrInvalidPromptTemplate("template %d is for scene %q", t.ID, t.Scene)
+		}
+		out.TemplateID, out.Version, content = t.ID, t.Version, t.Content
+	default:
+		t, err := uc.pick(ctx, scene, pet)
+		if err != nil {
+			return nil, err
+		}
+		content = defaultPromptTemplates[scene]
+		if t != nil {
+			out.TemplateID, out.Version, content = t.ID, t.Version, t.Content
+		}
+	}
+	if out.Prompt, err = RenderPrompt(content, vars); err != nil {
+		return nil, ErrInvalidPromptTemplate("template: %v", err)
+	}
+	return out, nil
+}
+
+func checkPromptScene(scene string) error {
+	if _, ok := defaultPromptTemplates[scene]; !ok {
+		return ErrInvalidPromptTemplate("scene must be %q or %q", PromptSceneChat, PromptSceneNote)
+	}
+	return nil
+}
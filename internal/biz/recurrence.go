@@ -0,0 +1,207 @@
+package biz
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 重复频率（RRULE FREQ）
+const (
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+	FreqYearly  = "YEARLY"
+)
+
+// maxRecurrencePeriods 查找下一次发生时间时最多展开的周期数（防止异常规则死循环）
+const maxRecurrencePeriods = 100000
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday,
+}
+
+// Recurrence RRULE 子集：FREQ=DAILY|WEEKLY|MONTHLY|YEARLY;INTERVAL=n;BYDAY=MO,WE（仅 WEEKLY）;COUNT=n;UNTIL=yyyyMMdd
+// 发生时间的时分取自起始时间；MONTHLY/YEARLY 跳过不存在的日期（如 31 日、2 月 29 日）
+// COUNT 为整个序列的发生次数（含起始），UNTIL 为最后一天（含，按提醒时区的日期）
+type Recurrence struct {
+	Freq     string
+	Interval int
+	ByDay    []time.Weekday // 按周一到周日排序
+	Count    int            // 0 表示不限
+	Until    string         // yyyy-MM-dd，空表示不限
+}
+
+// ParseRecurrence 解析 RRULE 字符串（可带 "RRULE:" 前缀，大小写不敏感）；空字符串返回 nil（不重复）
+func ParseRecurrence(s string) (*Recurrence, error) {
+	s = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(s)), "RRULE:")
+	if s == "" {
+		return nil, nil
+	}
+	r := &Recurrence{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(strings.Trim(s, ";"), ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		key, val := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate %s", key)
+		}
+		seen[key] = true
+		switch key {
+		case "FREQ":
+			switch val {
+			case FreqDaily, FreqWeekly, FreqMonthly, FreqYearly:
+				r.Freq = val
+			default:
+				return nil, fmt.Errorf("unsupported FREQ %q", val)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 || n > 1000 {
+				return nil, fmt.Errorf("INTERVAL must be between 1 and 1000")
+			}
+			r.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 || n > 10000 {
+				return nil, fmt.Errorf("COUNT must be between 1 and 10000")
+			}
+			r.Count = n
+		case "UNTIL":
+			// 接受 yyyyMMdd、yyyy-MM-dd 及 yyyyMMddTHHmmssZ（只取日期）
+			d := strings.ReplaceAll(val, "-", "")
+			if i := strings.IndexByte(d, 'T'); i >= 0 {
+				d = d[:i]
+			}
+			t, err := time.Parse("20060102", d)
+			if err != nil {
+				return nil, fmt.Errorf("invalid UNTIL %q", val)
+			}
+			r.Until = t.Format("2006-01-02")
+		case "BYDAY":
+			days := map[time.Weekday]bool{}
+			for _, v := range strings.Split(val, ",") {
+				wd, ok := rruleWeekdays[strings.TrimSpace(v)]
+				if !ok {
+					return nil, fmt.Errorf("invalid BYDAY %q", v)
+				}
+				days[wd] = true
+			}
+			for wd := range days {
+				r.ByDay = append(r.ByDay, wd)
+			}
+			sort.Slice(r.ByDay, func(i, j int) bool { return mondayIndex(r.ByDay[i]) < mondayIndex(r.ByDay[j]) })
+		default:
+			return nil, fmt.Errorf("unsupported rule part %s", key)
+		}
+	}
+	if r.Freq == "" {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+	if len(r.ByDay) > 0 && r.Freq != FreqWeekly {
+		return nil, fmt.Errorf("BYDAY is only supported with FREQ=WEEKLY")
+	}
+	if r.Count > 0 && r.Until != "" {
+		return nil, fmt.Errorf("COUNT and UNTIL must not both be set")
+	}
+	return r, nil
+}
+
+// String 规范化的 RRULE（nil 为空字符串）
+func (r *Recurrence) String() string {
+	if r == nil {
+		return ""
+	}
+	parts := []string{"FREQ=" + r.Freq}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, wd := range r.ByDay {
+			days = append(days, strings.ToUpper(wd.String()[:2]))
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != "" {
+		parts = append(parts, "UNTIL="+strings.ReplaceAll(r.Until, "-", ""))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next 起始时间为 start（须已位于提醒时区）的序列中，严格晚于 after 的第一次发生时间；序列已结束返回 false
+// r 为 nil 表示只在 start 发生一次
+func (r *Recurrence) Next(start, after time.Time) (time.Time, bool) {
+	if r == nil {
+		return start, start.After(after)
+	}
+	n := 0
+	for i := 0; i < maxRecurrencePeriods; i++ {
+		for _, t := range r.period(start, i) {
+			n++
+			if r.Count > 0 && n > r.Count {
+				return time.Time{}, false
+			}
+			if r.Until != "" && t.Format("2006-01-02") > r.Until {
+				return time.Time{}, false
+			}
+			if t.After(after) {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// period 第 i 个周期内的发生时间（升序，均不早于 start）
+func (r *Recurrence) period(start time.Time, i int) []time.Time {
+	y, m, d := start.Date()
+	hh, mm, ss := start.Clock()
+	loc := start.Location()
+	k := i * r.Interval
+	switch r.Freq {
+	case FreqDaily:
+		return []time.Time{time.Date(y, m, d+k, hh, mm, ss, 0, loc)}
+	case FreqWeekly:
+		if len(r.ByDay) == 0 {
+			return []time.Time{time.Date(y, m, d+7*k, hh, mm, ss, 0, loc)}
+		}
+		// 以周一为一周的开始
+		monday := d - mondayIndex(start.Weekday()) + 7*k
+		out := make([]time.Time, 0, len(r.ByDay))
+		for _, wd := range r.ByDay {
+			t := time.Date(y, m, monday+mondayIndex(wd), hh, mm, ss, 0, loc)
+			if !t.Before(start) {
+				out = append(out, t)
+			}
+		}
+		return out
+	case FreqMonthly:
+		t := time.Date(y, m+time.Month(k), d, hh, mm, ss, 0, loc)
+		if t.Day() != d {
+			return nil
+		}
+		return []time.Time{t}
+	case FreqYearly:
+		t := time.Date(y+k, m, d, hh, mm, ss, 0, loc)
+		if t.Day() != d {
+			return nil
+		}
+		return []time.Time{t}
+	}
+	return nil
+}
+
+// mondayIndex 周一为 0，周日为 6
+func mondayIndex(wd time.Weekday) int {
+	return (int(wd) + 6) % 7
+}
@@ -0,0 +1,384 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// MaxRemindersPerUser 每个用户最多的提醒数
+const MaxRemindersPerUser = 50
+
+var (
+	// ErrReminderNotFound 提醒不存在或不属于该用户
+	ErrReminderNotFound = errors.NotFound("REMINDER_NOT_FOUND", "reminder not found")
+	// ErrReminderLimitReached 提醒数量已达上限
+	ErrReminderLimitReached = errors.BadRequest("REMINDER_LIMIT_REACHED", fmt.Sprintf("at most %d reminders per user", MaxRemindersPerUser))
+)
+
+// ErrInvalidReminder 提醒字段校验失败（HTTP 400）
+func ErrInvalidReminder(format string, args ...interface{}) error {
+	return errors.BadRequest("INVALID_REMINDER", fmt.Sprintf(format, args...))
+}
+
+// 提醒类型
+const (
+	ReminderFeeding    = "feeding"    // 喂食
+	ReminderMedication = "medication" // 用药
+	ReminderVaccine    = "vaccine"    // 疫苗
+	ReminderDeworming  = "deworming"  // 驱虫
+	ReminderOther      = "other"      // 其他
+)
+
+const (
+	// ReminderStartLayout 提醒起始时间格式（提醒时区的本地时间，精确到分钟）
+	ReminderStartLayout = "2006-01-02 15:04"
+	// defaultReminderTimezone 未指定时区时使用
+	defaultReminderTimezone = "Asia/Shanghai"
+	// reminderBatch 调度器每次读取的到期提醒数
+	reminderBatch = 100
+	// reminderRetryDelay 发送失败的提醒推迟多久后重试
+	reminderRetryDelay = 5 * time.Minute
+)
+
+// reminderLines 到点时宠物的口吻（%s 为宠物名）
+var reminderLines = map[string]string{
+	ReminderFeeding:    "主人，%s的小肚子咕咕叫啦，该吃饭咯～",
+	ReminderMedication: "主人，该给%s吃药啦，按时吃药才能快快好起来！",
+	ReminderVaccine:    "主人，%s该去打疫苗啦，记得提前预约医院哦～",
+	ReminderDeworming:  "主人，%s该驱虫啦，别忘了哦～",
+	ReminderOther:      "主人，%s来提醒你啦～",
+}
+
+// Reminder 护理提醒（reminders 表）
+type Reminder struct {
+	ID         int64      // 提醒ID
+	UserID     int64      // 用户ID
+	PetID      int64      // 宠物ID
+	Kind       string     // 类型 feeding/medication/vaccine/deworming/other
+	Title      string     // 标题
+	Note       string     // 备注
+	RRule      string     // 重复规则（规范化的 RRULE，空为不重复）
+	Timezone   string     // IANA 时区，起始时间与重复规则按该时区解释
+	StartAt    string     // 起始时间 yyyy-MM-dd HH:mm（提醒时区）
+	Enabled    bool       // 是否启用
+	NextRunAt  *time.Time // 下次提醒时间（停用或已结束为 nil）
+	LastRunAt  *time.Time // 上次提醒时间
+	FiredCount int32      // 已提醒次数
+	CreatedAt  time.Time  // 创建时间
+	UpdatedAt  time.Time  // 更新时间
+}
+
+// ReminderRepo 提醒仓储
+// List: petID 为 0 返回全部宠物的提醒（按 ID 升序）
+// Get/Update/Delete: 不存在或不属于该用户返回 ErrReminderNotFound
+// ListDue: 启用且 next_run_at <= now 的提醒（按 next_run_at 升序，最多 limit 条）
+// Advance: 仅当 next_run_at 仍为 prev 时推进到 next 并记录本次提醒，返回是否推进成功（多实例下只有一个成功）
+// Postpone: 仅当 next_run_at 仍为 prev 时推迟到 until（不计为一次提醒），返回是否推迟成功
+type ReminderRepo interface {
+	Create(ctx context.Context, r *Reminder) error
+	Get(ctx context.Context, userID, id int64) (*Reminder, error)
+	List(ctx context.Context, userID, petID int64) ([]*Reminder, error)
+	Count(ctx context.Context, userID int64) (int64, error)
+	Update(ctx context.Context, r *Reminder) error
+	Delete(ctx context.Context, userID, id int64) error
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*Reminder, error)
+	Advance(ctx context.Context, id int64, prev time.Time, next *time.Time, firedAt time.Time) (bool, error)
+	Postpone(ctx context.Context, id int64, prev, until time.Time) (bool, error)
+}
+
+// ReminderUsecase 护理提醒：按 RRULE 与时区计算下次提醒时间，到点以宠物口吻写一条提醒消息
+type ReminderUsecase struct {
+	repo     ReminderRepo
+	pets     *PetUsecase
+	messages MessageRepo
+	tx       Transaction
+	log      *log.Helper
+}
+
+func NewReminderUsecase(repo ReminderRepo, pets *PetUsecase, messages MessageRepo, tx Transaction, logger log.Logger) *ReminderUsecase {
+	return &ReminderUsecase{repo: repo, pets: pets, messages: messages, tx: tx, log: log.NewHelper(logger)}
+}
+
+// Create 创建提醒（petID 为 0 时使用当前宠物）
+func (uc *ReminderUsecase) Create(ctx context.Context, r *Reminder) (*Reminder, error) {
+	p, err := uc.pets.Resolve(ctx, r.UserID, r.PetID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, ErrPetNotFound
+	}
+	r.PetID = p.ID
+	r.Enabled = true
+	if err := uc.prepare(r, time.Now()); err != nil {
+		return nil, err
+	}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		n, err := uc.repo.Count(ctx, r.UserID)
+		if err != nil {
+			return err
+		}
+		if n >= MaxRemindersPerUser {
+			return ErrReminderLimitReached
+		}
+		now := time.Now()
+		r.CreatedAt, r.UpdatedAt = now, now
+		return uc.repo.Create(ctx, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Get 提醒详情
+func (uc *ReminderUsecase) Get(ctx context.Context, userID, id int64) (*Reminder, error) {
+	return uc.repo.Get(ctx, userID, id)
+}
+
+// List 提醒列表；petID 为 0 返回全部宠物的提醒，否则须为该用户的宠物
+func (uc *ReminderUsecase) List(ctx context.Context, userID, petID int64) ([]*Reminder, error) {
+	if petID != 0 {
+		if _, err := uc.pets.Resolve(ctx, userID, petID); err != nil {
+			return nil, err
+		}
+	}
+	return uc.repo.List(ctx, userID, petID)
+}
+
+// Update 修改提醒（整条覆盖，宠物与启用状态不变），按新规则从现在起重新计算下次提醒时间
+func (uc *ReminderUsecase) Update(ctx context.Context, in *Reminder) (*Reminder, error) {
+	r, err := uc.repo.Get(ctx, in.UserID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+	r.Kind, r.Title, r.Note, r.RRule, r.Timezone, r.StartAt = in.Kind, in.Title, in.Note, in.RRule, in.Timezone, in.StartAt
+	if err := uc.prepare(r, time.Now()); err != nil {
+		return nil, err
+	}
+	r.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// SetEnabled 启用/停用提醒；启用时从现在起计算下次提醒时间（错过的不补发）
+func (uc *ReminderUsecase) SetEnabled(ctx context.Context, userID, id int64, enabled bool) (*Reminder, error) {
+	r, err := uc.repo.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	r.Enabled = enabled
+	if err := uc.prepare(r, time.Now()); err != nil {
+		return nil, err
+	}
+	r.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Delete 删除提醒
+func (uc *ReminderUsecase) Delete(ctx context.Context, userID, id int64) error {
+	return uc.repo.Delete(ctx, userID, id)
+}
+
+// prepare 校验字段、规范化规则与时区，并计算 now 之后的下次提醒时间（停用时为 nil）
+func (uc *ReminderUsecase) prepare(r *Reminder, now time.Time) error {
+	if _, ok := reminderLines[r.Kind]; !ok {
+		return ErrInvalidReminder("kind must be one of feeding, medication, vaccine, deworming, other")
+	}
+	r.Title = strings.TrimSpace(r.Title)
+	if r.Title == "" {
+		return ErrInvalidReminder("title is required")
+	}
+	if utf8.RuneCountInString(r.Title) > 50 {
+		return ErrInvalidReminder("title must be at most 50 characters")
+	}
+	if utf8.RuneCountInString(r.Note) > 255 {
+		return ErrInvalidReminder("note must be at most 255 characters")
+	}
+	if r.Timezone == "" {
+		r.Timezone = defaultReminderTimezone
+	}
+	loc, err := time.LoadLocation(r.Timezone)
+	// LoadLocation 接受 "Local"，其含义取决于服务器配置，不允许客户端使用
+	if err != nil || r.Timezone == "Local" {
+		return ErrInvalidTimezone
+	}
+	start, err := time.ParseInLocation(ReminderStartLayout, strings.TrimSpace(r.StartAt), loc)
+	if err != nil {
+		return ErrInvalidReminder("start_at must be in YYYY-MM-DD HH:MM format")
+	}
+	r.StartAt = start.Format(ReminderStartLayout)
+	rec, err := ParseRecurrence(r.RRule)
+	if err != nil {
+		return ErrInvalidReminder("invalid rrule: %v", err)
+	}
+	r.RRule = rec.String()
+	r.NextRunAt = nil
+	if !r.Enabled {
+		return nil
+	}
+	next, ok := rec.Next(start, now)
+	if !ok {
+		return ErrInvalidReminder("reminder has no upcoming occurrence")
+	}
+	r.NextRunAt = &next
+	return nil
+}
+
+// FireDue 发送 now 时刻已到期的提醒，返回发送条数
+// 每条提醒只发送一次（错过的多次合并为一次），随后推进到 now 之后的下一次；单条失败只记日志
+func (uc *ReminderUsecase) FireDue(ctx context.Context, now time.Time) (int, error) {
+	fired := 0
+	// 每轮最多 reminderBatch 条；发送失败的提醒推迟 reminderRetryDelay 后重试，不会在后续轮次中被重复读取
+	for round := 0; round < 10; round++ {
+		list, err := uc.repo.ListDue(ctx, now, reminderBatch)
+		if err != nil {
+			return fired, err
+		}
+		for _, r := range list {
+			ok, err := uc.fire(ctx, r, now)
+			if err != nil {
+				uc.log.WithContext(ctx).Errorf("fire reminder %d failed, retry in %s: %v", r.ID, reminderRetryDelay, err)
+				if _, err := uc.repo.Postpone(ctx, r.ID, *r.NextRunAt, now.Add(reminderRetryDelay)); err != nil {
+					return fired, err
+				}
+				continue
+			}
+			if ok {
+				fired++
+			}
+		}
+		if len(list) < reminderBatch {
+			break
+		}
+	}
+	return fired, nil
+}
+
+// fire 推进提醒并写入提醒消息（同一事务）；已被其他实例处理返回 false
+func (uc *ReminderUsecase) fire(ctx context.Context, r *Reminder, now time.Time) (bool, error) {
+	next, err := r.nextAfter(now)
+	if err != nil {
+		// 规则已无法解析（如时区数据变化），停止该提醒
+		uc.log.WithContext(ctx).Warnf("reminder %d: %v, stopping", r.ID, err)
+	}
+	fired := false
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		ok, err := uc.repo.Advance(ctx, r.ID, *r.NextRunAt, next, now)
+		if err != nil || !ok {
+			return err
+		}
+		fired = true
+		name := "我"
+		if p, err := uc.pets.Resolve(ctx, r.UserID, r.PetID); err == nil && p != nil && p.Name != "" {
+			name = p.Name
+		}
+		return uc.messages.CreateMessage(ctx, &Message{
+			UserID:      r.UserID,
+			PetID:       r.PetID,
+			Sender:      1,
+			MessageType: MessageTypeReminder,
+			Content:     reminderContent(r, name),
+			CreatedAt:   now,
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+	return fired, nil
+}
+
+// nextAfter now 之后的下一次提醒时间，序列已结束为 nil
+func (r *Reminder) nextAfter(now time.Time) (*time.Time, error) {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.ParseInLocation(ReminderStartLayout, r.StartAt, loc)
+	if err != nil {
+		return nil, err
+	}
+	rec, err := ParseRecurrence(r.RRule)
+	if err != nil {
+		return nil, err
+	}
+	next, ok := rec.Next(start, now)
+	if !ok {
+		return nil, nil
+	}
+	return &next, nil
+}
+
+// reminderContent 宠物口吻的提醒消息
+func reminderContent(r *Reminder, petName string) string {
+	s := fmt.Sprintf(reminderLines[r.Kind], petName) + "\n【" + r.Title + "】"
+	if r.Note != "" {
+		s += " " + r.Note
+	}
+	return s
+}
+
+// ReminderScheduler 提醒调度器：按固定周期发送到期提醒
+// 实现 kratos transport.Server，随应用启动/停止；多实例部署时由 ReminderRepo.Advance 保证同一次提醒只发送一次
+type ReminderScheduler struct {
+	uc       *ReminderUsecase
+	interval time.Duration
+	log      *log.Helper
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func NewReminderScheduler(uc *ReminderUsecase, logger log.Logger) *ReminderScheduler {
+	return &ReminderScheduler{
+		uc:       uc,
+		interval: 30 * time.Second,
+		log:      log.NewHelper(logger),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 启动调度循环（阻塞直到 Stop）
+func (s *ReminderScheduler) Start(ctx context.Context) error {
+	defer close(s.done)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if n, err := s.uc.FireDue(ctx, time.Now()); err != nil {
+				s.log.Errorf("fire due reminders failed: %v", err)
+			} else if n > 0 {
+				s.log.Infof("fired %d reminders", n)
+			}
+		case <-s.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop 停止调度循环，等待正在进行的一轮结束
+func (s *ReminderScheduler) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// 用户角色（users.role）
+const (
+	RoleUser  = "user"  // 普通用户（默认）
+	RoleAdmin = "admin" // 运营/管理员：可修改共享目录数据（模型、道具、分类）与触发后台任务
+)
+
+// ValidRole 是否为已知角色
+func ValidRole(role string) bool {
+	return role == RoleUser || role == RoleAdmin
+}
+
+// Roles 写入 access token 的角色列表（旧数据无角色时视为普通用户）
+func (u *User) Roles() []string {
+	if u.Role == "" {
+		return []string{RoleUser}
+	}
+	return []string{u.Role}
+}
+
+// SeedAdmin 初始化管理员账号（供命令行使用）
+// 用户不存在时按注册规则创建并设为管理员；已存在时提升为管理员，password 非空则同时重置密码
+// 返回是否新建了用户
+func SeedAdmin(ctx context.Context, repo AuthRepo, username, password string) (*User, bool, error) {
+	username = strings.TrimSpace(username)
+	u, err := repo.GetByUsername(ctx, username)
+	if err != nil {
+		if !errors.Is(err, ErrUserNotFound) {
+			return nil, false, err
+		}
+		if err := ValidateUsername(username); err != nil {
+			return nil, false, err
+		}
+		if err := ValidatePassword(password); err != nil {
+			return nil, false, err
+		}
+		u = &User{Username: username, Password: password, Nickname: username, Role: RoleAdmin}
+		id, err := repo.Create(ctx, u)
+		if err != nil {
+			return nil, false, err
+		}
+		u.Id = id
+		return u, true, nil
+	}
+	if password != "" {
+		if err := ValidatePassword(password); err != nil {
+			return nil, false, err
+		}
+		if err := repo.UpdatePassword(ctx, u.Id, password); err != nil {
+			return nil, false, err
+		}
+	}
+	if err := repo.UpdateRole(ctx, u.Id, RoleAdmin); err != nil {
+		return nil, false, err
+	}
+	u.Role = RoleAdmin
+	return u, false, nil
+}
@@ -0,0 +1,359 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	jwtutil "pet-angel/internal/util/jwt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrInvalidRefreshToken refresh token 不存在、已轮换、已吊销或已过期
+	ErrInvalidRefreshToken = errors.Unauthorized("INVALID_REFRESH_TOKEN", "invalid or expired refresh token")
+	// ErrSessionNotFound 会话不存在
+	ErrSessionNotFound = errors.NotFound("SESSION_NOT_FOUND", "session not found")
+	// ErrRecentLoginRequired 敏感操作要求当前会话是刚刚登录的（无密码账号的二次确认）
+	ErrRecentLoginRequired = errors.Forbidden("RECENT_LOGIN_REQUIRED", "please log in again to confirm")
+)
+
+// Session 登录会话（一条记录对应一个 refresh token 链）
+// refresh token 每次刷新都会轮换，库中只保存其 SHA-256 摘要
+type Session struct {
+	ID              int64      // 会话ID
+	UserID          int64      // 用户ID
+	RefreshHash     string     // 当前 refresh token 摘要（hex）
+	AccessJTI       string     // 当前 access token 的 jti
+	AccessExpiresAt time.Time  // 当前 access token 过期时间
+	ExpiresAt       time.Time  // refresh token 过期时间
+	UserAgent       string     // 登录时的 User-Agent
+	IP              string     // 登录时的客户端 IP
+	LastSeenAt      time.Time  // 最近活跃时间（批量刷新，存在分钟级延迟）
+	RevokedAt       *time.Time // 吊销时间（nil 表示有效）
+	CreatedAt       time.Time  // 创建时间
+	UpdatedAt       time.Time  // 更新时间
+}
+
+// ClientInfo 发起登录的客户端信息
+type ClientInfo struct {
+	UserAgent string
+	IP        string
+}
+
+// Active 会话是否仍可用于刷新
+func (s *Session) Active(now time.Time) bool {
+	return s != nil && s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// SessionRepo 会话仓储
+// Create: 写入会话后回填 ID 与时间字段
+// Rotate: 以 oldHash 为条件原子轮换，条件不满足（已被并发轮换/吊销）返回 ErrInvalidRefreshToken
+// RevokeAll: 吊销用户全部有效会话（exceptID>0 时保留该会话），返回被吊销的会话（用于拉黑其 access token）
+// TouchLastSeen: 批量刷新最近活跃时间（sessionID -> 时间）
+type SessionRepo interface {
+	Create(ctx context.Context, s *Session) (int64, error)
+	GetByID(ctx context.Context, id int64) (*Session, error)
+	GetByRefreshHash(ctx context.Context, hash string) (*Session, error)
+	ListActive(ctx context.Context, userID int64, now time.Time) ([]*Session, error)
+	Rotate(ctx context.Context, id int64, oldHash string, next *Session) error
+	Revoke(ctx context.Context, id int64) error
+	RevokeAll(ctx context.Context, userID, exceptID int64) ([]*Session, error)
+	TouchLastSeen(ctx context.Context, seen map[int64]time.Time) error
+}
+
+// TokenDenylist 已吊销 access token（jti）名单
+// 记录保留到 token 自然过期为止
+type TokenDenylist interface {
+	Add(ctx context.Context, jti string, ttl time.Duration) error
+	Contains(ctx context.Context, jti string) (bool, error)
+}
+
+// TokenPair 登录/刷新返回的令牌对
+type TokenPair struct {
+	AccessToken      string // access token（JWT）
+	ExpiresIn        int32  // access token 有效期（秒）
+	RefreshToken     string // refresh token（不透明随机串）
+	RefreshExpiresIn int32  // refresh token 有效期（秒）
+}
+
+// newRefreshToken 生成 refresh token 及其摘要
+func newRefreshToken() (token, hash string) {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	token = hex.EncodeToString(b)
+	return token, hashRefreshToken(token)
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Refresh 使用 refresh token 换取新的令牌对（refresh token 同时轮换，旧 token 立即失效）
+func (uc *AuthUsecase) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	if refreshToken == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+	oldHash := hashRefreshToken(refreshToken)
+	s, err := uc.sessions.GetByRefreshHash(ctx, oldHash)
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+	if !s.Active(time.Now()) {
+		return nil, ErrInvalidRefreshToken
+	}
+	// 每次续期重新读取角色，角色变更在下一次刷新时生效
+	u, err := uc.repo.GetByID(ctx, s.UserID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+	next, pair, err := uc.newTokens(u, s.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.sessions.Rotate(ctx, s.ID, oldHash, next); err != nil {
+		return nil, err
+	}
+	// 旧 access token 随之作废
+	uc.deny(ctx, s.AccessJTI, s.AccessExpiresAt)
+	return pair, nil
+}
+
+// Logout 退出当前会话：拉黑当前 access token 并吊销其所属会话
+func (uc *AuthUsecase) Logout(ctx context.Context, sessionID int64, jti string, accessExpiresAt time.Time) error {
+	if jti != "" {
+		if err := uc.denylist.Add(ctx, jti, time.Until(accessExpiresAt)); err != nil {
+			return err
+		}
+	}
+	// 旧版 token 不带 sid/jti，无法定位会话，只能等待其自然过期
+	if sessionID <= 0 {
+		return nil
+	}
+	return uc.sessions.Revoke(ctx, sessionID)
+}
+
+// LogoutAll 退出全部设备：吊销用户所有会话并拉黑对应 access token
+func (uc *AuthUsecase) LogoutAll(ctx context.Context, userID int64) error {
+	return uc.revokeSessions(ctx, userID, 0)
+}
+
+// revokeSessions 吊销用户会话（保留 exceptID）并拉黑对应 access token
+func (uc *AuthUsecase) revokeSessions(ctx context.Context, userID, exceptID int64) error {
+	revoked, err := uc.sessions.RevokeAll(ctx, userID, exceptID)
+	if err != nil {
+		return err
+	}
+	for _, s := range revoked {
+		uc.deny(ctx, s.AccessJTI, s.AccessExpiresAt)
+	}
+	return nil
+}
+
+// ListSessions 当前用户的有效会话（未吊销且 refresh token 未过期），按最近活跃倒序
+func (uc *AuthUsecase) ListSessions(ctx context.Context, userID int64) ([]*Session, error) {
+	return uc.sessions.ListActive(ctx, userID, time.Now())
+}
+
+// RevokeSession 踢下线指定会话（仅限本人会话），其 access token 立即失效
+func (uc *AuthUsecase) RevokeSession(ctx context.Context, userID, sessionID int64) error {
+	s, err := uc.sessions.GetByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if s.UserID != userID {
+		// 不暴露他人会话是否存在
+		return ErrSessionNotFound
+	}
+	if s.RevokedAt != nil {
+		return nil
+	}
+	if err := uc.sessions.Revoke(ctx, s.ID); err != nil {
+		return err
+	}
+	uc.deny(ctx, s.AccessJTI, s.AccessExpiresAt)
+	return nil
+}
+
+// startSession 为用户创建新会话并签发令牌对
+// 先落库拿到会话ID，再签发携带 sid 的 access token
+func (uc *AuthUsecase) startSession(ctx context.Context, u *User, client ClientInfo) (*TokenPair, error) {
+	refresh, hash := newRefreshToken()
+	now := time.Now()
+	s := &Session{
+		UserID:          u.Id,
+		RefreshHash:     hash,
+		AccessJTI:       jwtutil.NewTokenID(),
+		AccessExpiresAt: now.Add(uc.accessTTL()),
+		ExpiresAt:       now.Add(uc.refreshTTL()),
+		UserAgent:       truncate(client.UserAgent, 255),
+		IP:              truncate(client.IP, 64),
+		LastSeenAt:      now,
+	}
+	id, err := uc.sessions.Create(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	access, exp, err := uc.signAccess(u, id, s.AccessJTI)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{
+		AccessToken:      access,
+		ExpiresIn:        int32(time.Until(exp).Seconds()),
+		RefreshToken:     refresh,
+		RefreshExpiresIn: int32(uc.refreshTTL().Seconds()),
+	}, nil
+}
+
+// newTokens 为已有会话签发新的 access token 与 refresh token，返回待落库的会话字段
+func (uc *AuthUsecase) newTokens(u *User, sessionID int64) (*Session, *TokenPair, error) {
+	jti := jwtutil.NewTokenID()
+	access, exp, err := uc.signAccess(u, sessionID, jti)
+	if err != nil {
+		return nil, nil, err
+	}
+	refresh, hash := newRefreshToken()
+	refreshTTL := uc.refreshTTL()
+	s := &Session{
+		ID:              sessionID,
+		UserID:          u.Id,
+		RefreshHash:     hash,
+		AccessJTI:       jti,
+		AccessExpiresAt: exp,
+		ExpiresAt:       time.Now().Add(refreshTTL),
+	}
+	return s, &TokenPair{
+		AccessToken:      access,
+		ExpiresIn:        int32(time.Until(exp).Seconds()),
+		RefreshToken:     refresh,
+		RefreshExpiresIn: int32(refreshTTL.Seconds()),
+	}, nil
+}
+
+// signAccess 签发携带 sid 与角色的 access token
+func (uc *AuthUsecase) signAccess(u *User, sessionID int64, jti string) (string, time.Time, error) {
+	claims := jwtutil.NewClaims(u.Id, sessionID, jti, uc.accessTTL())
+	claims.Roles = u.Roles()
+	access, err := uc.keys.Sign(claims)
+	return access, claims.ExpiresAt.Time, err
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
+
+// deny 拉黑 access token，失败仅影响其在剩余有效期内可用，不阻断主流程
+func (uc *AuthUsecase) deny(ctx context.Context, jti string, exp time.Time) {
+	if jti == "" || !time.Now().Before(exp) {
+		return
+	}
+	_ = uc.denylist.Add(ctx, jti, time.Until(exp))
+}
+
+func (uc *AuthUsecase) accessTTL() time.Duration {
+	if uc.cfg != nil && uc.cfg.JwtTtl != nil {
+		return uc.cfg.JwtTtl.AsDuration()
+	}
+	return 15 * time.Minute
+}
+
+func (uc *AuthUsecase) refreshTTL() time.Duration {
+	if uc.cfg != nil && uc.cfg.RefreshTtl != nil {
+		return uc.cfg.RefreshTtl.AsDuration()
+	}
+	return 30 * 24 * time.Hour
+}
+
+// SessionTracker 会话“最近活跃”批量记录器
+// 请求路径上只做内存标记（同一会话在一个周期内只保留最后一次），由后台按周期批量落库，
+// 避免每个请求都写一次 user_sessions。实现 kratos transport.Server，随应用启动/停止。
+type SessionTracker struct {
+	repo     SessionRepo
+	interval time.Duration
+	log      *log.Helper
+
+	mu       sync.Mutex
+	pending  map[int64]time.Time
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func NewSessionTracker(repo SessionRepo, logger log.Logger) *SessionTracker {
+	return &SessionTracker{
+		repo:     repo,
+		interval: time.Minute,
+		log:      log.NewHelper(logger),
+		pending:  make(map[int64]time.Time),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Touch 标记会话活跃（请求路径调用，仅内存操作）
+func (t *SessionTracker) Touch(sessionID int64) {
+	if t == nil || sessionID <= 0 {
+		return
+	}
+	t.mu.Lock()
+	t.pending[sessionID] = time.Now()
+	t.mu.Unlock()
+}
+
+// Flush 将待写入的活跃时间批量落库
+func (t *SessionTracker) Flush(ctx context.Context) error {
+	t.mu.Lock()
+	if len(t.pending) == 0 {
+		t.mu.Unlock()
+		return nil
+	}
+	batch := t.pending
+	t.pending = make(map[int64]time.Time, len(batch))
+	t.mu.Unlock()
+	return t.repo.TouchLastSeen(ctx, batch)
+}
+
+// Start 启动后台刷写循环（阻塞直到 Stop）
+func (t *SessionTracker) Start(ctx context.Context) error {
+	defer close(t.done)
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := t.Flush(ctx); err != nil {
+				t.log.Errorf("flush session last seen failed: %v", err)
+			}
+		case <-t.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop 停止刷写循环并落库剩余数据
+func (t *SessionTracker) Stop(ctx context.Context) error {
+	t.stopOnce.Do(func() { close(t.stop) })
+	select {
+	case <-t.done:
+	case <-ctx.Done():
+	}
+	return t.Flush(ctx)
+}
@@ -7,13 +7,16 @@ import (
 
 // User 表示 users 表对应的业务实体
 // 注意：Password 应存储 bcrypt 哈希；Weight 单位为 kg 的整数
+// 宠物字段（ModelID ~ Hobby）来自当前宠物（pets 表，users.active_pet_id），尚无宠物时为零值
 
 type User struct {
 	Id          int64     // users.id 主键
 	Username    string    // 登录名（唯一）
-	Password    string    // 密码哈希
+	Password    string    // 密码哈希（为空表示未设置密码）
+	Phone       string    // 手机号（E.164，可为空）
 	Nickname    string    // 昵称
 	Avatar      string    // 头像URL
+	ActivePetID int64     // 当前宠物ID（0 表示尚无宠物）
 	ModelID     int64     // 当前模型ID
 	ModelURL    string    // 当前模型URL（与 pet_models.path 一致）
 	PetName     string    // 宠物名称
@@ -24,6 +27,7 @@ type User struct {
 	Hobby       string    // 爱好
 	Description string    // 简介
 	Coins       int32     // 金币余额
+	Role        string    // 角色 user/admin
 	CreatedAt   time.Time // 创建时间
 	UpdatedAt   time.Time // 更新时间
 }
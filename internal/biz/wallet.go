@@ -0,0 +1,199 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrInvalidCoinReason 流水原因筛选值不合法
+	ErrInvalidCoinReason = errors.BadRequest("INVALID_COIN_REASON", "reason must be one of item_purchase, item_use, note_unlock, model_unlock, reward, gift")
+	// ErrInvalidCursor 分页游标不合法
+	ErrInvalidCursor = errors.BadRequest("INVALID_CURSOR", "invalid cursor")
+)
+
+// 金币流水原因
+const (
+	CoinReasonItemPurchase = "item_purchase" // 购买道具
+	CoinReasonItemUse      = "item_use"      // 使用道具（背包上线前每次使用扣费，仅存在于历史流水）
+	CoinReasonNoteUnlock   = "note_unlock"   // 解锁小纸条
+	CoinReasonModelUnlock  = "model_unlock"  // 解锁宠物模型
+	CoinReasonReward       = "reward"        // 奖励
+	CoinReasonGift         = "gift"          // 用户间赠送
+)
+
+var coinReasons = map[string]bool{
+	CoinReasonItemPurchase: true, CoinReasonItemUse: true, CoinReasonNoteUnlock: true, CoinReasonModelUnlock: true, CoinReasonReward: true, CoinReasonGift: true,
+}
+
+// 金币流水关联对象类型
+const (
+	CoinRefItem     = "item"      // items.id
+	CoinRefMessage  = "message"   // messages.id
+	CoinRefPost     = "post"      // posts.id
+	CoinRefCheckIn  = "check_in"  // check_ins.id
+	CoinRefPetModel = "pet_model" // pet_models.id
+)
+
+// CoinRef 流水关联的业务对象（可为空）
+type CoinRef struct {
+	Type string // 见 CoinRef* 常量
+	ID   int64
+}
+
+// CoinTransaction 金币流水（coin_transactions 表）
+type CoinTransaction struct {
+	ID             int64     // 流水ID
+	UserID         int64     // 用户ID
+	Amount         int32     // 变动金额：正数入账，负数出账
+	Balance        int32     // 变动后余额（Migrated 时未知，为 0）
+	Reason         string    // 原因，见 CoinReason* 常量
+	RefType        string    // 关联对象类型
+	RefID          int64     // 关联对象ID
+	CounterpartyID int64     // 转账对方用户ID（非转账为 0）
+	Migrated       bool      // 由历史解锁记录迁移而来（无余额快照）
+	CreatedAt      time.Time // 发生时间
+
+	RefTitle string // 关联对象标题（仅查询时填充）
+}
+
+// Transaction 事务管理：fn 内通过 ctx 调用的仓储方法处于同一事务中，嵌套调用合并为外层事务
+type Transaction interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// WalletRepo 金币余额与流水仓储（users.coins 仅由此仓储修改）
+// Lock: 按 ID 升序对用户行加排他锁（须在事务内调用），任一用户不存在返回 ErrUserNotFound
+// Apply: 按 t.Amount 变更余额并写入流水，回填 t.ID 与 t.Balance；余额不足返回 ErrInsufficientCoins
+// Balance: 读取当前余额
+// List: 按 ID 倒序返回 ID < beforeID（beforeID 为 0 时不限）的流水，reason 为空不筛选，并填充 RefTitle
+type WalletRepo interface {
+	Lock(ctx context.Context, userIDs ...int64) error
+	Apply(ctx context.Context, t *CoinTransaction) error
+	Balance(ctx context.Context, userID int64) (int32, error)
+	List(ctx context.Context, userID int64, reason string, beforeID int64, limit int) ([]*CoinTransaction, error)
+}
+
+// WalletUsecase 金币入账/扣减/转账，是修改用户金币的唯一入口
+// 每个操作在一个事务内完成：锁定用户行、变更余额并写入带原因与关联对象的流水
+type WalletUsecase struct {
+	repo WalletRepo
+	tx   Transaction
+	log  *log.Helper
+}
+
+func NewWalletUsecase(repo WalletRepo, tx Transaction, logger log.Logger) *WalletUsecase {
+	return &WalletUsecase{repo: repo, tx: tx, log: log.NewHelper(logger)}
+}
+
+// Balance 当前金币余额
+func (uc *WalletUsecase) Balance(ctx context.Context, userID int64) (int32, error) {
+	return uc.repo.Balance(ctx, userID)
+}
+
+// ListTransactions 游标分页查询流水（按时间倒序），返回下一页游标（为空表示没有更多）
+func (uc *WalletUsecase) ListTransactions(ctx context.Context, userID int64, cursor string, pageSize int32, reason string) ([]*CoinTransaction, string, error) {
+	if reason != "" && !coinReasons[reason] {
+		return nil, "", ErrInvalidCoinReason
+	}
+	beforeID, limit, err := parseIDCursor(cursor, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	list, err := uc.repo.List(ctx, userID, reason, beforeID, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	next := ""
+	if len(list) > limit {
+		list = list[:limit]
+		next = strconv.FormatInt(list[len(list)-1].ID, 10)
+	}
+	return list, next, nil
+}
+
+// parseIDCursor 解析按 ID 倒序分页的游标（上一页最后一条的 ID），每页条数默认 20、最大 100
+func parseIDCursor(cursor string, pageSize int32) (beforeID int64, limit int, err error) {
+	if cursor != "" {
+		id, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil || id <= 0 {
+			return 0, 0, ErrInvalidCursor
+		}
+		beforeID = id
+	}
+	switch {
+	case pageSize <= 0:
+		limit = 20
+	case pageSize > 100:
+		limit = 100
+	default:
+		limit = int(pageSize)
+	}
+	return beforeID, limit, nil
+}
+
+// Lock 锁定用户的余额行（须在事务内调用），同一用户在其后的检查与入账/扣减串行执行；用户不存在返回 ErrUserNotFound
+func (uc *WalletUsecase) Lock(ctx context.Context, userID int64) error {
+	return uc.repo.Lock(ctx, userID)
+}
+
+// Credit 入账 amount（>0）金币
+func (uc *WalletUsecase) Credit(ctx context.Context, userID int64, amount int32, reason string, ref CoinRef) (*CoinTransaction, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidParameter
+	}
+	return uc.apply(ctx, userID, amount, reason, ref)
+}
+
+// Debit 扣减 amount（>0）金币，余额不足返回 ErrInsufficientCoins
+func (uc *WalletUsecase) Debit(ctx context.Context, userID int64, amount int32, reason string, ref CoinRef) (*CoinTransaction, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidParameter
+	}
+	return uc.apply(ctx, userID, -amount, reason, ref)
+}
+
+func (uc *WalletUsecase) apply(ctx context.Context, userID int64, delta int32, reason string, ref CoinRef) (*CoinTransaction, error) {
+	if reason == "" {
+		return nil, ErrInvalidParameter
+	}
+	t := &CoinTransaction{UserID: userID, Amount: delta, Reason: reason, RefType: ref.Type, RefID: ref.ID, CreatedAt: time.Now()}
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Lock(ctx, userID); err != nil {
+			return err
+		}
+		return uc.repo.Apply(ctx, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Transfer 从 from 转 amount 金币给 to，双方各写一条流水（互为 counterparty）
+// 返回转出方与转入方流水；转出方余额不足返回 ErrInsufficientCoins
+func (uc *WalletUsecase) Transfer(ctx context.Context, from, to int64, amount int32, reason string, ref CoinRef) (out, in *CoinTransaction, err error) {
+	if from == to || amount <= 0 || reason == "" {
+		return nil, nil, ErrInvalidParameter
+	}
+	now := time.Now()
+	out = &CoinTransaction{UserID: from, Amount: -amount, Reason: reason, RefType: ref.Type, RefID: ref.ID, CounterpartyID: to, CreatedAt: now}
+	in = &CoinTransaction{UserID: to, Amount: amount, Reason: reason, RefType: ref.Type, RefID: ref.ID, CounterpartyID: from, CreatedAt: now}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Lock(ctx, from, to); err != nil {
+			return err
+		}
+		if err := uc.repo.Apply(ctx, out); err != nil {
+			return err
+		}
+		return uc.repo.Apply(ctx, in)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, in, nil
+}
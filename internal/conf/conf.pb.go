@@ -29,6 +29,8 @@ type Bootstrap struct {
 	Auth          *Auth                  `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`       // JWT 配置
 	Minio         *Minio                 `protobuf:"bytes,4,opt,name=minio,proto3" json:"minio,omitempty"`     // MinIO 对象存储（可选）
 	Storage       *Storage               `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty"` // 本地存储（开发阶段使用）
+	Notify        *Notify                `protobuf:"bytes,6,opt,name=notify,proto3" json:"notify,omitempty"`   // 通知发送（验证码等）
+	Rewards       *Rewards               `protobuf:"bytes,7,opt,name=rewards,proto3" json:"rewards,omitempty"` // 金币奖励规则
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,29 +100,652 @@ func (x *Bootstrap) GetStorage() *Storage {
 	return nil
 }
 
+func (x *Bootstrap) GetNotify() *Notify {
+	if x != nil {
+		return x.Notify
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetRewards() *Rewards {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_conf_conf_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Server) GetHttp() *Server_HTTP {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Server) GetGrpc() *Server_GRPC {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+type Data struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	mi := &file_conf_conf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Data) GetDatabase() *Data_Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *Data) GetRedis() *Data_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+// 认证配置
+type Auth struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JwtSecret      string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`                // HMAC 密钥（兼容旧配置：未配置 jwt_keys 时作为唯一 HS256 密钥，kid 为空）
+	JwtTtl         *durationpb.Duration   `protobuf:"bytes,2,opt,name=jwt_ttl,json=jwtTtl,proto3" json:"jwt_ttl,omitempty"`                         // access token 有效期（默认 15m）
+	AutoRegister   bool                   `protobuf:"varint,3,opt,name=auto_register,json=autoRegister,proto3" json:"auto_register,omitempty"`      // 登录时用户不存在则自动注册（仅 demo 使用，默认关闭）
+	RefreshTtl     *durationpb.Duration   `protobuf:"bytes,4,opt,name=refresh_ttl,json=refreshTtl,proto3" json:"refresh_ttl,omitempty"`             // refresh token 有效期（默认 720h，每次刷新顺延）
+	TrustedProxies []string               `protobuf:"bytes,5,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"` // 可信反向代理（IP 或 CIDR）；仅当直连对端在其中时才采用 X-Forwarded-For / X-Real-IP 作为客户端 IP
+	ResetCodeTtl   *durationpb.Duration   `protobuf:"bytes,6,opt,name=reset_code_ttl,json=resetCodeTtl,proto3" json:"reset_code_ttl,omitempty"`     // 密码重置验证码有效期（默认 15m）
+	LoginThrottle  *LoginThrottle         `protobuf:"bytes,7,opt,name=login_throttle,json=loginThrottle,proto3" json:"login_throttle,omitempty"`    // 登录防暴力破解
+	JwtKeys        []*JwtKey              `protobuf:"bytes,8,rep,name=jwt_keys,json=jwtKeys,proto3" json:"jwt_keys,omitempty"`                      // 签名密钥环，按时间先后排列，最后一个可签发的密钥用于签发新 token
+	OauthProviders []*OAuthProvider       `protobuf:"bytes,9,rep,name=oauth_providers,json=oauthProviders,proto3" json:"oauth_providers,omitempty"` // 第三方登录渠道（未配置则 OAuthLogin 不可用）
+	LoginCode      *LoginCode             `protobuf:"bytes,10,opt,name=login_code,json=loginCode,proto3" json:"login_code,omitempty"`               // 手机验证码登录
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Auth) Reset() {
+	*x = Auth{}
+	mi := &file_conf_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth) ProtoMessage() {}
+
+func (x *Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
+func (*Auth) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Auth) GetJwtSecret() string {
+	if x != nil {
+		return x.JwtSecret
+	}
+	return ""
+}
+
+func (x *Auth) GetJwtTtl() *durationpb.Duration {
+	if x != nil {
+		return x.JwtTtl
+	}
+	return nil
+}
+
+func (x *Auth) GetAutoRegister() bool {
+	if x != nil {
+		return x.AutoRegister
+	}
+	return false
+}
+
+func (x *Auth) GetRefreshTtl() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshTtl
+	}
+	return nil
+}
+
+func (x *Auth) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
+func (x *Auth) GetResetCodeTtl() *durationpb.Duration {
+	if x != nil {
+		return x.ResetCodeTtl
+	}
+	return nil
+}
+
+func (x *Auth) GetLoginThrottle() *LoginThrottle {
+	if x != nil {
+		return x.LoginThrottle
+	}
+	return nil
+}
+
+func (x *Auth) GetJwtKeys() []*JwtKey {
+	if x != nil {
+		return x.JwtKeys
+	}
+	return nil
+}
+
+func (x *Auth) GetOauthProviders() []*OAuthProvider {
+	if x != nil {
+		return x.OauthProviders
+	}
+	return nil
+}
+
+func (x *Auth) GetLoginCode() *LoginCode {
+	if x != nil {
+		return x.LoginCode
+	}
+	return nil
+}
+
+// 手机验证码登录：验证码有效期、重发间隔与发送频率限制
+type LoginCode struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Ttl            *durationpb.Duration   `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`                                             // 验证码有效期（默认 5m）
+	ResendInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=resend_interval,json=resendInterval,proto3" json:"resend_interval,omitempty"` // 同一手机号两次发送的最小间隔（默认 60s）
+	MaxAttempts    int32                  `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`         // 单个验证码最多尝试次数，超过后作废（默认 5）
+	MaxPerPhone    int32                  `protobuf:"varint,4,opt,name=max_per_phone,json=maxPerPhone,proto3" json:"max_per_phone,omitempty"`       // 窗口内同一手机号最多发送次数（默认 5）
+	MaxPerIp       int32                  `protobuf:"varint,5,opt,name=max_per_ip,json=maxPerIp,proto3" json:"max_per_ip,omitempty"`                // 窗口内同一 IP 最多发送次数（默认 20）
+	Window         *durationpb.Duration   `protobuf:"bytes,6,opt,name=window,proto3" json:"window,omitempty"`                                       // 发送次数统计窗口（默认 1h）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LoginCode) Reset() {
+	*x = LoginCode{}
+	mi := &file_conf_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginCode) ProtoMessage() {}
+
+func (x *LoginCode) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginCode.ProtoReflect.Descriptor instead.
+func (*LoginCode) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LoginCode) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *LoginCode) GetResendInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ResendInterval
+	}
+	return nil
+}
+
+func (x *LoginCode) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *LoginCode) GetMaxPerPhone() int32 {
+	if x != nil {
+		return x.MaxPerPhone
+	}
+	return 0
+}
+
+func (x *LoginCode) GetMaxPerIp() int32 {
+	if x != nil {
+		return x.MaxPerIp
+	}
+	return 0
+}
+
+func (x *LoginCode) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// 第三方登录渠道
+type OAuthProvider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // 渠道名（请求中的 provider，小写字母开头，最长 16 位），如 wechat/apple
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`                                 // 实现：fake（本地离线模拟，code 即外部用户ID）
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`             // 应用ID（真实渠道使用）
+	ClientSecret  string                 `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"` // 应用密钥（真实渠道使用）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthProvider) Reset() {
+	*x = OAuthProvider{}
+	mi := &file_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthProvider) ProtoMessage() {}
+
+func (x *OAuthProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthProvider.ProtoReflect.Descriptor instead.
+func (*OAuthProvider) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OAuthProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OAuthProvider) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *OAuthProvider) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OAuthProvider) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+// JWT 签名密钥：token header 带 kid，校验时接受密钥环中任一密钥签发的 token
+// 轮换：追加新密钥并发布，待旧 token 过期后再移除旧密钥（或只保留其公钥）
+type JwtKey struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Kid            string                 `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`                                               // 密钥ID（唯一）
+	Alg            string                 `protobuf:"bytes,2,opt,name=alg,proto3" json:"alg,omitempty"`                                               // HS256 | ES256 | RS256
+	Secret         string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`                                         // HS256 对称密钥
+	PrivateKeyFile string                 `protobuf:"bytes,4,opt,name=private_key_file,json=privateKeyFile,proto3" json:"private_key_file,omitempty"` // ES256/RS256 私钥 PEM 文件（PKCS#1/SEC1/PKCS#8）
+	PublicKeyFile  string                 `protobuf:"bytes,5,opt,name=public_key_file,json=publicKeyFile,proto3" json:"public_key_file,omitempty"`    // ES256/RS256 公钥 PEM 文件；只配公钥时该密钥仅用于验签
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JwtKey) Reset() {
+	*x = JwtKey{}
+	mi := &file_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JwtKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JwtKey) ProtoMessage() {}
+
+func (x *JwtKey) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JwtKey.ProtoReflect.Descriptor instead.
+func (*JwtKey) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *JwtKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JwtKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JwtKey) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *JwtKey) GetPrivateKeyFile() string {
+	if x != nil {
+		return x.PrivateKeyFile
+	}
+	return ""
+}
+
+func (x *JwtKey) GetPublicKeyFile() string {
+	if x != nil {
+		return x.PublicKeyFile
+	}
+	return ""
+}
+
+// 登录失败限流：按用户名与客户端 IP 分别计数，达到阈值后锁定，锁定时长按指数退避递增
+type LoginThrottle struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MaxFailuresPerUser int32                  `protobuf:"varint,1,opt,name=max_failures_per_user,json=maxFailuresPerUser,proto3" json:"max_failures_per_user,omitempty"` // 同一用户名连续失败多少次后锁定（默认 5）
+	MaxFailuresPerIp   int32                  `protobuf:"varint,2,opt,name=max_failures_per_ip,json=maxFailuresPerIp,proto3" json:"max_failures_per_ip,omitempty"`       // 同一 IP 连续失败多少次后锁定（默认 20）
+	FailureWindow      *durationpb.Duration   `protobuf:"bytes,3,opt,name=failure_window,json=failureWindow,proto3" json:"failure_window,omitempty"`                     // 失败计数窗口，窗口内无新失败则清零（默认 15m）
+	BaseLockout        *durationpb.Duration   `protobuf:"bytes,4,opt,name=base_lockout,json=baseLockout,proto3" json:"base_lockout,omitempty"`                           // 首次锁定时长，之后每多失败一次翻倍（默认 30s）
+	MaxLockout         *durationpb.Duration   `protobuf:"bytes,5,opt,name=max_lockout,json=maxLockout,proto3" json:"max_lockout,omitempty"`                              // 锁定时长上限（默认 1h）
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LoginThrottle) Reset() {
+	*x = LoginThrottle{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginThrottle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginThrottle) ProtoMessage() {}
+
+func (x *LoginThrottle) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginThrottle.ProtoReflect.Descriptor instead.
+func (*LoginThrottle) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LoginThrottle) GetMaxFailuresPerUser() int32 {
+	if x != nil {
+		return x.MaxFailuresPerUser
+	}
+	return 0
+}
+
+func (x *LoginThrottle) GetMaxFailuresPerIp() int32 {
+	if x != nil {
+		return x.MaxFailuresPerIp
+	}
+	return 0
+}
+
+func (x *LoginThrottle) GetFailureWindow() *durationpb.Duration {
+	if x != nil {
+		return x.FailureWindow
+	}
+	return nil
+}
+
+func (x *LoginThrottle) GetBaseLockout() *durationpb.Duration {
+	if x != nil {
+		return x.BaseLockout
+	}
+	return nil
+}
+
+func (x *LoginThrottle) GetMaxLockout() *durationpb.Duration {
+	if x != nil {
+		return x.MaxLockout
+	}
+	return nil
+}
+
+// MinIO 配置
+type Minio struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                    // 例如 47.121.139.174:9000
+	AccessKey     string                 `protobuf:"bytes,2,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"` // 访问密钥
+	SecretKey     string                 `protobuf:"bytes,3,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"` // 密钥
+	UseSsl        bool                   `protobuf:"varint,4,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`         // 是否使用https
+	Bucket        string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`                        // 存储桶
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Minio) Reset() {
+	*x = Minio{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Minio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Minio) ProtoMessage() {}
+
+func (x *Minio) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Minio.ProtoReflect.Descriptor instead.
+func (*Minio) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Minio) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Minio) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *Minio) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Minio) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
+	}
+	return false
+}
+
+func (x *Minio) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+// 金币奖励规则
+type Rewards struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckIn       *CheckIn               `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"` // 每日签到
+	Activity      *Activity              `protobuf:"bytes,2,opt,name=activity,proto3" json:"activity,omitempty"`              // 社区与聊天活跃奖励
+	PetXp         *PetXP                 `protobuf:"bytes,3,opt,name=pet_xp,json=petXp,proto3" json:"pet_xp,omitempty"`       // 宠物经验
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server) Reset() {
-	*x = Server{}
-	mi := &file_conf_conf_proto_msgTypes[1]
+func (x *Rewards) Reset() {
+	*x = Rewards{}
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server) String() string {
+func (x *Rewards) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server) ProtoMessage() {}
+func (*Rewards) ProtoMessage() {}
 
-func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[1]
+func (x *Rewards) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -131,48 +756,56 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server.ProtoReflect.Descriptor instead.
-func (*Server) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Rewards.ProtoReflect.Descriptor instead.
+func (*Rewards) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Server) GetHttp() *Server_HTTP {
+func (x *Rewards) GetCheckIn() *CheckIn {
 	if x != nil {
-		return x.Http
+		return x.CheckIn
 	}
 	return nil
 }
 
-func (x *Server) GetGrpc() *Server_GRPC {
+func (x *Rewards) GetActivity() *Activity {
 	if x != nil {
-		return x.Grpc
+		return x.Activity
 	}
 	return nil
 }
 
-type Data struct {
+func (x *Rewards) GetPetXp() *PetXP {
+	if x != nil {
+		return x.PetXp
+	}
+	return nil
+}
+
+// 宠物经验配置
+type PetXP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Rules         []*PetXPRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`       // 经验规则（未配置时使用内置默认规则）
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // 每日上限按该时区的自然日计算（默认 Asia/Shanghai）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data) Reset() {
-	*x = Data{}
-	mi := &file_conf_conf_proto_msgTypes[2]
+func (x *PetXP) Reset() {
+	*x = PetXP{}
+	mi := &file_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data) String() string {
+func (x *PetXP) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data) ProtoMessage() {}
+func (*PetXP) ProtoMessage() {}
 
-func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[2]
+func (x *PetXP) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -183,49 +816,50 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data.ProtoReflect.Descriptor instead.
-func (*Data) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PetXP.ProtoReflect.Descriptor instead.
+func (*PetXP) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Data) GetDatabase() *Data_Database {
+func (x *PetXP) GetRules() []*PetXPRule {
 	if x != nil {
-		return x.Database
+		return x.Rules
 	}
 	return nil
 }
 
-func (x *Data) GetRedis() *Data_Redis {
+func (x *PetXP) GetTimezone() string {
 	if x != nil {
-		return x.Redis
+		return x.Timezone
 	}
-	return nil
+	return ""
 }
 
-// 认证配置
-type Auth struct {
+// 宠物经验规则（经验归行为发起者）
+type PetXPRule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JwtSecret     string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"` // HMAC 密钥
-	JwtTtl        *durationpb.Duration   `protobuf:"bytes,2,opt,name=jwt_ttl,json=jwtTtl,proto3" json:"jwt_ttl,omitempty"`          // token 有效期
+	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`                        // 事件：pet_chatted | item_used | post_created | comment_created | post_liked
+	Xp            int32                  `protobuf:"varint,2,opt,name=xp,proto3" json:"xp,omitempty"`                             // 每次获得经验，<=0 表示关闭该规则
+	DailyCap      int32                  `protobuf:"varint,3,opt,name=daily_cap,json=dailyCap,proto3" json:"daily_cap,omitempty"` // 每人每天该规则最多获得的经验，0 表示不限
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Auth) Reset() {
-	*x = Auth{}
-	mi := &file_conf_conf_proto_msgTypes[3]
+func (x *PetXPRule) Reset() {
+	*x = PetXPRule{}
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Auth) String() string {
+func (x *PetXPRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Auth) ProtoMessage() {}
+func (*PetXPRule) ProtoMessage() {}
 
-func (x *Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[3]
+func (x *PetXPRule) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,52 +870,56 @@ func (x *Auth) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
-func (*Auth) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PetXPRule.ProtoReflect.Descriptor instead.
+func (*PetXPRule) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Auth) GetJwtSecret() string {
+func (x *PetXPRule) GetEvent() string {
 	if x != nil {
-		return x.JwtSecret
+		return x.Event
 	}
 	return ""
 }
 
-func (x *Auth) GetJwtTtl() *durationpb.Duration {
+func (x *PetXPRule) GetXp() int32 {
 	if x != nil {
-		return x.JwtTtl
+		return x.Xp
 	}
-	return nil
+	return 0
 }
 
-// MinIO 配置
-type Minio struct {
+func (x *PetXPRule) GetDailyCap() int32 {
+	if x != nil {
+		return x.DailyCap
+	}
+	return 0
+}
+
+// 活跃奖励配置
+type Activity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                    // 例如 47.121.139.174:9000
-	AccessKey     string                 `protobuf:"bytes,2,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"` // 访问密钥
-	SecretKey     string                 `protobuf:"bytes,3,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"` // 密钥
-	UseSsl        bool                   `protobuf:"varint,4,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`         // 是否使用https
-	Bucket        string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`                        // 存储桶
+	Rules         []*ActivityRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`       // 奖励规则（未配置时使用内置默认规则）
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // 每日上限按该时区的自然日计算（默认 Asia/Shanghai）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Minio) Reset() {
-	*x = Minio{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+func (x *Activity) Reset() {
+	*x = Activity{}
+	mi := &file_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Minio) String() string {
+func (x *Activity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Minio) ProtoMessage() {}
+func (*Activity) ProtoMessage() {}
 
-func (x *Minio) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+func (x *Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,42 +930,196 @@ func (x *Minio) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Minio.ProtoReflect.Descriptor instead.
-func (*Minio) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Activity.ProtoReflect.Descriptor instead.
+func (*Activity) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Minio) GetEndpoint() string {
+func (x *Activity) GetRules() []*ActivityRule {
 	if x != nil {
-		return x.Endpoint
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Activity) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
 	}
 	return ""
 }
 
-func (x *Minio) GetAccessKey() string {
+// 活跃奖励规则
+type ActivityRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Event           string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`                                               // 事件：post_created | post_liked（奖励帖子作者）| comment_created | pet_chatted
+	Coins           int32                  `protobuf:"varint,2,opt,name=coins,proto3" json:"coins,omitempty"`                                              // 每次奖励金币，<=0 表示关闭该规则
+	DailyCap        int32                  `protobuf:"varint,3,opt,name=daily_cap,json=dailyCap,proto3" json:"daily_cap,omitempty"`                        // 每人每天最多奖励次数，0 表示不限
+	MinContentRunes int32                  `protobuf:"varint,4,opt,name=min_content_runes,json=minContentRunes,proto3" json:"min_content_runes,omitempty"` // 内容最少字数（评论），不足不奖励
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ActivityRule) Reset() {
+	*x = ActivityRule{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityRule) ProtoMessage() {}
+
+func (x *ActivityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
-		return x.AccessKey
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityRule.ProtoReflect.Descriptor instead.
+func (*ActivityRule) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ActivityRule) GetEvent() string {
+	if x != nil {
+		return x.Event
 	}
 	return ""
 }
 
-func (x *Minio) GetSecretKey() string {
+func (x *ActivityRule) GetCoins() int32 {
 	if x != nil {
-		return x.SecretKey
+		return x.Coins
+	}
+	return 0
+}
+
+func (x *ActivityRule) GetDailyCap() int32 {
+	if x != nil {
+		return x.DailyCap
+	}
+	return 0
+}
+
+func (x *ActivityRule) GetMinContentRunes() int32 {
+	if x != nil {
+		return x.MinContentRunes
+	}
+	return 0
+}
+
+// 每日签到配置
+type CheckIn struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StreakRewards   []int32                `protobuf:"varint,1,rep,packed,name=streak_rewards,json=streakRewards,proto3" json:"streak_rewards,omitempty"` // 连续签到第 N 天奖励 streak_rewards[N-1]，超出长度按最后一项（默认 5,10,15,20,25,30,50）
+	DefaultTimezone string                 `protobuf:"bytes,2,opt,name=default_timezone,json=defaultTimezone,proto3" json:"default_timezone,omitempty"`   // 请求未携带时区时使用的 IANA 时区（默认 Asia/Shanghai）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckIn) Reset() {
+	*x = CheckIn{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIn) ProtoMessage() {}
+
+func (x *CheckIn) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIn.ProtoReflect.Descriptor instead.
+func (*CheckIn) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckIn) GetStreakRewards() []int32 {
+	if x != nil {
+		return x.StreakRewards
+	}
+	return nil
+}
+
+func (x *CheckIn) GetDefaultTimezone() string {
+	if x != nil {
+		return x.DefaultTimezone
 	}
 	return ""
 }
 
-func (x *Minio) GetUseSsl() bool {
+// 通知发送配置
+type Notify struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`                     // log（默认，输出到服务日志）| file（追加写入 file_path，便于本地开发查看验证码）
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // driver=file 时的输出文件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notify) Reset() {
+	*x = Notify{}
+	mi := &file_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notify) ProtoMessage() {}
+
+func (x *Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
-		return x.UseSsl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Minio) GetBucket() string {
+// Deprecated: Use Notify.ProtoReflect.Descriptor instead.
+func (*Notify) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Notify) GetDriver() string {
 	if x != nil {
-		return x.Bucket
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Notify) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
 	}
 	return ""
 }
@@ -343,7 +1135,7 @@ type Storage struct {
 
 func (x *Storage) Reset() {
 	*x = Storage{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -355,7 +1147,7 @@ func (x *Storage) String() string {
 func (*Storage) ProtoMessage() {}
 
 func (x *Storage) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,7 +1160,7 @@ func (x *Storage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Storage.ProtoReflect.Descriptor instead.
 func (*Storage) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+	return file_conf_conf_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Storage) GetLocalRoot() string {
@@ -396,7 +1188,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -408,7 +1200,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +1248,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +1260,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +1307,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -527,7 +1319,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +1361,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -581,7 +1373,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,13 +1422,15 @@ var File_conf_conf_proto protoreflect.FileDescriptor
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xdb\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xb6\x02\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12$\n" +
 	"\x04auth\x18\x03 \x01(\v2\x10.kratos.api.AuthR\x04auth\x12'\n" +
 	"\x05minio\x18\x04 \x01(\v2\x11.kratos.api.MinioR\x05minio\x12-\n" +
-	"\astorage\x18\x05 \x01(\v2\x13.kratos.api.StorageR\astorage\"\xb8\x02\n" +
+	"\astorage\x18\x05 \x01(\v2\x13.kratos.api.StorageR\astorage\x12*\n" +
+	"\x06notify\x18\x06 \x01(\v2\x12.kratos.api.NotifyR\x06notify\x12-\n" +
+	"\arewards\x18\a \x01(\v2\x13.kratos.api.RewardsR\arewards\"\xb8\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
@@ -658,11 +1452,48 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
 	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"Y\n" +
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"\x8f\x04\n" +
 	"\x04Auth\x12\x1d\n" +
 	"\n" +
 	"jwt_secret\x18\x01 \x01(\tR\tjwtSecret\x122\n" +
-	"\ajwt_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06jwtTtl\"\x92\x01\n" +
+	"\ajwt_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06jwtTtl\x12#\n" +
+	"\rauto_register\x18\x03 \x01(\bR\fautoRegister\x12:\n" +
+	"\vrefresh_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"refreshTtl\x12'\n" +
+	"\x0ftrusted_proxies\x18\x05 \x03(\tR\x0etrustedProxies\x12?\n" +
+	"\x0ereset_code_ttl\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\fresetCodeTtl\x12@\n" +
+	"\x0elogin_throttle\x18\a \x01(\v2\x19.kratos.api.LoginThrottleR\rloginThrottle\x12-\n" +
+	"\bjwt_keys\x18\b \x03(\v2\x12.kratos.api.JwtKeyR\ajwtKeys\x12B\n" +
+	"\x0foauth_providers\x18\t \x03(\v2\x19.kratos.api.OAuthProviderR\x0eoauthProviders\x124\n" +
+	"\n" +
+	"login_code\x18\n" +
+	" \x01(\v2\x15.kratos.api.LoginCodeR\tloginCode\"\x94\x02\n" +
+	"\tLoginCode\x12+\n" +
+	"\x03ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12B\n" +
+	"\x0fresend_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0eresendInterval\x12!\n" +
+	"\fmax_attempts\x18\x03 \x01(\x05R\vmaxAttempts\x12\"\n" +
+	"\rmax_per_phone\x18\x04 \x01(\x05R\vmaxPerPhone\x12\x1c\n" +
+	"\n" +
+	"max_per_ip\x18\x05 \x01(\x05R\bmaxPerIp\x121\n" +
+	"\x06window\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x06window\"}\n" +
+	"\rOAuthProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x04 \x01(\tR\fclientSecret\"\x96\x01\n" +
+	"\x06JwtKey\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\x12\x10\n" +
+	"\x03alg\x18\x02 \x01(\tR\x03alg\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12(\n" +
+	"\x10private_key_file\x18\x04 \x01(\tR\x0eprivateKeyFile\x12&\n" +
+	"\x0fpublic_key_file\x18\x05 \x01(\tR\rpublicKeyFile\"\xad\x02\n" +
+	"\rLoginThrottle\x121\n" +
+	"\x15max_failures_per_user\x18\x01 \x01(\x05R\x12maxFailuresPerUser\x12-\n" +
+	"\x13max_failures_per_ip\x18\x02 \x01(\x05R\x10maxFailuresPerIp\x12@\n" +
+	"\x0efailure_window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rfailureWindow\x12<\n" +
+	"\fbase_lockout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\vbaseLockout\x12:\n" +
+	"\vmax_lockout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxLockout\"\x92\x01\n" +
 	"\x05Minio\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x1d\n" +
 	"\n" +
@@ -670,7 +1501,32 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"secret_key\x18\x03 \x01(\tR\tsecretKey\x12\x17\n" +
 	"\ause_ssl\x18\x04 \x01(\bR\x06useSsl\x12\x16\n" +
-	"\x06bucket\x18\x05 \x01(\tR\x06bucket\"M\n" +
+	"\x06bucket\x18\x05 \x01(\tR\x06bucket\"\x95\x01\n" +
+	"\aRewards\x12.\n" +
+	"\bcheck_in\x18\x01 \x01(\v2\x13.kratos.api.CheckInR\acheckIn\x120\n" +
+	"\bactivity\x18\x02 \x01(\v2\x14.kratos.api.ActivityR\bactivity\x12(\n" +
+	"\x06pet_xp\x18\x03 \x01(\v2\x11.kratos.api.PetXPR\x05petXp\"P\n" +
+	"\x05PetXP\x12+\n" +
+	"\x05rules\x18\x01 \x03(\v2\x15.kratos.api.PetXPRuleR\x05rules\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"N\n" +
+	"\tPetXPRule\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\x0e\n" +
+	"\x02xp\x18\x02 \x01(\x05R\x02xp\x12\x1b\n" +
+	"\tdaily_cap\x18\x03 \x01(\x05R\bdailyCap\"V\n" +
+	"\bActivity\x12.\n" +
+	"\x05rules\x18\x01 \x03(\v2\x18.kratos.api.ActivityRuleR\x05rules\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"\x83\x01\n" +
+	"\fActivityRule\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\x14\n" +
+	"\x05coins\x18\x02 \x01(\x05R\x05coins\x12\x1b\n" +
+	"\tdaily_cap\x18\x03 \x01(\x05R\bdailyCap\x12*\n" +
+	"\x11min_content_runes\x18\x04 \x01(\x05R\x0fminContentRunes\"[\n" +
+	"\aCheckIn\x12%\n" +
+	"\x0estreak_rewards\x18\x01 \x03(\x05R\rstreakRewards\x12)\n" +
+	"\x10default_timezone\x18\x02 \x01(\tR\x0fdefaultTimezone\"=\n" +
+	"\x06Notify\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x1b\n" +
+	"\tfile_path\x18\x02 \x01(\tR\bfilePath\"M\n" +
 	"\aStorage\x12\x1d\n" +
 	"\n" +
 	"local_root\x18\x01 \x01(\tR\tlocalRoot\x12#\n" +
@@ -688,40 +1544,70 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
 	(*Data)(nil),                // 2: kratos.api.Data
 	(*Auth)(nil),                // 3: kratos.api.Auth
-	(*Minio)(nil),               // 4: kratos.api.Minio
-	(*Storage)(nil),             // 5: kratos.api.Storage
-	(*Server_HTTP)(nil),         // 6: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 7: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 8: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 9: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 10: google.protobuf.Duration
+	(*LoginCode)(nil),           // 4: kratos.api.LoginCode
+	(*OAuthProvider)(nil),       // 5: kratos.api.OAuthProvider
+	(*JwtKey)(nil),              // 6: kratos.api.JwtKey
+	(*LoginThrottle)(nil),       // 7: kratos.api.LoginThrottle
+	(*Minio)(nil),               // 8: kratos.api.Minio
+	(*Rewards)(nil),             // 9: kratos.api.Rewards
+	(*PetXP)(nil),               // 10: kratos.api.PetXP
+	(*PetXPRule)(nil),           // 11: kratos.api.PetXPRule
+	(*Activity)(nil),            // 12: kratos.api.Activity
+	(*ActivityRule)(nil),        // 13: kratos.api.ActivityRule
+	(*CheckIn)(nil),             // 14: kratos.api.CheckIn
+	(*Notify)(nil),              // 15: kratos.api.Notify
+	(*Storage)(nil),             // 16: kratos.api.Storage
+	(*Server_HTTP)(nil),         // 17: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 18: kratos.api.Server.GRPC
+	(*Data_Database)(nil),       // 19: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 20: kratos.api.Data.Redis
+	(*durationpb.Duration)(nil), // 21: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	3,  // 2: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
-	4,  // 3: kratos.api.Bootstrap.minio:type_name -> kratos.api.Minio
-	5,  // 4: kratos.api.Bootstrap.storage:type_name -> kratos.api.Storage
-	6,  // 5: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	7,  // 6: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	8,  // 7: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	9,  // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	10, // 9: kratos.api.Auth.jwt_ttl:type_name -> google.protobuf.Duration
-	10, // 10: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	10, // 11: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	10, // 12: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	10, // 13: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	8,  // 3: kratos.api.Bootstrap.minio:type_name -> kratos.api.Minio
+	16, // 4: kratos.api.Bootstrap.storage:type_name -> kratos.api.Storage
+	15, // 5: kratos.api.Bootstrap.notify:type_name -> kratos.api.Notify
+	9,  // 6: kratos.api.Bootstrap.rewards:type_name -> kratos.api.Rewards
+	17, // 7: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	18, // 8: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	19, // 9: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	20, // 10: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	21, // 11: kratos.api.Auth.jwt_ttl:type_name -> google.protobuf.Duration
+	21, // 12: kratos.api.Auth.refresh_ttl:type_name -> google.protobuf.Duration
+	21, // 13: kratos.api.Auth.reset_code_ttl:type_name -> google.protobuf.Duration
+	7,  // 14: kratos.api.Auth.login_throttle:type_name -> kratos.api.LoginThrottle
+	6,  // 15: kratos.api.Auth.jwt_keys:type_name -> kratos.api.JwtKey
+	5,  // 16: kratos.api.Auth.oauth_providers:type_name -> kratos.api.OAuthProvider
+	4,  // 17: kratos.api.Auth.login_code:type_name -> kratos.api.LoginCode
+	21, // 18: kratos.api.LoginCode.ttl:type_name -> google.protobuf.Duration
+	21, // 19: kratos.api.LoginCode.resend_interval:type_name -> google.protobuf.Duration
+	21, // 20: kratos.api.LoginCode.window:type_name -> google.protobuf.Duration
+	21, // 21: kratos.api.LoginThrottle.failure_window:type_name -> google.protobuf.Duration
+	21, // 22: kratos.api.LoginThrottle.base_lockout:type_name -> google.protobuf.Duration
+	21, // 23: kratos.api.LoginThrottle.max_lockout:type_name -> google.protobuf.Duration
+	14, // 24: kratos.api.Rewards.check_in:type_name -> kratos.api.CheckIn
+	12, // 25: kratos.api.Rewards.activity:type_name -> kratos.api.Activity
+	10, // 26: kratos.api.Rewards.pet_xp:type_name -> kratos.api.PetXP
+	11, // 27: kratos.api.PetXP.rules:type_name -> kratos.api.PetXPRule
+	13, // 28: kratos.api.Activity.rules:type_name -> kratos.api.ActivityRule
+	21, // 29: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	21, // 30: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	21, // 31: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	21, // 32: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -735,7 +1621,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
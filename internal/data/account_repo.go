@@ -0,0 +1,322 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// AccountRepo 实现 biz.AccountRepo（GORM；内存模式下删除内存中的用户、宠物与宠物状态）
+type AccountRepo struct{ data *Data }
+
+func NewAccountRepo(d *Data) *AccountRepo { return &AccountRepo{data: d} }
+
+// Delete 删除用户及其数据，并修正他人内容上的计数
+// 用户自己的帖子连同其下全部评论、点赞一起删除；用户在他人帖子下的评论删除后回减 comment_count；
+// 用户点过的赞删除后回减对应帖子/评论的 liked_count
+func (r *AccountRepo) Delete(ctx context.Context, userID int64) error {
+	if r.data.Gorm == nil {
+		r.data.mu.Lock()
+		defer r.data.mu.Unlock()
+		u, ok := r.data.userByID[userID]
+		if !ok {
+			return biz.ErrUserNotFound
+		}
+		for id, p := range r.data.petByID {
+			if p.UserID == userID {
+				r.data.deletePet(id)
+			}
+		}
+		delete(r.data.userByID, userID)
+		delete(r.data.userByUsername, u.Username)
+		return nil
+	}
+	return r.data.Gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var n int64
+		if err := tx.Model(&UserModel{}).Where("id=?", userID).Count(&n).Error; err != nil {
+			return err
+		}
+		if n == 0 {
+			return biz.ErrUserNotFound
+		}
+		var postIDs []int64
+		if err := tx.Model(&PostModel{}).Where("user_id=?", userID).Pluck("id", &postIDs).Error; err != nil {
+			return err
+		}
+
+		// 1. 回减该用户点过赞的帖子/评论的点赞数
+		decr := gorm.Expr("CASE WHEN liked_count>0 THEN liked_count-1 ELSE 0 END")
+		for _, target := range []struct {
+			typ   int32
+			model interface{}
+		}{{0, &PostModel{}}, {1, &CommentModel{}}} {
+			liked := tx.Model(&LikeModel{}).Select("target_id").Where("user_id=? AND target_type=?", userID, target.typ)
+			if err := tx.Model(target.model).Where("id IN (?)", liked).Update("liked_count", decr).Error; err != nil {
+				return err
+			}
+		}
+
+		// 2. 回减他人帖子的评论数
+		var counts []struct {
+			PostID int64
+			N      int32
+		}
+		if err := tx.Model(&CommentModel{}).Select("post_id, COUNT(*) AS n").
+			Where("user_id=? AND post_id NOT IN (?)", userID, tx.Model(&PostModel{}).Select("id").Where("user_id=?", userID)).
+			Group("post_id").Scan(&counts).Error; err != nil {
+			return err
+		}
+		for _, c := range counts {
+			if err := tx.Model(&PostModel{}).Where("id=?", c.PostID).
+				Update("comment_count", gorm.Expr("CASE WHEN comment_count>? THEN comment_count-? ELSE 0 END", c.N, c.N)).Error; err != nil {
+				return err
+			}
+		}
+
+		// 3. 待删除评论：用户自己的评论 + 用户帖子下的全部评论
+		var commentIDs []int64
+		q := tx.Model(&CommentModel{}).Where("user_id=?", userID)
+		if len(postIDs) > 0 {
+			q = q.Or("post_id IN ?", postIDs)
+		}
+		if err := q.Pluck("id", &commentIDs).Error; err != nil {
+			return err
+		}
+
+		// 4. 删除点赞（用户点的赞 + 他人对待删除帖子/评论的赞）、评论、帖子
+		if err := tx.Where("user_id=?", userID).Delete(&LikeModel{}).Error; err != nil {
+			return err
+		}
+		if len(postIDs) > 0 {
+			if err := tx.Where("target_type=0 AND target_id IN ?", postIDs).Delete(&LikeModel{}).Error; err != nil {
+				return err
+			}
+		}
+		if len(commentIDs) > 0 {
+			if err := tx.Where("target_type=1 AND target_id IN ?", commentIDs).Delete(&LikeModel{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("id IN ?", commentIDs).Delete(&CommentModel{}).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("user_id=?", userID).Delete(&PostModel{}).Error; err != nil {
+			return err
+		}
+
+		// 5. 其余按用户归属的数据
+		for _, m := range []interface{}{&UserUnlockRecordDO{}, &CoinTransactionDO{}, &CheckInDO{}, &ActivityRewardDO{}, &UserItemDO{}, &ItemPurchaseDO{}, &PetStateDO{}, &PetLevelDO{}, &PetXPLogDO{}, &PetWeightDO{}, &PetCareRecordDO{}, &PetVetVisitDO{}, &ReminderDO{}, &PetMemoryDO{}, &PetMemoryCursorDO{}, &UserPetModelDO{}, &MessageDO{}, &PetDO{}, &UserIdentityDO{}, &UserSessionDO{}, &PasswordResetDO{}} {
+			if err := tx.Where("user_id=?", userID).Delete(m).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("follower_id=? OR followee_id=?", userID, userID).Delete(&FollowModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&UserModel{}, userID).Error
+	})
+}
+
+// 导出用的行结构（时间字段按 time.Time 读取，不依赖 DATE_FORMAT）
+
+type exportPostRow struct {
+	ID           int64
+	CategoryID   int64
+	Title        string
+	Content      string
+	Type         int32
+	ImageUrls    string
+	VideoUrl     string
+	CoverUrl     string
+	Locate       string
+	Tags         string
+	LikedCount   int32
+	CommentCount int32
+	IsPrivate    bool
+	CreatedAt    time.Time
+}
+
+type exportCommentRow struct {
+	ID         int64
+	PostID     int64
+	Content    string
+	LikedCount int32
+	CreatedAt  time.Time
+}
+
+type exportLikeRow struct {
+	TargetType int32
+	TargetID   int64
+	CreatedAt  time.Time
+}
+
+type exportFollowRow struct {
+	FolloweeID int64
+	CreatedAt  time.Time
+}
+
+type exportUnlockRow struct {
+	MessageID  int64
+	CoinsSpent int32
+	CreatedAt  time.Time
+}
+
+// Export 读取用户产生的数据（按 ID 升序）
+func (r *AccountRepo) Export(ctx context.Context, userID int64) (*biz.AccountExport, error) {
+	out := &biz.AccountExport{
+		Pets:          []*biz.ExportPet{},
+		Messages:      []*biz.ExportMessage{},
+		Posts:         []*biz.ExportPost{},
+		Comments:      []*biz.ExportComment{},
+		Likes:         []*biz.ExportLike{},
+		Following:     []*biz.ExportFollow{},
+		UnlockRecords: []*biz.ExportUnlock{},
+		Coins:         []*biz.ExportCoinTransaction{},
+		Identities:    []*biz.ExportIdentity{},
+		Reminders:     []*biz.ExportReminder{},
+		PetHealth:     &biz.ExportPetHealth{Weights: []*biz.ExportWeight{}, CareRecords: []*biz.ExportCareRecord{}, VetVisits: []*biz.ExportVetVisit{}},
+	}
+	if r.data.Gorm == nil {
+		return out, nil
+	}
+	db := r.data.Gorm.WithContext(ctx)
+
+	var msgs []MessageDO
+	if err := db.Where("user_id=?", userID).Order("id").Find(&msgs).Error; err != nil {
+		return nil, err
+	}
+	for _, m := range msgs {
+		out.Messages = append(out.Messages, &biz.ExportMessage{
+			ID: m.ID, PetID: m.PetID, Sender: m.Sender, MessageType: m.MessageType, IsLocked: m.IsLocked, Content: m.Content, CreatedAt: m.CreatedAt,
+		})
+	}
+
+	var pets []PetDO
+	if err := db.Where("user_id=?", userID).Order("id").Find(&pets).Error; err != nil {
+		return nil, err
+	}
+	for _, p := range pets {
+		out.Pets = append(out.Pets, &biz.ExportPet{
+			ID: p.ID, Name: p.Name, Avatar: p.Avatar, Sex: p.Sex, Kind: p.Kind, Weight: p.Weight, Hobby: p.Hobby,
+			ModelID: p.ModelID, ModelURL: p.ModelURL, CreatedAt: p.CreatedAt,
+		})
+	}
+
+	var weights []PetWeightDO
+	if err := db.Where("user_id=?", userID).Order("id").Find(&weights).Error; err != nil {
+		return nil, err
+	}
+	for _, w := range weights {
+		out.PetHealth.Weights = append(out.PetHealth.Weights, &biz.ExportWeight{
+			ID: w.ID, PetID: w.PetID, WeightKg: w.WeightKg, MeasuredOn: w.MeasuredOn, Note: w.Note, CreatedAt: w.CreatedAt,
+		})
+	}
+	var care []PetCareRecordDO
+	if err := db.Where("user_id=?", userID).Order("id").Find(&care).Error; err != nil {
+		return nil, err
+	}
+	for _, c := range care {
+		out.PetHealth.CareRecords = append(out.PetHealth.CareRecords, &biz.ExportCareRecord{
+			ID: c.ID, PetID: c.PetID, Type: c.Type, Name: c.Name, GivenOn: c.GivenOn, NextDueOn: c.NextDueOn,
+			Clinic: c.Clinic, Note: c.Note, CreatedAt: c.CreatedAt,
+		})
+	}
+	var visits []PetVetVisitDO
+	if err := db.Where("user_id=?", userID).Order("id").Find(&visits).Error; err != nil {
+		return nil, err
+	}
+	for _, v := range visits {
+		out.PetHealth.VetVisits = append(out.PetHealth.VetVisits, &biz.ExportVetVisit{
+			ID: v.ID, PetID: v.PetID, VisitedOn: v.VisitedOn, Clinic: v.Clinic, Reason: v.Reason, Diagnosis: v.Diagnosis,
+			Notes: v.Notes, Attachments: splitCSV(v.Attachments), CreatedAt: v.CreatedAt,
+		})
+	}
+
+	var reminders []ReminderDO
+	if err := db.Where("user_id=?", userID).Order("id").Find(&reminders).Error; err != nil {
+		return nil, err
+	}
+	for _, rem := range reminders {
+		out.Reminders = append(out.Reminders, &biz.ExportReminder{
+			ID: rem.ID, PetID: rem.PetID, Kind: rem.Kind, Title: rem.Title, Note: rem.Note, RRule: rem.RRule,
+			Timezone: rem.Timezone, StartAt: rem.StartAt, Enabled: rem.Enabled, CreatedAt: rem.CreatedAt,
+		})
+	}
+
+	var memories []PetMemoryDO
+	if err := db.Where("user_id=?", userID).Order("id").Find(&memories).Error; err != nil {
+		return nil, err
+	}
+	for _, m := range memories {
+		out.Memories = append(out.Memories, &biz.ExportMemory{ID: m.ID, PetID: m.PetID, Kind: m.Kind, Content: m.Content, CreatedAt: m.CreatedAt})
+	}
+
+	var posts []exportPostRow
+	if err := db.Table("posts").Where("user_id=?", userID).Order("id").Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	for _, p := range posts {
+		out.Posts = append(out.Posts, &biz.ExportPost{
+			ID: p.ID, CategoryID: p.CategoryID, Title: p.Title, Content: p.Content, Type: p.Type,
+			ImageURLs: splitCSV(p.ImageUrls), VideoURL: p.VideoUrl, CoverURL: p.CoverUrl, Locate: p.Locate, Tags: p.Tags,
+			LikedCount: p.LikedCount, CommentCount: p.CommentCount, IsPrivate: p.IsPrivate, CreatedAt: p.CreatedAt,
+		})
+	}
+
+	var comments []exportCommentRow
+	if err := db.Table("comments").Where("user_id=?", userID).Order("id").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	for _, c := range comments {
+		out.Comments = append(out.Comments, &biz.ExportComment{
+			ID: c.ID, PostID: c.PostID, Content: c.Content, LikedCount: c.LikedCount, CreatedAt: c.CreatedAt,
+		})
+	}
+
+	var likes []exportLikeRow
+	if err := db.Table("likes").Where("user_id=?", userID).Order("created_at").Find(&likes).Error; err != nil {
+		return nil, err
+	}
+	for _, l := range likes {
+		out.Likes = append(out.Likes, &biz.ExportLike{TargetType: l.TargetType, TargetID: l.TargetID, CreatedAt: l.CreatedAt})
+	}
+
+	var follows []exportFollowRow
+	if err := db.Table("user_follows").Where("follower_id=?", userID).Order("created_at").Find(&follows).Error; err != nil {
+		return nil, err
+	}
+	for _, f := range follows {
+		out.Following = append(out.Following, &biz.ExportFollow{UserID: f.FolloweeID, CreatedAt: f.CreatedAt})
+	}
+
+	var unlocks []exportUnlockRow
+	if err := db.Table("user_unlock_records").Where("user_id=?", userID).Order("id").Find(&unlocks).Error; err != nil {
+		return nil, err
+	}
+	for _, u := range unlocks {
+		out.UnlockRecords = append(out.UnlockRecords, &biz.ExportUnlock{MessageID: u.MessageID, CoinsSpent: u.CoinsSpent, CreatedAt: u.CreatedAt})
+	}
+
+	var coins []CoinTransactionDO
+	if err := db.Where("user_id=?", userID).Order("id").Find(&coins).Error; err != nil {
+		return nil, err
+	}
+	for _, c := range coins {
+		out.Coins = append(out.Coins, &biz.ExportCoinTransaction{
+			Amount: c.Amount, Balance: c.Balance, Reason: c.Reason, RefType: c.RefType, RefID: c.RefID,
+			CounterpartyID: c.CounterpartyID, CreatedAt: c.CreatedAt,
+		})
+	}
+
+	var idents []UserIdentityDO
+	if err := db.Where("user_id=?", userID).Order("id").Find(&idents).Error; err != nil {
+		return nil, err
+	}
+	for _, i := range idents {
+		out.Identities = append(out.Identities, &biz.ExportIdentity{Provider: i.Provider, Subject: i.Subject, Nickname: i.Nickname, CreatedAt: i.CreatedAt})
+	}
+	return out, nil
+}
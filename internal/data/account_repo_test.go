@@ -0,0 +1,391 @@
+package data
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"pet-angel/internal/auth"
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+	"pet-angel/internal/oauth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupAccountData sqlite 上的完整账号数据（AuthRepo 走 database/sql，其余仓储走 GORM）
+func setupAccountData(t *testing.T) *Data {
+	t.Helper()
+	gdb, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := gdb.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// :memory: 每个连接是独立的库
+	db.SetMaxOpenConns(1)
+	if err := gdb.Exec(`
+CREATE TABLE users (id INTEGER PRIMARY KEY AUTOINCREMENT, username TEXT NOT NULL DEFAULT '', password TEXT NOT NULL DEFAULT '', phone TEXT UNIQUE, nickname TEXT NOT NULL DEFAULT '',
+  avatar TEXT NOT NULL DEFAULT '', active_pet_id INTEGER NOT NULL DEFAULT 0, description TEXT NOT NULL DEFAULT '',
+  coins INTEGER NOT NULL DEFAULT 0, role TEXT NOT NULL DEFAULT 'user', created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE pets (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, name TEXT NOT NULL DEFAULT '', avatar TEXT NOT NULL DEFAULT '', sex INTEGER NOT NULL DEFAULT 0,
+  kind TEXT NOT NULL DEFAULT '', weight INTEGER NOT NULL DEFAULT 0, hobby TEXT NOT NULL DEFAULT '', model_id INTEGER NOT NULL DEFAULT 0, model_url TEXT NOT NULL DEFAULT '',
+  created_at DATETIME, updated_at DATETIME);
+CREATE TABLE posts (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, category_id INTEGER, title TEXT NOT NULL DEFAULT '', content TEXT, type INTEGER NOT NULL DEFAULT 0,
+  image_urls TEXT, video_url TEXT, cover_url TEXT, locate TEXT, tags TEXT, liked_count INTEGER NOT NULL DEFAULT 0, comment_count INTEGER NOT NULL DEFAULT 0,
+  is_private INTEGER NOT NULL DEFAULT 0, created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE comments (id INTEGER PRIMARY KEY AUTOINCREMENT, post_id INTEGER NOT NULL, user_id INTEGER NOT NULL, content TEXT NOT NULL, liked_count INTEGER NOT NULL DEFAULT 0,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE likes (user_id INTEGER NOT NULL, target_type INTEGER NOT NULL, target_id INTEGER NOT NULL, created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id, target_type, target_id));
+CREATE TABLE user_follows (follower_id INTEGER NOT NULL, followee_id INTEGER NOT NULL, created_at DATETIME DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY (follower_id, followee_id));
+CREATE TABLE messages (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, pet_id INTEGER NOT NULL DEFAULT 0, sender INTEGER NOT NULL, message_type INTEGER NOT NULL DEFAULT 0,
+  is_locked INTEGER NOT NULL DEFAULT 0, unlock_coins INTEGER NOT NULL DEFAULT 0, content TEXT NOT NULL, created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE user_unlock_records (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, message_id INTEGER NOT NULL, coins_spent INTEGER NOT NULL DEFAULT 0,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE coin_transactions (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, amount INTEGER NOT NULL, balance INTEGER, reason TEXT NOT NULL,
+  ref_type TEXT NOT NULL DEFAULT '', ref_id INTEGER NOT NULL DEFAULT 0, counterparty_id INTEGER NOT NULL DEFAULT 0, created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE check_ins (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, check_date TEXT NOT NULL, streak INTEGER NOT NULL DEFAULT 1,
+  reward INTEGER NOT NULL DEFAULT 0, timezone TEXT NOT NULL DEFAULT '', created_at DATETIME DEFAULT CURRENT_TIMESTAMP, UNIQUE (user_id, check_date));
+CREATE TABLE activity_rewards (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, rule TEXT NOT NULL, dedupe_key TEXT NOT NULL, reward_date TEXT NOT NULL,
+  coins INTEGER NOT NULL DEFAULT 0, created_at DATETIME DEFAULT CURRENT_TIMESTAMP, UNIQUE (user_id, rule, dedupe_key));
+CREATE TABLE items (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, description TEXT, icon_path TEXT, coin_cost INTEGER DEFAULT 0,
+  sort_order INTEGER NOT NULL DEFAULT 0, effects TEXT NOT NULL DEFAULT '', created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE pet_models (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, path TEXT NOT NULL, type INTEGER NOT NULL, is_default INTEGER DEFAULT 0,
+  sort_order INTEGER DEFAULT 0, unlock_level INTEGER NOT NULL DEFAULT 0, unlock_coins INTEGER NOT NULL DEFAULT 0, created_at DATETIME);
+CREATE TABLE user_pet_models (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, model_id INTEGER NOT NULL, coins INTEGER NOT NULL DEFAULT 0,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP, UNIQUE (user_id, model_id));
+CREATE TABLE pet_levels (pet_id INTEGER PRIMARY KEY, user_id INTEGER NOT NULL, xp INTEGER NOT NULL DEFAULT 0, updated_at DATETIME);
+CREATE TABLE pet_xp_logs (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, pet_id INTEGER NOT NULL DEFAULT 0, source TEXT NOT NULL, xp INTEGER NOT NULL, xp_date TEXT NOT NULL,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE pet_states (pet_id INTEGER PRIMARY KEY, user_id INTEGER NOT NULL, hunger REAL NOT NULL DEFAULT 80, mood REAL NOT NULL DEFAULT 80, energy REAL NOT NULL DEFAULT 80,
+  cleanliness REAL NOT NULL DEFAULT 80, updated_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE user_items (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, item_id INTEGER NOT NULL, quantity INTEGER NOT NULL DEFAULT 0,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP, updated_at DATETIME DEFAULT CURRENT_TIMESTAMP, UNIQUE (user_id, item_id));
+CREATE TABLE item_purchases (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, item_id INTEGER NOT NULL, quantity INTEGER NOT NULL,
+  unit_cost INTEGER NOT NULL DEFAULT 0, total_cost INTEGER NOT NULL DEFAULT 0, coin_tx_id INTEGER NOT NULL DEFAULT 0, created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE pet_weights (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, pet_id INTEGER NOT NULL, weight_kg REAL NOT NULL, measured_on TEXT NOT NULL,
+  note TEXT NOT NULL DEFAULT '', created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE pet_care_records (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, pet_id INTEGER NOT NULL, type TEXT NOT NULL, name TEXT NOT NULL,
+  given_on TEXT NOT NULL, next_due_on TEXT NOT NULL DEFAULT '', clinic TEXT NOT NULL DEFAULT '', note TEXT NOT NULL DEFAULT '', created_at DATETIME, updated_at DATETIME);
+CREATE TABLE pet_vet_visits (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, pet_id INTEGER NOT NULL, visited_on TEXT NOT NULL, clinic TEXT NOT NULL DEFAULT '',
+  reason TEXT NOT NULL DEFAULT '', diagnosis TEXT, notes TEXT, attachments TEXT, created_at DATETIME, updated_at DATETIME);
+CREATE TABLE reminders (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, pet_id INTEGER NOT NULL, kind TEXT NOT NULL, title TEXT NOT NULL,
+  note TEXT NOT NULL DEFAULT '', rrule TEXT NOT NULL DEFAULT '', timezone TEXT NOT NULL, start_at TEXT NOT NULL, enabled INTEGER NOT NULL DEFAULT 1,
+  next_run_at DATETIME, last_run_at DATETIME, fired_count INTEGER NOT NULL DEFAULT 0, created_at DATETIME, updated_at DATETIME);
+CREATE TABLE pet_memories (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, pet_id INTEGER NOT NULL, kind TEXT NOT NULL, content TEXT NOT NULL,
+  from_message_id INTEGER NOT NULL DEFAULT 0, to_message_id INTEGER NOT NULL DEFAULT 0, created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+CREATE TABLE pet_memory_cursors (pet_id INTEGER PRIMARY KEY, user_id INTEGER NOT NULL, message_id INTEGER NOT NULL DEFAULT 0, attempted_at DATETIME, updated_at DATETIME);
+CREATE TABLE prompt_templates (id INTEGER PRIMARY KEY AUTOINCREMENT, scene TEXT NOT NULL, model_type INTEGER NOT NULL DEFAULT -1, model_id INTEGER NOT NULL DEFAULT 0,
+  version INTEGER NOT NULL, content TEXT NOT NULL, note TEXT NOT NULL DEFAULT '', is_active INTEGER NOT NULL DEFAULT 0, created_by INTEGER NOT NULL DEFAULT 0,
+  created_at DATETIME, UNIQUE (scene, model_type, model_id, version));
+CREATE TABLE user_sessions (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, refresh_hash TEXT NOT NULL UNIQUE, access_jti TEXT NOT NULL DEFAULT '',
+  access_expires_at DATETIME NOT NULL, expires_at DATETIME NOT NULL, user_agent TEXT NOT NULL DEFAULT '', ip TEXT NOT NULL DEFAULT '', last_seen_at DATETIME,
+  revoked_at DATETIME, created_at DATETIME, updated_at DATETIME);
+CREATE TABLE user_identities (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, provider TEXT NOT NULL, subject TEXT NOT NULL, nickname TEXT NOT NULL DEFAULT '',
+  avatar TEXT NOT NULL DEFAULT '', created_at DATETIME, last_login_at DATETIME);
+CREATE TABLE password_resets (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, code_hash TEXT NOT NULL, attempts INTEGER NOT NULL DEFAULT 0,
+  expires_at DATETIME NOT NULL, used_at DATETIME, created_at DATETIME);
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	d, cleanup, err := NewData(nil, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	d.Gorm, d.DB = gdb, db
+	return d
+}
+
+func TestDeleteAndExportAccount(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "image"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "image", "a.png"), []byte("avatar"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "image", "p.png"), []byte("post"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "image", "lab.pdf"), []byte("lab"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	hash, _ := bcrypt.GenerateFromPassword([]byte("passw0rd"), bcrypt.MinCost)
+	// alice(1) 与 bob(2) 互相点赞、评论、关注
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, password, nickname, avatar, active_pet_id) VALUES (1, 'alice', ?, 'Alice', '/static/image/a.png', 1), (2, 'bob', ?, 'Bob', '', 3);
+INSERT INTO pets (id, user_id, name, avatar) VALUES (1, 1, 'Mimi', '/static/image/a.png'), (2, 1, 'Wangcai', ''), (3, 2, 'Bob cat', '');
+INSERT INTO posts (id, user_id, title, image_urls, liked_count, comment_count) VALUES
+  (10, 1, 'alice post', '/static/image/p.png,https://cdn.example.com/x.png,/static/../secret', 1, 1),
+  (20, 2, 'bob post', '', 2, 3);
+INSERT INTO comments (id, post_id, user_id, content, liked_count) VALUES
+  (100, 10, 2, 'bob on alice', 0),
+  (200, 20, 1, 'alice on bob 1', 1),
+  (201, 20, 1, 'alice on bob 2', 0),
+  (202, 20, 2, 'bob on bob', 1);
+INSERT INTO likes (user_id, target_type, target_id) VALUES (2, 0, 10), (1, 0, 20), (2, 0, 20), (2, 1, 200), (1, 1, 202);
+INSERT INTO user_follows (follower_id, followee_id) VALUES (1, 2), (2, 1);
+INSERT INTO messages (id, user_id, sender, message_type, is_locked, content) VALUES (1, 1, 0, 0, 0, 'hi'), (2, 1, 1, 1, 0, 'note'), (3, 2, 0, 0, 0, 'bob hi');
+INSERT INTO user_unlock_records (user_id, message_id, coins_spent) VALUES (1, 2, 5);
+INSERT INTO coin_transactions (user_id, amount, balance, reason, ref_type, ref_id) VALUES (1, -5, 0, 'note_unlock', 'message', 2), (2, 10, 10, 'reward', '', 0);
+INSERT INTO user_identities (user_id, provider, subject) VALUES (1, 'wechat', 'wx-alice');
+INSERT INTO pet_weights (user_id, pet_id, weight_kg, measured_on) VALUES (1, 1, 4.25, '2024-01-01'), (2, 3, 6, '2024-01-01');
+INSERT INTO pet_vet_visits (user_id, pet_id, visited_on, attachments) VALUES (1, 1, '2024-01-02', '/static/image/lab.pdf');
+INSERT INTO reminders (user_id, pet_id, kind, title, rrule, timezone, start_at) VALUES (1, 1, 'feeding', '早饭', 'FREQ=DAILY', 'Asia/Shanghai', '2024-01-01 08:00');
+INSERT INTO pet_memories (user_id, pet_id, kind, content) VALUES (1, 1, 'fact', '主人叫 Alice'), (2, 3, 'fact', '主人叫 Bob');
+INSERT INTO pet_memory_cursors (pet_id, user_id, message_id) VALUES (1, 1, 1);
+`, string(hash), string(hash)).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	c := &conf.Auth{JwtSecret: "s"}
+	keys, err := auth.NewKeyring(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authUC := biz.NewAuthUsecase(NewAuthRepo(d), NewSessionRepo(d), NewTokenDenylist(d), NewPasswordResetRepo(d), NewLoginAttemptRepo(d), nil, keys, c, log.DefaultLogger)
+	uc := biz.NewAccountUsecase(authUC, NewAccountRepo(d), NewIdentityRepo(d), NewLocalUploadStore(&conf.Storage{LocalRoot: root, PublicPrefix: "/static/"}), log.DefaultLogger)
+	_, pair, err := authUC.Login(ctx, "alice", "passw0rd", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 导出
+	var buf bytes.Buffer
+	if err := uc.ExportMyData(ctx, 1, &buf); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := io.ReadAll(rc)
+		_ = rc.Close()
+		files[f.Name] = string(b)
+	}
+	if files["files/image/a.png"] != "avatar" || files["files/image/p.png"] != "post" || files["files/image/lab.pdf"] != "lab" {
+		t.Fatalf("uploads not exported: %v", files)
+	}
+	var profile map[string]interface{}
+	if err := json.Unmarshal([]byte(files["profile.json"]), &profile); err != nil || profile["username"] != "alice" {
+		t.Fatalf("profile: %s %v", files["profile.json"], err)
+	}
+	if _, ok := profile["password"]; ok {
+		t.Fatal("password hash must not be exported")
+	}
+	if profile["pet_name"] != "Mimi" {
+		t.Fatalf("profile must carry the active pet: %v", profile["pet_name"])
+	}
+	var pets, msgs, comments, coins, idents, reminders, memories, missing []interface{}
+	_ = json.Unmarshal([]byte(files["pets.json"]), &pets)
+	_ = json.Unmarshal([]byte(files["reminders.json"]), &reminders)
+	_ = json.Unmarshal([]byte(files["memories.json"]), &memories)
+	_ = json.Unmarshal([]byte(files["messages.json"]), &msgs)
+	_ = json.Unmarshal([]byte(files["coin_transactions.json"]), &coins)
+	_ = json.Unmarshal([]byte(files["comments.json"]), &comments)
+	_ = json.Unmarshal([]byte(files["identities.json"]), &idents)
+	_ = json.Unmarshal([]byte(files["files_missing.json"]), &missing)
+	if len(msgs) != 2 || len(comments) != 2 || len(coins) != 1 || len(idents) != 1 {
+		t.Fatalf("want 2 messages, 2 comments, 1 coin transaction and 1 identity, got %d %d %d %d", len(msgs), len(comments), len(coins), len(idents))
+	}
+	if len(pets) != 2 || len(reminders) != 1 || len(memories) != 1 {
+		t.Fatalf("want 2 pets, 1 reminder and 1 memory, got %d %d %d", len(pets), len(reminders), len(memories))
+	}
+	var health struct {
+		Weights   []map[string]interface{} `json:"weights"`
+		VetVisits []map[string]interface{} `json:"vet_visits"`
+	}
+	if err := json.Unmarshal([]byte(files["pet_health.json"]), &health); err != nil || len(health.Weights) != 1 || health.Weights[0]["weight_kg"] != 4.25 || len(health.VetVisits) != 1 {
+		t.Fatalf("pet_health.json: %s %v", files["pet_health.json"], err)
+	}
+	if len(missing) != 2 {
+		t.Fatalf("want cdn and traversal urls missing, got %v", missing)
+	}
+
+	// 注销
+	if err := uc.DeleteAccount(ctx, 1, 0, "wrong"); !errors.Is(err, biz.ErrInvalidPassword) {
+		t.Fatalf("want invalid password, got %v", err)
+	}
+	if err := uc.DeleteAccount(ctx, 1, 0, "passw0rd"); err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.DeleteAccount(ctx, 1, 0, "passw0rd"); !errors.Is(err, biz.ErrUserNotFound) {
+		t.Fatalf("want user not found, got %v", err)
+	}
+
+	var post PostModel
+	if err := d.Gorm.Select("id", "liked_count", "comment_count").Take(&post, 20).Error; err != nil {
+		t.Fatal(err)
+	}
+	if post.LikedCount != 1 || post.CommentCount != 1 {
+		t.Fatalf("bob post counters: liked=%d comments=%d", post.LikedCount, post.CommentCount)
+	}
+	var left CommentModel
+	if err := d.Gorm.Select("id", "liked_count").Take(&left, 202).Error; err != nil || left.LikedCount != 0 {
+		t.Fatalf("bob comment: %+v %v", left, err)
+	}
+	for table, want := range map[string]int64{
+		"users": 1, "pets": 1, "posts": 1, "comments": 1, "likes": 1, "user_follows": 0,
+		"messages": 1, "user_unlock_records": 0, "coin_transactions": 1, "user_identities": 0, "user_sessions": 0,
+		"pet_weights": 1, "pet_vet_visits": 0, "reminders": 0, "pet_memories": 1, "pet_memory_cursors": 0,
+	} {
+		var n int64
+		d.Gorm.Table(table).Count(&n)
+		if n != want {
+			t.Fatalf("%s: want %d rows, got %d", table, want, n)
+		}
+	}
+
+	// 旧令牌全部失效
+	if _, err := authUC.Refresh(ctx, pair.RefreshToken); !errors.Is(err, biz.ErrInvalidRefreshToken) {
+		t.Fatalf("want invalid refresh token, got %v", err)
+	}
+	if _, _, err := authUC.Login(ctx, "alice", "passw0rd", biz.ClientInfo{}); !errors.Is(err, biz.ErrUserNotFound) {
+		t.Fatalf("want user not found, got %v", err)
+	}
+}
+
+func TestDeleteAccountRecentLogin(t *testing.T) {
+	ctx := context.Background()
+	c := &conf.Auth{JwtSecret: "s", OauthProviders: []*conf.OAuthProvider{{Name: "wechat", Driver: "fake"}}}
+	m := newMemoryAuth(t, c)
+	keys, err := auth.NewKeyring(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	providers, err := oauth.NewProviders(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	identities := NewIdentityRepo(m.data)
+	oauthUC := biz.NewOAuthUsecase(m.uc, identities, NewAccountRepo(m.data), providers, log.DefaultLogger)
+	sms := &smsOutbox{last: map[string]string{}}
+	phoneUC := biz.NewPhoneLoginUsecase(m.uc, NewLoginCodeRepo(m.data), sms, c, log.DefaultLogger)
+	uc := biz.NewAccountUsecase(m.uc, NewAccountRepo(m.data), identities, nil, log.DefaultLogger)
+	sessionOf := func(pair *biz.TokenPair) int64 {
+		claims, err := keys.Parse(pair.AccessToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return claims.SessionID
+	}
+	age := func(sid int64) {
+		m.sessions.mu.Lock()
+		m.sessions.mem[sid].CreatedAt = time.Now().Add(-biz.RecentLoginWindow - time.Minute)
+		m.sessions.mu.Unlock()
+	}
+
+	// 第三方登录注册的账号：没有密码，登录较早时须重新登录，刚登录的会话可直接注销
+	wx, old, _, err := oauthUC.Login(ctx, "wechat", "wx-1", "", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	age(sessionOf(old))
+	if err := uc.DeleteAccount(ctx, wx.Id, sessionOf(old), ""); !errors.Is(err, biz.ErrRecentLoginRequired) {
+		t.Fatalf("oauth user with stale session: want recent login required, got %v", err)
+	}
+	if err := uc.DeleteAccount(ctx, wx.Id, sessionOf(old), "guess"); !errors.Is(err, biz.ErrInvalidPassword) {
+		t.Fatalf("oauth user has no password, got %v", err)
+	}
+	_, fresh, _, err := oauthUC.Login(ctx, "wechat", "wx-1", "", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.DeleteAccount(ctx, wx.Id, sessionOf(fresh), ""); err != nil {
+		t.Fatalf("oauth user after fresh login: %v", err)
+	}
+
+	// 手机验证码注册的账号同理；他人的会话、缺失的会话都不能用于确认
+	bob, _, err := m.uc.Register(ctx, "bob", "passw0rd", "", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, bobPair, err := m.uc.Login(ctx, "bob", "passw0rd", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := phoneUC.SendCode(ctx, "13800138000", biz.ClientInfo{}); err != nil {
+		t.Fatal(err)
+	}
+	pu, pp, _, err := phoneUC.Login(ctx, "13800138000", sms.last["+8613800138000"], biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.DeleteAccount(ctx, pu.Id, sessionOf(bobPair), ""); !errors.Is(err, biz.ErrRecentLoginRequired) {
+		t.Fatalf("foreign session: want recent login required, got %v", err)
+	}
+	if err := uc.DeleteAccount(ctx, pu.Id, 0, ""); !errors.Is(err, biz.ErrRecentLoginRequired) {
+		t.Fatalf("no session: want recent login required, got %v", err)
+	}
+	if err := uc.DeleteAccount(ctx, pu.Id, sessionOf(pp), ""); err != nil {
+		t.Fatalf("phone user after fresh login: %v", err)
+	}
+
+	// 有密码且未绑定第三方的账号仍须提供密码
+	if err := uc.DeleteAccount(ctx, bob.Id, sessionOf(bobPair), ""); !errors.Is(err, biz.ErrInvalidPassword) {
+		t.Fatalf("password user: want invalid password, got %v", err)
+	}
+	if err := uc.DeleteAccount(ctx, bob.Id, 0, "passw0rd"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeleteAccountMemory(t *testing.T) {
+	ctx := context.Background()
+	m := newMemoryAuth(t, &conf.Auth{JwtSecret: "s"})
+	d := m.data
+	states := biz.NewPetStateUsecase(NewPetStateRepo(d), NewPetRepo(d), d)
+	var petIDs []int64
+	for _, name := range []string{"alice", "bob"} {
+		// 内存模式下注册时创建第一只宠物
+		u, _, err := m.uc.Register(ctx, name, "passw0rd", "", biz.ClientInfo{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, err := states.ApplyEffects(ctx, u.Id, 0, []biz.ItemEffect{{Stat: biz.PetStatMood, Delta: 10}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		petIDs = append(petIDs, s.PetID)
+	}
+
+	// 内存模式下注销同样清理该用户的宠物与宠物状态，他人数据不受影响
+	if err := NewAccountRepo(d).Delete(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := d.petByID[petIDs[0]]; ok {
+		t.Fatal("alice's pet must be deleted")
+	}
+	if _, ok := d.petStateByPet[petIDs[0]]; ok {
+		t.Fatal("alice's pet state must be deleted")
+	}
+	if _, ok := d.petByID[petIDs[1]]; !ok || len(d.petByID) != 1 || len(d.petStateByPet) != 1 {
+		t.Fatalf("bob's pet and state must remain: %d pets, %d states", len(d.petByID), len(d.petStateByPet))
+	}
+	if err := NewAccountRepo(d).Delete(ctx, 1); !errors.Is(err, biz.ErrUserNotFound) {
+		t.Fatalf("want user not found, got %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// ActivityRewardDO 映射 activity_rewards 表
+
+type ActivityRewardDO struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID     int64     `gorm:"column:user_id;not null"`
+	Rule       string    `gorm:"column:rule;not null"`
+	DedupeKey  string    `gorm:"column:dedupe_key;not null"`
+	RewardDate string    `gorm:"column:reward_date;not null"`
+	Coins      int32     `gorm:"column:coins;not null"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (ActivityRewardDO) TableName() string { return "activity_rewards" }
+
+// ActivityRewardRepo 实现 biz.ActivityRewardRepo（GORM；内存模式下保存在进程内）
+
+type ActivityRewardRepo struct {
+	data *Data
+
+	mu     sync.Mutex
+	mem    map[string]*ActivityRewardDO // user_id/rule/dedupe_key -> 记录
+	nextID int64
+}
+
+func NewActivityRewardRepo(d *Data) *ActivityRewardRepo {
+	return &ActivityRewardRepo{data: d, mem: map[string]*ActivityRewardDO{}, nextID: 1}
+}
+
+func activityRewardKey(userID int64, rule, dedupeKey string) string {
+	return fmt.Sprintf("%d/%s/%s", userID, rule, dedupeKey)
+}
+
+func (r *ActivityRewardRepo) Exists(ctx context.Context, userID int64, rule, dedupeKey string) (bool, error) {
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		_, ok := r.mem[activityRewardKey(userID, rule, dedupeKey)]
+		return ok, nil
+	}
+	var n int64
+	err := r.data.db(ctx).Model(&ActivityRewardDO{}).
+		Where("user_id=? AND rule=? AND dedupe_key=?", userID, rule, dedupeKey).Count(&n).Error
+	return n > 0, err
+}
+
+func (r *ActivityRewardRepo) CountOnDate(ctx context.Context, userID int64, rule, date string) (int32, error) {
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		var n int32
+		for _, v := range r.mem {
+			if v.UserID == userID && v.Rule == rule && v.RewardDate == date {
+				n++
+			}
+		}
+		return n, nil
+	}
+	var n int64
+	err := r.data.db(ctx).Model(&ActivityRewardDO{}).
+		Where("user_id=? AND rule=? AND reward_date=?", userID, rule, date).Count(&n).Error
+	return int32(n), err
+}
+
+func (r *ActivityRewardRepo) Create(ctx context.Context, g *biz.ActivityReward) error {
+	row := &ActivityRewardDO{UserID: g.UserID, Rule: g.Rule, DedupeKey: g.DedupeKey, RewardDate: g.RewardDate, Coins: g.Coins, CreatedAt: g.CreatedAt}
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		key := activityRewardKey(g.UserID, g.Rule, g.DedupeKey)
+		if _, ok := r.mem[key]; ok {
+			return biz.ErrActivityRewardExists
+		}
+		row.ID = r.nextID
+		r.nextID++
+		r.mem[key] = row
+		g.ID = row.ID
+		return nil
+	}
+	if err := r.data.db(ctx).Create(row).Error; err != nil {
+		if isDuplicateKey(err) {
+			return biz.ErrActivityRewardExists
+		}
+		return err
+	}
+	g.ID = row.ID
+	return nil
+}
+
+func (r *ActivityRewardRepo) PostAuthor(ctx context.Context, postID int64) (int64, error) {
+	if r.data.Gorm == nil {
+		return 0, nil
+	}
+	var author int64
+	if err := r.data.db(ctx).Model(&PostModel{}).Select("user_id").Where("id=?", postID).Take(&author).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return author, nil
+}
@@ -0,0 +1,75 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestActivityRewards(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username) VALUES (1, 'alice'), (2, 'bob'), (3, 'carol'), (4, 'dave');
+INSERT INTO posts (id, user_id, title) VALUES (10, 1, '我家猫'), (11, 2, 'bob 的狗');
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	wallet := biz.NewWalletUsecase(NewWalletRepo(d), d, log.DefaultLogger)
+	cfg := &conf.Rewards{Activity: &conf.Activity{Rules: []*conf.ActivityRule{
+		{Event: biz.EventPostCreated, Coins: 10, DailyCap: 1},
+		{Event: biz.EventPostLiked, Coins: 1, DailyCap: 2},
+		{Event: biz.EventCommentCreated, Coins: 2, MinContentRunes: 5},
+		{Event: biz.EventPetChatted, Coins: 5, DailyCap: 1},
+	}}}
+	levels := biz.NewPetLevelUsecase(NewPetLevelRepo(d), NewPetRepo(d), wallet, d, cfg, log.DefaultLogger)
+	bus := biz.NewEventBus(biz.NewActivityRewardUsecase(NewActivityRewardRepo(d), wallet, d, cfg, log.DefaultLogger), levels, log.DefaultLogger)
+	community := biz.NewCommunityUsecase(NewCommunityRepo(d), bus)
+	avatar := biz.NewAvatarUsecase(NewAvatarRepo(d), biz.NewPetUsecase(NewPetRepo(d), NewAvatarRepo(d), levels, d), levels, bus, nil)
+
+	// 点赞奖励帖子作者：自己点赞不奖励，取消后重新点赞不重复奖励，超过每日上限不奖励
+	for _, liker := range []int64{1, 2, 2, 3, 4} {
+		if err := community.LikePost(ctx, liker, 10); err != nil {
+			t.Fatal(err)
+		}
+		if liker == 2 {
+			d.Gorm.Exec("DELETE FROM likes WHERE user_id=2")
+		}
+	}
+	// 评论：内容过短、重复评论同一帖子、评论自己的帖子均不奖励
+	for _, e := range []*biz.ActivityEvent{
+		{UserID: 1, PostID: 11, Content: "好可爱"},
+		{UserID: 1, PostID: 11, Content: "好可爱的小狗狗呀"},
+		{UserID: 1, PostID: 11, Content: "再评论一次也没有奖励"},
+		{UserID: 2, PostID: 11, Content: "这是我自己的帖子哦"},
+	} {
+		e.Type = biz.EventCommentCreated
+		bus.Publish(ctx, e)
+	}
+	// 发帖：每日上限 1 次
+	bus.Publish(ctx, &biz.ActivityEvent{Type: biz.EventPostCreated, UserID: 2, PostID: 11})
+	bus.Publish(ctx, &biz.ActivityEvent{Type: biz.EventPostCreated, UserID: 2, PostID: 12})
+	// 聊天：每天首次
+	for i := 0; i < 2; i++ {
+		if _, err := avatar.SaveUserMessage(ctx, 3, nil, "今天开心吗"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for id, want := range map[int64]int32{1: 2 + 2, 2: 10, 3: 5, 4: 0} {
+		if got, _ := wallet.Balance(ctx, id); got != want {
+			t.Fatalf("user %d coins: want %d, got %d", id, want, got)
+		}
+	}
+	var n int64
+	d.Gorm.Model(&ActivityRewardDO{}).Count(&n)
+	var ledger []CoinTransactionDO
+	d.Gorm.Where("reason=?", biz.CoinReasonReward).Find(&ledger)
+	if n != 5 || len(ledger) != 5 {
+		t.Fatalf("each reward should have one record and one ledger entry: %d %d", n, len(ledger))
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 	"time"
 
 	"pet-angel/internal/biz"
 
+	mysqldrv "github.com/go-sql-driver/mysql"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -20,44 +22,68 @@ type AuthRepo struct {
 
 func NewAuthRepo(d *Data) *AuthRepo { return &AuthRepo{data: d} }
 
-func dtoToBiz(u *UserDTO) *biz.User {
+// userToBiz 内存用户转业务实体，宠物字段取自当前宠物（调用方持有 mu 读锁）
+func (d *Data) userToBiz(u *UserDTO) *biz.User {
 	if u == nil {
 		return nil
 	}
-	return &biz.User{
+	out := &biz.User{
 		Id:          u.ID,
 		Username:    u.Username,
 		Password:    u.Password,
+		Phone:       u.Phone,
 		Nickname:    u.Nickname,
 		Avatar:      u.Avatar,
-		ModelID:     u.ModelID,
-		ModelURL:    u.ModelURL,
-		PetName:     u.PetName,
-		PetAvatar:   u.PetAvatar,
-		PetSex:      u.PetSex,
-		Kind:        u.Kind,
-		Weight:      u.Weight,
-		Hobby:       u.Hobby,
+		ActivePetID: u.ActivePetID,
 		Description: u.Description,
 		Coins:       u.Coins,
+		Role:        u.Role,
 		CreatedAt:   time.Unix(u.CreatedAt, 0),
 	}
+	if p := d.petByID[u.ActivePetID]; p != nil {
+		out.ModelID, out.ModelURL = p.ModelID, p.ModelURL
+		out.PetName, out.PetAvatar, out.PetSex = p.Name, p.Avatar, p.Sex
+		out.Kind, out.Weight, out.Hobby = p.Kind, p.Weight, p.Hobby
+	}
+	return out
+}
+
+// userPet 用户资料中的宠物字段
+func userPet(user *biz.User) *PetDO {
+	return &PetDO{
+		UserID: user.Id, Name: user.PetName, Avatar: user.PetAvatar, Sex: user.PetSex, Kind: user.Kind, Weight: user.Weight, Hobby: user.Hobby,
+		ModelID: user.ModelID, ModelURL: user.ModelURL,
+	}
+}
+
+// hasPetFields 资料更新是否包含宠物字段
+func hasPetFields(user *biz.User) bool {
+	return user.PetName != "" || user.PetAvatar != "" || user.PetSex != 0 || user.Kind != "" || user.Weight != 0 || user.Hobby != "" || user.ModelID != 0
 }
 
 // --- MySQL helpers ---
 
-func (r *AuthRepo) getByUsernameSQL(ctx context.Context, username string) (*biz.User, error) {
+// isDuplicateKey 判断是否为 MySQL 唯一键冲突（Error 1062）
+func isDuplicateKey(err error) bool {
+	var me *mysqldrv.MySQLError
+	return errors.As(err, &me) && me.Number == 1062
+}
+
+// getUserSQL 按条件查询单个用户（where 中的列属于 users）；phone 允许为 NULL（未绑定手机号）；宠物字段取自当前宠物
+func (r *AuthRepo) getUserSQL(ctx context.Context, where string, arg interface{}) (*biz.User, error) {
 	row := r.data.DB.QueryRowContext(
 		ctx,
-		`SELECT id,username,password,nickname,avatar,model_id,model_url,pet_name,pet_avatar,pet_sex,kind,weight,hobby,description,coins,created_at
-		 FROM users WHERE username=?`,
-		username,
+		`SELECT u.id,u.username,u.password,COALESCE(u.phone,''),u.nickname,u.avatar,u.active_pet_id,
+		        COALESCE(p.model_id,0),COALESCE(p.model_url,''),COALESCE(p.name,''),COALESCE(p.avatar,''),COALESCE(p.sex,0),
+		        COALESCE(p.kind,''),COALESCE(p.weight,0),COALESCE(p.hobby,''),u.description,u.coins,u.role,u.created_at
+		 FROM users u LEFT JOIN pets p ON p.id=u.active_pet_id WHERE u.`+where,
+		arg,
 	)
 	var u biz.User
 	var createdAt time.Time
 	err := row.Scan(
-		&u.Id, &u.Username, &u.Password, &u.Nickname, &u.Avatar, &u.ModelID, &u.ModelURL,
-		&u.PetName, &u.PetAvatar, &u.PetSex, &u.Kind, &u.Weight, &u.Hobby, &u.Description, &u.Coins, &createdAt,
+		&u.Id, &u.Username, &u.Password, &u.Phone, &u.Nickname, &u.Avatar, &u.ActivePetID, &u.ModelID, &u.ModelURL,
+		&u.PetName, &u.PetAvatar, &u.PetSex, &u.Kind, &u.Weight, &u.Hobby, &u.Description, &u.Coins, &u.Role, &createdAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -69,89 +95,162 @@ func (r *AuthRepo) getByUsernameSQL(ctx context.Context, username string) (*biz.
 	return &u, nil
 }
 
-func (r *AuthRepo) getByIDSQL(ctx context.Context, userID int64) (*biz.User, error) {
-	row := r.data.DB.QueryRowContext(
-		ctx,
-		`SELECT id,username,password,nickname,avatar,model_id,model_url,pet_name,pet_avatar,pet_sex,kind,weight,hobby,description,coins,created_at
-		 FROM users WHERE id=?`,
-		userID,
-	)
-	var u biz.User
-	var createdAt time.Time
-	err := row.Scan(
-		&u.Id, &u.Username, &u.Password, &u.Nickname, &u.Avatar, &u.ModelID, &u.ModelURL,
-		&u.PetName, &u.PetAvatar, &u.PetSex, &u.Kind, &u.Weight, &u.Hobby, &u.Description, &u.Coins, &createdAt,
-	)
+// hashPassword bcrypt 哈希；空密码保存为空串（该用户无法用密码登录）
+func hashPassword(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, biz.ErrUserNotFound
-		}
-		return nil, err
+		return "", err
 	}
-	u.CreatedAt = createdAt
-	return &u, nil
+	return string(hash), nil
 }
 
+// nullablePhone 未绑定手机号时写入 NULL，避免空串触发唯一索引冲突
+func nullablePhone(phone string) sql.NullString {
+	return sql.NullString{String: phone, Valid: phone != ""}
+}
+
+// createSQL 在一个事务内写入用户与其第一只宠物（资料中的宠物字段），并设为当前宠物
 func (r *AuthRepo) createSQL(ctx context.Context, user *biz.User) (int64, error) {
 	// 密码哈希
-	hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	hash, err := hashPassword(user.Password)
 	if err != nil {
 		return 0, err
 	}
 	// 默认模型与URL
 	if user.ModelURL == "" {
-		user.ModelURL = "/models/Dog_1.glb"
+		user.ModelURL = biz.DefaultPetModelURL
+	}
+	if user.Role == "" {
+		user.Role = biz.RoleUser
 	}
-	res, err := r.data.DB.ExecContext(
+	tx, err := r.data.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	res, err := tx.ExecContext(
 		ctx,
-		`INSERT INTO users(
-		 username,password,nickname,avatar,model_id,model_url,pet_name,pet_avatar,pet_sex,kind,weight,hobby,description,coins,created_at,updated_at
-		) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,NOW(),NOW())`,
-		user.Username, string(hash), user.Nickname, user.Avatar, user.ModelID, user.ModelURL, user.PetName, user.PetAvatar, user.PetSex, user.Kind, user.Weight, user.Hobby, user.Description, 0,
+		`INSERT INTO users(username,password,phone,nickname,avatar,description,coins,role,created_at,updated_at)
+		 VALUES (?,?,?,?,?,?,?,?,NOW(),NOW())`,
+		user.Username, hash, nullablePhone(user.Phone), user.Nickname, user.Avatar, user.Description, 0, user.Role,
 	)
 	if err != nil {
+		// 并发注册同名用户/同一手机号时由唯一索引 uk_username/uk_phone 兜底
+		if isDuplicateKey(err) {
+			var me *mysqldrv.MySQLError
+			if errors.As(err, &me) && strings.Contains(me.Message, "uk_phone") {
+				return 0, biz.ErrPhoneAlreadyExists
+			}
+			return 0, biz.ErrUserAlreadyExists
+		}
 		return 0, err
 	}
 	id, err := res.LastInsertId()
 	if err != nil {
 		return 0, err
 	}
+	user.Id = id
+	petID, err := insertPetSQL(ctx, tx, userPet(user))
+	if err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE users SET active_pet_id=? WHERE id=?`, petID, id); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	user.ActivePetID = petID
 	return id, nil
 }
 
+func insertPetSQL(ctx context.Context, tx *sql.Tx, p *PetDO) (int64, error) {
+	res, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO pets(user_id,name,avatar,sex,kind,weight,hobby,model_id,model_url,created_at,updated_at)
+		 VALUES (?,?,?,?,?,?,?,?,?,NOW(),NOW())`,
+		p.UserID, p.Name, p.Avatar, p.Sex, p.Kind, p.Weight, p.Hobby, p.ModelID, p.ModelURL,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+// updateInfoSQL 更新用户资料；宠物字段写入当前宠物，尚无宠物时新建一只并设为当前宠物
 func (r *AuthRepo) updateInfoSQL(ctx context.Context, user *biz.User) error {
-	_, err := r.data.DB.ExecContext(
+	tx, err := r.data.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	var activePetID int64
+	if err := tx.QueryRowContext(ctx, `SELECT active_pet_id FROM users WHERE id=? FOR UPDATE`, user.Id).Scan(&activePetID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return biz.ErrUserNotFound
+		}
+		return err
+	}
+	if _, err := tx.ExecContext(
 		ctx,
 		`UPDATE users
 		 SET nickname=IF(?<>'',?,nickname),
 		     avatar=IF(?<>'',?,avatar),
-		     model_id=IF(?<>0,?,model_id),
-		     model_url=IF(?<>'',?,model_url),
-		     pet_name=IF(?<>'',?,pet_name),
-		     pet_avatar=IF(?<>'',?,pet_avatar),
-		     pet_sex=IF(?<>0,?,pet_sex),
-		     kind=IF(?<>'',?,kind),
-		     weight=IF(?<>0,?,weight),
-		     hobby=IF(?<>'',?,hobby),
 		     description=IF(?<>'',?,description),
-		     coins=IF(?<>0,?,coins),
 		     updated_at=NOW()
 		 WHERE id=?`,
 		user.Nickname, user.Nickname,
 		user.Avatar, user.Avatar,
-		user.ModelID, user.ModelID,
-		user.ModelURL, user.ModelURL,
-		user.PetName, user.PetName,
-		user.PetAvatar, user.PetAvatar,
-		user.PetSex, user.PetSex,
-		user.Kind, user.Kind,
-		user.Weight, user.Weight,
-		user.Hobby, user.Hobby,
 		user.Description, user.Description,
-		user.Coins, user.Coins,
 		user.Id,
-	)
-	return err
+	); err != nil {
+		return err
+	}
+	switch {
+	case !hasPetFields(user):
+	case activePetID == 0:
+		p := userPet(user)
+		if p.ModelURL == "" {
+			p.ModelURL = biz.DefaultPetModelURL
+		}
+		petID, err := insertPetSQL(ctx, tx, p)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE users SET active_pet_id=? WHERE id=?`, petID, user.Id); err != nil {
+			return err
+		}
+	default:
+		if _, err := tx.ExecContext(
+			ctx,
+			`UPDATE pets
+			 SET model_id=IF(?<>0,?,model_id),
+			     model_url=IF(?<>'',?,model_url),
+			     name=IF(?<>'',?,name),
+			     avatar=IF(?<>'',?,avatar),
+			     sex=IF(?<>0,?,sex),
+			     kind=IF(?<>'',?,kind),
+			     weight=IF(?<>0,?,weight),
+			     hobby=IF(?<>'',?,hobby),
+			     updated_at=NOW()
+			 WHERE id=?`,
+			user.ModelID, user.ModelID,
+			user.ModelURL, user.ModelURL,
+			user.PetName, user.PetName,
+			user.PetAvatar, user.PetAvatar,
+			user.PetSex, user.PetSex,
+			user.Kind, user.Kind,
+			user.Weight, user.Weight,
+			user.Hobby, user.Hobby,
+			activePetID,
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
 }
 
 func (r *AuthRepo) getModelPathSQL(ctx context.Context, modelID int64) (string, error) {
@@ -170,7 +269,7 @@ func (r *AuthRepo) getModelPathSQL(ctx context.Context, modelID int64) (string,
 
 func (r *AuthRepo) GetByUsername(ctx context.Context, username string) (*biz.User, error) {
 	if r.data.DB != nil {
-		return r.getByUsernameSQL(ctx, username)
+		return r.getUserSQL(ctx, "username=?", username)
 	}
 	// in-memory fallback
 	r.data.mu.RLock()
@@ -179,12 +278,12 @@ func (r *AuthRepo) GetByUsername(ctx context.Context, username string) (*biz.Use
 	if !ok {
 		return nil, biz.ErrUserNotFound
 	}
-	return dtoToBiz(u), nil
+	return r.data.userToBiz(u), nil
 }
 
 func (r *AuthRepo) GetByID(ctx context.Context, userID int64) (*biz.User, error) {
 	if r.data.DB != nil {
-		return r.getByIDSQL(ctx, userID)
+		return r.getUserSQL(ctx, "id=?", userID)
 	}
 	r.data.mu.RLock()
 	defer r.data.mu.RUnlock()
@@ -192,7 +291,25 @@ func (r *AuthRepo) GetByID(ctx context.Context, userID int64) (*biz.User, error)
 	if !ok {
 		return nil, biz.ErrUserNotFound
 	}
-	return dtoToBiz(u), nil
+	return r.data.userToBiz(u), nil
+}
+
+// GetByPhone 按手机号查询（内存模式下线性扫描）
+func (r *AuthRepo) GetByPhone(ctx context.Context, phone string) (*biz.User, error) {
+	if phone == "" {
+		return nil, biz.ErrUserNotFound
+	}
+	if r.data.DB != nil {
+		return r.getUserSQL(ctx, "phone=?", phone)
+	}
+	r.data.mu.RLock()
+	defer r.data.mu.RUnlock()
+	for _, u := range r.data.userByID {
+		if u.Phone == phone {
+			return r.data.userToBiz(u), nil
+		}
+	}
+	return nil, biz.ErrUserNotFound
 }
 
 func (r *AuthRepo) Create(ctx context.Context, user *biz.User) (int64, error) {
@@ -203,35 +320,46 @@ func (r *AuthRepo) Create(ctx context.Context, user *biz.User) (int64, error) {
 	r.data.mu.Lock()
 	defer r.data.mu.Unlock()
 	if _, exists := r.data.userByUsername[user.Username]; exists {
-		return 0, errors.New("username exists")
+		return 0, biz.ErrUserAlreadyExists
+	}
+	if user.Phone != "" {
+		for _, u := range r.data.userByID {
+			if u.Phone == user.Phone {
+				return 0, biz.ErrPhoneAlreadyExists
+			}
+		}
 	}
-	id := r.data.nextUserID
-	r.data.nextUserID++
 	// 内存模式下也保存 bcrypt 哈希，保持与 MySQL 行为一致
-	hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	hash, err := hashPassword(user.Password)
 	if err != nil {
 		return 0, err
 	}
+	id := r.data.nextUserID
+	r.data.nextUserID++
 	if user.ModelURL == "" {
-		user.ModelURL = "/models/Dog_1.glb"
+		user.ModelURL = biz.DefaultPetModelURL
 	}
+	if user.Role == "" {
+		user.Role = biz.RoleUser
+	}
+	now := time.Now()
+	user.Id = id
+	pet := userPet(user)
+	pet.CreatedAt, pet.UpdatedAt = now, now
+	r.data.createPet(pet)
+	user.ActivePetID = pet.ID
 	d := &UserDTO{
 		ID:          id,
 		Username:    user.Username,
-		Password:    string(hash),
+		Password:    hash,
+		Phone:       user.Phone,
 		Nickname:    user.Nickname,
 		Avatar:      user.Avatar,
-		ModelID:     user.ModelID,
-		ModelURL:    user.ModelURL,
-		PetName:     user.PetName,
-		PetAvatar:   user.PetAvatar,
-		PetSex:      user.PetSex,
-		Kind:        user.Kind,
-		Weight:      user.Weight,
-		Hobby:       user.Hobby,
+		ActivePetID: pet.ID,
 		Description: user.Description,
 		Coins:       0,
-		CreatedAt:   time.Now().Unix(),
+		Role:        user.Role,
+		CreatedAt:   now.Unix(),
 	}
 	r.data.userByID[id] = d
 	r.data.userByUsername[user.Username] = d
@@ -255,44 +383,83 @@ func (r *AuthRepo) UpdateInfo(ctx context.Context, user *biz.User) error {
 	if user.Avatar != "" {
 		u.Avatar = user.Avatar
 	}
+	if user.Description != "" {
+		u.Description = user.Description
+	}
+	if !hasPetFields(user) {
+		return nil
+	}
+	p := r.data.petByID[u.ActivePetID]
+	if p == nil {
+		p = &PetDO{UserID: u.ID, ModelURL: biz.DefaultPetModelURL, CreatedAt: time.Now()}
+		r.data.createPet(p)
+		u.ActivePetID = p.ID
+	}
 	if user.ModelID != 0 {
-		u.ModelID = user.ModelID
+		p.ModelID = user.ModelID
 	}
 	if user.ModelURL != "" {
-		u.ModelURL = user.ModelURL
+		p.ModelURL = user.ModelURL
 	}
 	if user.PetName != "" {
-		u.PetName = user.PetName
+		p.Name = user.PetName
 	}
 	if user.PetAvatar != "" {
-		u.PetAvatar = user.PetAvatar
+		p.Avatar = user.PetAvatar
 	}
 	if user.PetSex != 0 {
-		u.PetSex = user.PetSex
+		p.Sex = user.PetSex
 	}
 	if user.Kind != "" {
-		u.Kind = user.Kind
+		p.Kind = user.Kind
 	}
 	if user.Weight != 0 {
-		u.Weight = user.Weight
+		p.Weight = user.Weight
 	}
 	if user.Hobby != "" {
-		u.Hobby = user.Hobby
+		p.Hobby = user.Hobby
 	}
-	if user.Description != "" {
-		u.Description = user.Description
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdatePassword 以 bcrypt 哈希写入新密码
+func (r *AuthRepo) UpdatePassword(ctx context.Context, userID int64, password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	if r.data.DB != nil {
+		res, err := r.data.DB.ExecContext(ctx, `UPDATE users SET password=?, updated_at=NOW() WHERE id=?`, string(hash), userID)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return biz.ErrUserNotFound
+		}
+		return nil
 	}
-	// 允许直接修改金币（demo模式）
-	if user.Coins != 0 {
-		u.Coins = user.Coins
+	r.data.mu.Lock()
+	defer r.data.mu.Unlock()
+	u, ok := r.data.userByID[userID]
+	if !ok {
+		return biz.ErrUserNotFound
 	}
+	u.Password = string(hash)
 	return nil
 }
 
-func (r *AuthRepo) UpdateCoins(ctx context.Context, userID int64, delta int32) error {
+// UpdateRole 修改用户角色
+func (r *AuthRepo) UpdateRole(ctx context.Context, userID int64, role string) error {
 	if r.data.DB != nil {
-		_, err := r.data.DB.ExecContext(ctx, `UPDATE users SET coins=GREATEST(0,coins+?), updated_at=NOW() WHERE id=?`, delta, userID)
-		return err
+		res, err := r.data.DB.ExecContext(ctx, `UPDATE users SET role=?, updated_at=NOW() WHERE id=?`, role, userID)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return biz.ErrUserNotFound
+		}
+		return nil
 	}
 	r.data.mu.Lock()
 	defer r.data.mu.Unlock()
@@ -300,10 +467,7 @@ func (r *AuthRepo) UpdateCoins(ctx context.Context, userID int64, delta int32) e
 	if !ok {
 		return biz.ErrUserNotFound
 	}
-	u.Coins += delta
-	if u.Coins < 0 {
-		u.Coins = 0
-	}
+	u.Role = role
 	return nil
 }
 
@@ -312,5 +476,5 @@ func (r *AuthRepo) GetModelPath(ctx context.Context, modelID int64) (string, err
 		return r.getModelPathSQL(ctx, modelID)
 	}
 	// 内存模式：无模型表，返回默认
-	return "/models/Dog_1.glb", nil
+	return biz.DefaultPetModelURL, nil
 }
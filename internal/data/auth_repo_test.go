@@ -0,0 +1,427 @@
+package data
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"pet-angel/internal/auth"
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+	jwtutil "pet-angel/internal/util/jwt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// memoryAuth 内存模式下的认证用例及其依赖
+type memoryAuth struct {
+	uc       *biz.AuthUsecase
+	data     *Data
+	sessions *SessionRepo
+	denylist *TokenDenylist
+	attempts *LoginAttemptRepo
+	sent     []*biz.Notification
+}
+
+func (m *memoryAuth) Send(_ context.Context, n *biz.Notification) error {
+	m.sent = append(m.sent, n)
+	return nil
+}
+
+func newMemoryAuth(t *testing.T, c *conf.Auth) *memoryAuth {
+	t.Helper()
+	d, cleanup, err := NewData(nil, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	keys, err := auth.NewKeyring(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &memoryAuth{data: d, sessions: NewSessionRepo(d), denylist: NewTokenDenylist(d), attempts: NewLoginAttemptRepo(d)}
+	m.uc = biz.NewAuthUsecase(NewAuthRepo(d), m.sessions, m.denylist, NewPasswordResetRepo(d), m.attempts, m, keys, c, log.DefaultLogger)
+	return m
+}
+
+func newMemoryAuthUsecase(t *testing.T, c *conf.Auth) *biz.AuthUsecase {
+	return newMemoryAuth(t, c).uc
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	ctx := context.Background()
+	uc := newMemoryAuthUsecase(t, &conf.Auth{JwtSecret: "s"})
+
+	// 参数校验
+	if _, _, err := uc.Register(ctx, "1abc", "passw0rd", "", biz.ClientInfo{}); !errors.Is(err, biz.ErrInvalidUsername) {
+		t.Fatalf("want invalid username, got %v", err)
+	}
+	if _, _, err := uc.Register(ctx, "alice", "password", "", biz.ClientInfo{}); !errors.Is(err, biz.ErrWeakPassword) {
+		t.Fatalf("want weak password, got %v", err)
+	}
+
+	u, pair, err := uc.Register(ctx, "alice", "passw0rd", "", biz.ClientInfo{})
+	if err != nil || pair.AccessToken == "" || u.Id == 0 || u.Nickname != "alice" {
+		t.Fatalf("register: %+v %+v %v", u, pair, err)
+	}
+	if _, _, err := uc.Register(ctx, "alice", "passw0rd", "", biz.ClientInfo{}); !errors.Is(err, biz.ErrUserAlreadyExists) {
+		t.Fatalf("want duplicate, got %v", err)
+	}
+
+	// 关闭 auto_register 时：不存在的用户不会被创建，密码错误与用户不存在区分返回
+	if _, _, err := uc.Login(ctx, "bob", "passw0rd", biz.ClientInfo{}); !errors.Is(err, biz.ErrUserNotFound) {
+		t.Fatalf("want user not found, got %v", err)
+	}
+	if _, _, err := uc.Login(ctx, "bob", "passw0rd", biz.ClientInfo{}); !errors.Is(err, biz.ErrUserNotFound) {
+		t.Fatalf("login must not create users, got %v", err)
+	}
+	if _, _, err := uc.Login(ctx, "alice", "wrong", biz.ClientInfo{}); !errors.Is(err, biz.ErrInvalidCredentials) {
+		t.Fatalf("want invalid credentials, got %v", err)
+	}
+	if got, _, err := uc.Login(ctx, "alice", "passw0rd", biz.ClientInfo{}); err != nil || got.Id != u.Id {
+		t.Fatalf("login: %+v %v", got, err)
+	}
+}
+
+func TestLoginAutoRegister(t *testing.T) {
+	ctx := context.Background()
+	uc := newMemoryAuthUsecase(t, &conf.Auth{JwtSecret: "s", AutoRegister: true})
+	u, _, err := uc.Login(ctx, "carol", "anything", biz.ClientInfo{})
+	if err != nil || u.Id == 0 {
+		t.Fatalf("auto register: %+v %v", u, err)
+	}
+	if again, _, err := uc.Login(ctx, "carol", "anything", biz.ClientInfo{}); err != nil || again.Id != u.Id {
+		t.Fatalf("second login: %+v %v", again, err)
+	}
+}
+
+func TestRefreshRotationAndLogout(t *testing.T) {
+	ctx := context.Background()
+	m := newMemoryAuth(t, &conf.Auth{JwtSecret: "s"})
+	uc, denylist := m.uc, m.denylist
+
+	_, first, err := uc.Register(ctx, "dave", "passw0rd", "", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := uc.Refresh(ctx, first.RefreshToken)
+	if err != nil || second.RefreshToken == first.RefreshToken {
+		t.Fatalf("refresh: %+v %v", second, err)
+	}
+	// 旧 refresh token 只能使用一次；旧 access token 被拉黑
+	if _, err := uc.Refresh(ctx, first.RefreshToken); !errors.Is(err, biz.ErrInvalidRefreshToken) {
+		t.Fatalf("reused refresh token: want invalid, got %v", err)
+	}
+	oldClaims, _ := jwtutil.Parse("s", first.AccessToken)
+	if revoked, _ := denylist.Contains(ctx, oldClaims.ID); !revoked {
+		t.Fatal("rotated access token should be denylisted")
+	}
+
+	// Logout：当前 access token 拉黑，会话的 refresh token 失效
+	claims, _ := jwtutil.Parse("s", second.AccessToken)
+	if err := uc.Logout(ctx, claims.SessionID, claims.ID, claims.ExpiresAt.Time); err != nil {
+		t.Fatal(err)
+	}
+	if revoked, _ := denylist.Contains(ctx, claims.ID); !revoked {
+		t.Fatal("logged out access token should be denylisted")
+	}
+	if _, err := uc.Refresh(ctx, second.RefreshToken); !errors.Is(err, biz.ErrInvalidRefreshToken) {
+		t.Fatalf("refresh after logout: want invalid, got %v", err)
+	}
+
+	// LogoutAll：吊销所有设备的会话
+	u, a, _ := uc.Login(ctx, "dave", "passw0rd", biz.ClientInfo{})
+	_, b, _ := uc.Login(ctx, "dave", "passw0rd", biz.ClientInfo{})
+	if err := uc.LogoutAll(ctx, u.Id); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []*biz.TokenPair{a, b} {
+		if _, err := uc.Refresh(ctx, p.RefreshToken); !errors.Is(err, biz.ErrInvalidRefreshToken) {
+			t.Fatalf("refresh after logout all: want invalid, got %v", err)
+		}
+	}
+}
+
+func TestListAndRevokeSessions(t *testing.T) {
+	ctx := context.Background()
+	m := newMemoryAuth(t, &conf.Auth{JwtSecret: "s"})
+	uc, sessions, denylist := m.uc, m.sessions, m.denylist
+
+	u, phone, err := uc.Register(ctx, "erin", "passw0rd", "", biz.ClientInfo{UserAgent: "iPhone", IP: "10.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, tablet, _ := uc.Login(ctx, "erin", "passw0rd", biz.ClientInfo{UserAgent: "iPad", IP: "10.0.0.2"})
+	other, _, _ := uc.Register(ctx, "frank", "passw0rd", "", biz.ClientInfo{})
+
+	phoneClaims, _ := jwtutil.Parse("s", phone.AccessToken)
+	if phoneClaims.SessionID == 0 {
+		t.Fatal("access token should carry sid")
+	}
+
+	// 手机会话较早登录，但最近活跃；Flush 后排在最前
+	tracker := biz.NewSessionTracker(sessions, log.DefaultLogger)
+	tracker.Touch(phoneClaims.SessionID)
+	if err := tracker.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := uc.ListSessions(ctx, u.Id)
+	if err != nil || len(list) != 2 {
+		t.Fatalf("list sessions: %d %v", len(list), err)
+	}
+	if list[0].ID != phoneClaims.SessionID || list[0].UserAgent != "iPhone" || list[0].IP != "10.0.0.1" {
+		t.Fatalf("most recently seen session first, got %+v", list[0])
+	}
+
+	// 不能踢他人会话
+	if err := uc.RevokeSession(ctx, other.Id, phoneClaims.SessionID); !errors.Is(err, biz.ErrSessionNotFound) {
+		t.Fatalf("revoke other's session: want not found, got %v", err)
+	}
+	if err := uc.RevokeSession(ctx, u.Id, phoneClaims.SessionID); err != nil {
+		t.Fatal(err)
+	}
+	if revoked, _ := denylist.Contains(ctx, phoneClaims.ID); !revoked {
+		t.Fatal("revoked session's access token should be denylisted")
+	}
+	if _, err := uc.Refresh(ctx, phone.RefreshToken); !errors.Is(err, biz.ErrInvalidRefreshToken) {
+		t.Fatalf("refresh revoked session: want invalid, got %v", err)
+	}
+	if _, err := uc.Refresh(ctx, tablet.RefreshToken); err != nil {
+		t.Fatalf("other session must survive: %v", err)
+	}
+	if list, _ := uc.ListSessions(ctx, u.Id); len(list) != 1 {
+		t.Fatalf("want 1 active session, got %d", len(list))
+	}
+}
+
+func TestChangeAndResetPassword(t *testing.T) {
+	ctx := context.Background()
+	m := newMemoryAuth(t, &conf.Auth{JwtSecret: "s"})
+	uc := m.uc
+
+	u, cur, err := uc.Register(ctx, "gina", "passw0rd", "", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, other, _ := uc.Login(ctx, "gina", "passw0rd", biz.ClientInfo{})
+	curClaims, _ := jwtutil.Parse("s", cur.AccessToken)
+
+	// 修改密码：旧密码错误拒绝；成功后仅保留当前会话
+	if err := uc.ChangePassword(ctx, u.Id, curClaims.SessionID, "wrong", "newpass1"); !errors.Is(err, biz.ErrInvalidPassword) {
+		t.Fatalf("want invalid password, got %v", err)
+	}
+	if err := uc.ChangePassword(ctx, u.Id, curClaims.SessionID, "passw0rd", "newpass1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.Refresh(ctx, other.RefreshToken); !errors.Is(err, biz.ErrInvalidRefreshToken) {
+		t.Fatalf("other session should be revoked, got %v", err)
+	}
+	if _, err := uc.Refresh(ctx, cur.RefreshToken); err != nil {
+		t.Fatalf("current session should survive: %v", err)
+	}
+	if _, _, err := uc.Login(ctx, "gina", "newpass1", biz.ClientInfo{}); err != nil {
+		t.Fatalf("login with new password: %v", err)
+	}
+
+	// 重置密码：未知用户静默成功且不发送
+	if err := uc.RequestPasswordReset(ctx, "nobody"); err != nil || len(m.sent) != 0 {
+		t.Fatalf("unknown user: err %v sent %d", err, len(m.sent))
+	}
+	if err := uc.RequestPasswordReset(ctx, "gina"); err != nil || len(m.sent) != 1 {
+		t.Fatalf("request reset: err %v sent %d", err, len(m.sent))
+	}
+	code := regexp.MustCompile(`\d{6}`).FindString(m.sent[0].Content)
+	if err := uc.ResetPassword(ctx, "gina", "000000x", "resetpw1"); !errors.Is(err, biz.ErrInvalidResetCode) {
+		t.Fatalf("wrong code: want invalid, got %v", err)
+	}
+	if err := uc.ResetPassword(ctx, "gina", code, "resetpw1"); err != nil {
+		t.Fatal(err)
+	}
+	// 单次有效
+	if err := uc.ResetPassword(ctx, "gina", code, "resetpw2"); !errors.Is(err, biz.ErrInvalidResetCode) {
+		t.Fatalf("reused code: want invalid, got %v", err)
+	}
+	if _, _, err := uc.Login(ctx, "gina", "resetpw1", biz.ClientInfo{}); err != nil {
+		t.Fatalf("login after reset: %v", err)
+	}
+}
+
+// setupResetData sqlite 上的 password_resets 表
+func setupResetData(t *testing.T) *Data {
+	t.Helper()
+	gdb, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := gdb.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// :memory: 每个连接是独立的库
+	db.SetMaxOpenConns(1)
+	if err := gdb.Exec(`
+CREATE TABLE password_resets (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, code_hash TEXT NOT NULL, attempts INTEGER NOT NULL DEFAULT 0,
+  expires_at DATETIME NOT NULL, used_at DATETIME, created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	return &Data{Gorm: gdb}
+}
+
+func TestResetCodeAttemptCap(t *testing.T) {
+	ctx := context.Background()
+	// 数据库与内存两种实现：并发尝试时总次数不超过上限
+	for name, d := range map[string]*Data{"gorm": setupResetData(t), "memory": newMemoryAuth(t, &conf.Auth{JwtSecret: "s"}).data} {
+		repo := NewPasswordResetRepo(d)
+		id, err := repo.Create(ctx, &biz.PasswordReset{UserID: 1, CodeHash: "x", ExpiresAt: time.Now().Add(time.Hour)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var wg sync.WaitGroup
+		var taken int32
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if repo.TakeAttempt(ctx, id, 5) == nil {
+					atomic.AddInt32(&taken, 1)
+				}
+			}()
+		}
+		wg.Wait()
+		if taken != 5 {
+			t.Fatalf("%s: %d attempts taken, want 5", name, taken)
+		}
+		if err := repo.TakeAttempt(ctx, id, 5); !errors.Is(err, biz.ErrInvalidResetCode) {
+			t.Fatalf("%s: exhausted code: %v", name, err)
+		}
+	}
+}
+
+func TestLoginRehashesLegacyPlaintext(t *testing.T) {
+	ctx := context.Background()
+	m := newMemoryAuth(t, &conf.Auth{JwtSecret: "s"})
+	u, _, err := m.uc.Register(ctx, "hank", "passw0rd", "", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 模拟种子数据中的明文密码
+	m.data.userByID[u.Id].Password = "123456"
+
+	if _, _, err := m.uc.Login(ctx, "hank", "123456", biz.ClientInfo{}); err != nil {
+		t.Fatalf("legacy login: %v", err)
+	}
+	stored := m.data.userByID[u.Id].Password
+	if bcrypt.CompareHashAndPassword([]byte(stored), []byte("123456")) != nil {
+		t.Fatalf("password should be re-hashed, got %q", stored)
+	}
+	// 哈希串本身不能当作密码使用
+	if _, _, err := m.uc.Login(ctx, "hank", stored, biz.ClientInfo{}); !errors.Is(err, biz.ErrInvalidCredentials) {
+		t.Fatalf("hash as password: want invalid credentials, got %v", err)
+	}
+}
+
+func TestLoginLockoutBackoff(t *testing.T) {
+	ctx := context.Background()
+	m := newMemoryAuth(t, &conf.Auth{JwtSecret: "s", LoginThrottle: &conf.LoginThrottle{
+		MaxFailuresPerUser: 3,
+		MaxFailuresPerIp:   5,
+		BaseLockout:        durationpb.New(time.Minute),
+		MaxLockout:         durationpb.New(3 * time.Minute),
+	}})
+	if _, _, err := m.uc.Register(ctx, "ivy", "passw0rd", "", biz.ClientInfo{}); err != nil {
+		t.Fatal(err)
+	}
+	client := biz.ClientInfo{IP: "10.0.0.9"}
+	for i := 0; i < 3; i++ {
+		if _, _, err := m.uc.Login(ctx, "ivy", "wrong", client); !errors.Is(err, biz.ErrInvalidCredentials) {
+			t.Fatalf("attempt %d: want invalid credentials, got %v", i, err)
+		}
+	}
+	// 达到阈值后即使密码正确也被拒绝，并带 retry_after
+	_, _, err := m.uc.Login(ctx, "ivy", "passw0rd", client)
+	se := errors.FromError(err)
+	if se.Code != 429 || se.Reason != "LOGIN_LOCKED" || se.Metadata["retry_after"] != "60" {
+		t.Fatalf("want locked with retry_after=60, got %v %v", err, se.Metadata)
+	}
+	// 用户名维度锁定不影响同 IP 登录其它账号
+	if _, _, err := m.uc.Register(ctx, "jack", "passw0rd", "", biz.ClientInfo{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := m.uc.Login(ctx, "jack", "passw0rd", client); err != nil {
+		t.Fatalf("other account on same ip: %v", err)
+	}
+
+	// 指数退避：锁定到期后每次失败锁定时长翻倍，不超过 max_lockout
+	for _, want := range []string{"120", "180"} {
+		_ = m.attempts.Lock(ctx, "user:ivy", -time.Second, 15*time.Minute) // 模拟锁定到期
+		if _, _, err := m.uc.Login(ctx, "ivy", "wrong", client); !errors.Is(err, biz.ErrInvalidCredentials) {
+			t.Fatalf("want invalid credentials, got %v", err)
+		}
+		_, _, err := m.uc.Login(ctx, "ivy", "passw0rd", client)
+		if got := errors.FromError(err).Metadata["retry_after"]; got != want {
+			t.Fatalf("want retry_after=%s, got %v", want, err)
+		}
+	}
+
+	// 此时该 IP 也已达到阈值被锁定
+	if _, _, err := m.uc.Login(ctx, "jack", "passw0rd", client); errors.FromError(err).Reason != "LOGIN_LOCKED" {
+		t.Fatalf("want ip locked, got %v", err)
+	}
+
+	// 锁定到期后登录成功会清零用户名维度计数
+	_ = m.attempts.Lock(ctx, "user:ivy", -time.Second, 15*time.Minute)
+	if _, _, err := m.uc.Login(ctx, "ivy", "passw0rd", biz.ClientInfo{IP: "10.0.0.10"}); err != nil {
+		t.Fatalf("login after lock expired: %v", err)
+	}
+	if a, _ := m.attempts.Get(ctx, "user:ivy"); a.Failures != 0 {
+		t.Fatalf("failures should be reset, got %d", a.Failures)
+	}
+}
+
+func TestSeedAdminAndRoleClaims(t *testing.T) {
+	ctx := context.Background()
+	m := newMemoryAuth(t, &conf.Auth{JwtSecret: "s"})
+	repo := NewAuthRepo(m.data)
+
+	_, pair, err := m.uc.Register(ctx, "kate", "passw0rd", "", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, _ := jwtutil.Parse("s", pair.AccessToken); len(c.Roles) != 1 || c.Roles[0] != biz.RoleUser {
+		t.Fatalf("new user should carry role user, got %v", c.Roles)
+	}
+
+	// 提升已有用户：刷新后新 token 携带 admin 角色
+	if _, created, err := biz.SeedAdmin(ctx, repo, "kate", ""); err != nil || created {
+		t.Fatalf("promote: created=%v err=%v", created, err)
+	}
+	next, err := m.uc.Refresh(ctx, pair.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, _ := jwtutil.Parse("s", next.AccessToken); len(c.Roles) != 1 || c.Roles[0] != biz.RoleAdmin {
+		t.Fatalf("refreshed token should carry role admin, got %v", c.Roles)
+	}
+
+	// 新建管理员需满足注册规则
+	if _, _, err := biz.SeedAdmin(ctx, repo, "root", "weak"); !errors.Is(err, biz.ErrWeakPassword) {
+		t.Fatalf("want weak password, got %v", err)
+	}
+	u, created, err := biz.SeedAdmin(ctx, repo, "root", "adm1nPass")
+	if err != nil || !created || u.Role != biz.RoleAdmin {
+		t.Fatalf("create admin: %+v created=%v err=%v", u, created, err)
+	}
+	if got, _, err := m.uc.Login(ctx, "root", "adm1nPass", biz.ClientInfo{}); err != nil || got.Role != biz.RoleAdmin {
+		t.Fatalf("admin login: %+v %v", got, err)
+	}
+}
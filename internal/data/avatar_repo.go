@@ -10,24 +10,29 @@ import (
 	"pet-angel/internal/util"
 
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 )
 
 // GORM 模型定义（表结构与 1-init-tables.sql 对齐）
 
 // PetModelDO 映射 pet_models 表
 type PetModelDO struct {
-	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`     // 模型ID
-	Name      string    `gorm:"column:name;type:varchar(100);not null"` // 名称
-	Path      string    `gorm:"column:path;type:varchar(255);not null"` // 资源URL
-	Type      int32     `gorm:"column:type;not null"`                   // 0猫 1狗
-	IsDefault bool      `gorm:"column:is_default;not null"`             // 是否默认
-	SortOrder int32     `gorm:"column:sort_order;not null"`             // 排序
-	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`       // 创建时间
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"`     // 模型ID
+	Name        string    `gorm:"column:name;type:varchar(100);not null"` // 名称
+	Path        string    `gorm:"column:path;type:varchar(255);not null"` // 资源URL
+	Type        int32     `gorm:"column:type;not null"`                   // 0猫 1狗
+	IsDefault   bool      `gorm:"column:is_default;not null"`             // 是否默认
+	SortOrder   int32     `gorm:"column:sort_order;not null"`             // 排序
+	UnlockLevel int32     `gorm:"column:unlock_level;not null"`           // 解锁所需等级
+	UnlockCoins int32     `gorm:"column:unlock_coins;not null"`           // 解锁所需金币
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`       // 创建时间
 }
 
 func (PetModelDO) TableName() string { return "pet_models" }
 
+func (v *PetModelDO) toBiz() *biz.PetModel {
+	return &biz.PetModel{ID: v.ID, Name: v.Name, Path: v.Path, ModelType: v.Type, IsDefault: v.IsDefault, SortOrder: v.SortOrder, UnlockLevel: v.UnlockLevel, UnlockCoins: v.UnlockCoins, CreatedAt: v.CreatedAt}
+}
+
 // ItemDO 映射 items 表
 type ItemDO struct {
 	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"`     // 道具ID
@@ -35,17 +40,26 @@ type ItemDO struct {
 	Description string    `gorm:"column:description;type:varchar(255)"`   // 描述
 	IconPath    string    `gorm:"column:icon_path;type:varchar(255)"`     // 图标URL
 	CoinCost    int32     `gorm:"column:coin_cost;not null"`              // 消耗金币
+	SortOrder   int32     `gorm:"column:sort_order;not null"`             // 排序
+	Effects     string    `gorm:"column:effects;type:varchar(255)"`       // 使用效果，如 hunger:+30,mood:+10
 	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`       // 创建时间
 }
 
 func (ItemDO) TableName() string { return "items" }
 
+// toBiz 转换为业务实体；effects 格式错误时（例如手工修改数据库）视为无效果
+func (v *ItemDO) toBiz() *biz.Item {
+	effects, _ := biz.ParseItemEffects(v.Effects)
+	return &biz.Item{ID: v.ID, Name: v.Name, Description: v.Description, IconPath: v.IconPath, CoinCost: v.CoinCost, SortOrder: v.SortOrder, Effects: effects, CreatedAt: v.CreatedAt}
+}
+
 // MessageDO 映射 messages 表
 type MessageDO struct {
 	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"` // 消息ID
 	UserID      int64     `gorm:"column:user_id;not null"`            // 用户ID
+	PetID       int64     `gorm:"column:pet_id;not null"`             // 聊天对象宠物ID（小纸条为 0）
 	Sender      int32     `gorm:"column:sender;not null"`             // 0用户 1AI
-	MessageType int32     `gorm:"column:message_type;not null"`       // 0聊天 1小纸条
+	MessageType int32     `gorm:"column:message_type;not null"`       // 0聊天 1小纸条 2提醒
 	IsLocked    bool      `gorm:"column:is_locked;not null"`          // 锁定
 	UnlockCoins int32     `gorm:"column:unlock_coins;not null"`       // 解锁金币
 	Content     string    `gorm:"column:content;type:text;not null"`  // 内容
@@ -54,6 +68,10 @@ type MessageDO struct {
 
 func (MessageDO) TableName() string { return "messages" }
 
+func (m *MessageDO) toChatMsg() *biz.ChatMsg {
+	return &biz.ChatMsg{ID: m.ID, UserID: m.UserID, PetID: m.PetID, Sender: m.Sender, MessageType: m.MessageType, IsLocked: m.IsLocked, UnlockCoins: m.UnlockCoins, Content: m.Content, CreatedAt: m.CreatedAt}
+}
+
 // AvatarRepo 使用 GORM 的数据实现
 type AvatarRepo struct{ data *Data }
 
@@ -69,18 +87,22 @@ func (r *AvatarRepo) ListPetModels(ctx context.Context) ([]*biz.PetModel, error)
 		return nil, tx.Error
 	}
 	out := make([]*biz.PetModel, 0, len(rows))
-	for _, v := range rows {
-		vv := v
-		out = append(out, &biz.PetModel{ID: vv.ID, Name: vv.Name, Path: vv.Path, ModelType: vv.Type, IsDefault: vv.IsDefault, SortOrder: vv.SortOrder, CreatedAt: vv.CreatedAt})
+	for i := range rows {
+		out = append(out, rows[i].toBiz())
 	}
 	return out, nil
 }
 
-// PetModelExists 判断模型是否存在
-func (r *AvatarRepo) PetModelExists(ctx context.Context, modelID int64) (bool, error) {
-	var count int64
-	tx := r.data.Gorm.WithContext(ctx).Model(&PetModelDO{}).Where("id=?", modelID).Count(&count)
-	return count > 0, tx.Error
+// GetPetModel 获取单个模型
+func (r *AvatarRepo) GetPetModel(ctx context.Context, modelID int64) (*biz.PetModel, error) {
+	var row PetModelDO
+	if err := r.data.Gorm.WithContext(ctx).First(&row, modelID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrAvatarNotFound
+		}
+		return nil, err
+	}
+	return row.toBiz(), nil
 }
 
 // GetModelPath 获取模型路径
@@ -95,108 +117,74 @@ func (r *AvatarRepo) GetModelPath(ctx context.Context, modelID int64) (string, e
 	return row.Path, nil
 }
 
-// SetUserModel 更新用户当前模型（同时刷新 model_url）
-func (r *AvatarRepo) SetUserModel(ctx context.Context, userID, modelID int64) error {
+// SetPetModel 更新宠物模型（同时刷新 model_url）
+func (r *AvatarRepo) SetPetModel(ctx context.Context, petID, modelID int64) error {
 	path, err := r.GetModelPath(ctx, modelID)
 	if err != nil {
 		return err
 	}
 	tx := r.data.Gorm.WithContext(ctx).
-		Exec("UPDATE users SET model_id=?, model_url=?, updated_at=NOW() WHERE id=?", modelID, path, userID)
+		Exec("UPDATE pets SET model_id=?, model_url=?, updated_at=NOW() WHERE id=?", modelID, path, petID)
 	return tx.Error
 }
 
 // ListItems 获取道具列表
 func (r *AvatarRepo) ListItems(ctx context.Context) ([]*biz.Item, error) {
 	var rows []ItemDO
-	if err := r.data.Gorm.WithContext(ctx).Order("id asc").Find(&rows).Error; err != nil {
+	if err := r.data.Gorm.WithContext(ctx).Order("sort_order asc, id asc").Find(&rows).Error; err != nil {
 		return nil, err
 	}
 	out := make([]*biz.Item, 0, len(rows))
-	for _, v := range rows {
-		vv := v
-		out = append(out, &biz.Item{ID: vv.ID, Name: vv.Name, Description: vv.Description, IconPath: vv.IconPath, CoinCost: vv.CoinCost, CreatedAt: vv.CreatedAt})
+	for i := range rows {
+		out = append(out, rows[i].toBiz())
 	}
 	return out, nil
 }
 
-// UseItem 扣金币并返回剩余金币（事务）
-func (r *AvatarRepo) UseItem(ctx context.Context, userID, itemID int64) (int32, error) {
-	var remaining int32
-	err := r.data.Gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 读取道具
-		var it ItemDO
-		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&it, itemID).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return biz.ErrPropNotFound
-			}
-			return err
-		}
-		// 读取用户金币并加锁
-		type userRow struct{ Coins int32 }
-		var ur userRow
-		if err := tx.Table("users").Clauses(clause.Locking{Strength: "UPDATE"}).Select("coins").Where("id=?", userID).Take(&ur).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return biz.ErrUserNotFound
-			}
-			return err
-		}
-		if ur.Coins < it.CoinCost {
-			return biz.ErrInsufficientCoins
-		}
-		remaining = ur.Coins - it.CoinCost
-		if err := tx.Exec("UPDATE users SET coins=?, updated_at=NOW() WHERE id=?", remaining, userID).Error; err != nil {
-			return err
+// GetItem 获取单个道具
+func (r *AvatarRepo) GetItem(ctx context.Context, itemID int64) (*biz.Item, error) {
+	var it ItemDO
+	if err := r.data.db(ctx).First(&it, itemID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrPropNotFound
 		}
-		return nil
-	})
-	return remaining, err
+		return nil, err
+	}
+	return it.toBiz(), nil
 }
 
 // CreateChat 写入一条用户消息
-func (r *AvatarRepo) CreateChat(ctx context.Context, userID int64, content string) (*biz.ChatMsg, error) {
+func (r *AvatarRepo) CreateChat(ctx context.Context, userID, petID int64, content string) (*biz.ChatMsg, error) {
 	if r.data.Gorm == nil {
 		return nil, nil
 	}
-	row := &MessageDO{UserID: userID, Sender: 0, MessageType: 0, IsLocked: false, UnlockCoins: 0, Content: content}
+	row := &MessageDO{UserID: userID, PetID: petID, Sender: 0, MessageType: 0, IsLocked: false, UnlockCoins: 0, Content: content}
 	if err := r.data.Gorm.WithContext(ctx).Create(row).Error; err != nil {
 		return nil, err
 	}
-	return &biz.ChatMsg{
-		ID:          row.ID,
-		UserID:      row.UserID,
-		Sender:      row.Sender,
-		MessageType: row.MessageType,
-		IsLocked:    row.IsLocked,
-		UnlockCoins: row.UnlockCoins,
-		Content:     row.Content,
-		CreatedAt:   row.CreatedAt,
-	}, nil
+	return row.toChatMsg(), nil
 }
 
-// CreateAIChat 调用 AI 并将回复落库为一条来自 AI 的消息
-func (r *AvatarRepo) CreateAIChat(ctx context.Context, userID int64, content string) (*biz.ChatMsg, error) {
+// CreateAIChat 以 system 为系统提示、带上最近几轮聊天调用 AI，并将回复落库为一条来自 AI 的消息
+func (r *AvatarRepo) CreateAIChat(ctx context.Context, userID int64, pet *biz.Pet, system, content string) (*biz.ChatMsg, error) {
 	if r.data.Gorm == nil {
 		return nil, nil
 	}
-	// 读取用户/宠物关键信息作为 system prompt 的一部分（可选，不阻塞）
-	var profile struct{ Nickname, PetName, Kind string }
-	_ = r.data.Gorm.WithContext(ctx).Table("users").
-		Select("nickname,pet_name,kind").Where("id=?", userID).Scan(&profile).Error
-
-	system := "你是一个治愈系的宠物数字伙伴，以第一人称‘我’的口吻，温柔简短地回复。"
-	if profile.PetName != "" {
-		system += " 我的名字是" + profile.PetName + "。"
-	}
-	if profile.Kind != "" {
-		system += " 我是一只" + profile.Kind + "。"
+	var petID int64
+	if pet != nil {
+		petID = pet.ID
 	}
 	c := aiclient.Default()
 	if c == nil {
 		aiclient.SetClient(aiclient.NewClient(aiclient.Config{}))
 		c = aiclient.Default()
 	}
-	reply, err := c.Chat(ctx, system, content)
+	// 最近几轮聊天（已包含刚写入的用户消息）一并带给模型，让宠物记得前面聊过什么
+	history, err := r.ListChatHistory(ctx, userID, petID, biz.ChatHistoryTurns*2)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := c.Chat(ctx, biz.ChatMessages(system, biz.TrimChatHistory(history, biz.ChatHistoryTokenBudget), content))
 	if err != nil {
 		// AI调用失败时的兜底回复
 		reply = util.GetFallbackReply(content)
@@ -209,54 +197,56 @@ func (r *AvatarRepo) CreateAIChat(ctx context.Context, userID int64, content str
 	dbCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	row := &MessageDO{UserID: userID, Sender: 1, MessageType: 0, IsLocked: false, UnlockCoins: 0, Content: reply}
+	row := &MessageDO{UserID: userID, PetID: petID, Sender: 1, MessageType: 0, IsLocked: false, UnlockCoins: 0, Content: reply}
 	if err := r.data.Gorm.WithContext(dbCtx).Create(row).Error; err != nil {
 		return nil, err
 	}
-	return &biz.ChatMsg{
-		ID:          row.ID,
-		UserID:      row.UserID,
-		Sender:      row.Sender,
-		MessageType: row.MessageType,
-		IsLocked:    row.IsLocked,
-		UnlockCoins: row.UnlockCoins,
-		Content:     row.Content,
-		CreatedAt:   row.CreatedAt,
-	}, nil
+	return row.toChatMsg(), nil
 }
 
 // CreateAIMessage 直接写入一条来自 AI 的消息
-func (r *AvatarRepo) CreateAIMessage(ctx context.Context, userID int64, content string) (*biz.ChatMsg, error) {
+func (r *AvatarRepo) CreateAIMessage(ctx context.Context, userID, petID int64, content string) (*biz.ChatMsg, error) {
 	if r.data.Gorm == nil {
 		return nil, nil
 	}
-	row := &MessageDO{UserID: userID, Sender: 1, MessageType: 0, IsLocked: false, UnlockCoins: 0, Content: content}
+	row := &MessageDO{UserID: userID, PetID: petID, Sender: 1, MessageType: 0, IsLocked: false, UnlockCoins: 0, Content: content}
 	if err := r.data.Gorm.WithContext(ctx).Create(row).Error; err != nil {
 		return nil, err
 	}
-	return &biz.ChatMsg{ID: row.ID, UserID: row.UserID, Sender: row.Sender, MessageType: row.MessageType, IsLocked: row.IsLocked, UnlockCoins: row.UnlockCoins, Content: row.Content, CreatedAt: row.CreatedAt}, nil
+	return row.toChatMsg(), nil
 }
 
-// GetLatestAIMessage 获取最新的AI消息
-func (r *AvatarRepo) GetLatestAIMessage(ctx context.Context, userID int64) (*biz.ChatMsg, error) {
+// GetLatestAIMessage 获取与某只宠物聊天中最新的AI消息
+func (r *AvatarRepo) GetLatestAIMessage(ctx context.Context, userID, petID int64) (*biz.ChatMsg, error) {
 	if r.data.Gorm == nil {
 		return nil, nil
 	}
 	var row MessageDO
 	if err := r.data.Gorm.WithContext(ctx).
-		Where("user_id=? AND sender=1 AND message_type=0", userID).
+		Where("user_id=? AND pet_id=? AND sender=1 AND message_type=0", userID, petID).
 		Order("created_at DESC").
 		First(&row).Error; err != nil {
 		return nil, err
 	}
-	return &biz.ChatMsg{
-		ID:          row.ID,
-		UserID:      row.UserID,
-		Sender:      row.Sender,
-		MessageType: row.MessageType,
-		IsLocked:    row.IsLocked,
-		UnlockCoins: row.UnlockCoins,
-		Content:     row.Content,
-		CreatedAt:   row.CreatedAt,
-	}, nil
+	return row.toChatMsg(), nil
+}
+
+// ListChatHistory 与某只宠物最近的聊天消息（按时间倒序）
+func (r *AvatarRepo) ListChatHistory(ctx context.Context, userID, petID int64, limit int) ([]*biz.ChatMsg, error) {
+	if r.data.Gorm == nil {
+		return nil, nil
+	}
+	var rows []MessageDO
+	if err := r.data.Gorm.WithContext(ctx).
+		Where("user_id=? AND pet_id=? AND sender IN (0,1) AND message_type=0", userID, petID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.ChatMsg, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toChatMsg())
+	}
+	return out, nil
 }
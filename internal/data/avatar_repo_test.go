@@ -0,0 +1,93 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	aiclient "pet-angel/internal/ai"
+	"pet-angel/internal/biz"
+)
+
+// fakeAI 记录收到的对话并返回固定回复
+type fakeAI struct{ got []aiclient.Message }
+
+func (f *fakeAI) Chat(ctx context.Context, msgs []aiclient.Message) (string, error) {
+	f.got = msgs
+	return "喵~", nil
+}
+
+func (f *fakeAI) Stream(ctx context.Context, msgs []aiclient.Message, onDelta func(string) error) (string, error) {
+	f.got = msgs
+	return "喵~", onDelta("喵~")
+}
+
+// useAI 临时替换默认 AI 客户端，返回恢复函数
+func useAI(c aiclient.Client) func() {
+	prev := aiclient.Default()
+	aiclient.SetClient(c)
+	return func() { aiclient.SetClient(prev) }
+}
+
+func TestChatHistory(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	repo := NewAvatarRepo(d)
+	ai := &fakeAI{}
+	restore := useAI(ai)
+	defer restore()
+
+	pet := &biz.Pet{ID: 7, UserID: 1, Name: "咪咪"}
+	// 其他宠物的聊天、小纸条、提醒不进入上下文
+	d.Gorm.Create(&MessageDO{UserID: 1, PetID: 8, Sender: 0, Content: "other pet"})
+	d.Gorm.Create(&MessageDO{UserID: 1, PetID: 7, Sender: 1, MessageType: biz.MessageTypeReminder, Content: "reminder"})
+	d.Gorm.Create(&MessageDO{UserID: 1, PetID: 0, Sender: 1, MessageType: 1, Content: "note"})
+
+	for _, say := range []string{"我叫小明", "我叫什么？"} {
+		if _, err := repo.CreateChat(ctx, 1, pet.ID, say); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := repo.CreateAIChat(ctx, 1, pet, "你是咪咪", say); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// system + 我叫小明 + 喵~ + 我叫什么？（本轮输入不重复）
+	roles := make([]string, 0, len(ai.got))
+	for _, m := range ai.got {
+		roles = append(roles, m.Role)
+	}
+	if strings.Join(roles, ",") != "system,user,assistant,user" || ai.got[0].Content != "你是咪咪" || ai.got[1].Content != "我叫小明" || ai.got[3].Content != "我叫什么？" {
+		t.Fatalf("messages: %+v", ai.got)
+	}
+
+	// 只取最近的 limit 条，按时间倒序
+	list, err := repo.ListChatHistory(ctx, 1, pet.ID, 3)
+	if err != nil || len(list) != 3 || list[0].Content != "喵~" || list[2].Content != "喵~" {
+		t.Fatalf("list: %+v %v", list, err)
+	}
+
+	// 超出预算时丢弃最早的消息，最新一条总是保留
+	long := strings.Repeat("长", 100)
+	list = []*biz.ChatMsg{{Content: "b"}, {Content: "a"}, {Content: long}}
+	if got := biz.TrimChatHistory(list, 10); len(got) != 2 || got[0].Content != "a" || got[1].Content != "b" {
+		t.Fatalf("trim: %+v", got)
+	}
+	if got := biz.TrimChatHistory(list[2:], 10); len(got) != 1 {
+		t.Fatalf("trim keeps latest: %+v", got)
+	}
+	if n := biz.EstimateTokens("hello world!"); n != 7 {
+		t.Fatalf("estimate ascii: %d", n)
+	}
+	if n := biz.EstimateTokens(long); n != 104 {
+		t.Fatalf("estimate cjk: %d", n)
+	}
+
+	// 同步与流式共用：历史已含本轮输入时不重复；历史为空（如读取失败）时补上本轮输入
+	hist := []*biz.ChatMsg{{Sender: 0, Content: "hi"}, {Sender: 1, Content: "喵~"}, {Sender: 0, Content: "在吗"}}
+	if msgs := biz.ChatMessages("sys", hist, "在吗"); len(msgs) != 4 || msgs[2].Role != aiclient.RoleAssistant || msgs[3].Content != "在吗" {
+		t.Fatalf("chat messages: %+v", msgs)
+	}
+	if msgs := biz.ChatMessages("sys", nil, "在吗"); len(msgs) != 2 || msgs[1].Role != aiclient.RoleUser {
+		t.Fatalf("chat messages without history: %+v", msgs)
+	}
+}
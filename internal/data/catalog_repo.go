@@ -0,0 +1,240 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CatalogVersionDO 映射 catalog_versions 表
+type CatalogVersionDO struct {
+	Catalog   string    `gorm:"column:catalog;primaryKey"` // 目录名
+	Version   int64     `gorm:"column:version;not null"`   // 版本号
+	UpdatedAt time.Time `gorm:"column:updated_at"`         // 更新时间
+}
+
+func (CatalogVersionDO) TableName() string { return "catalog_versions" }
+
+// catalogTables 目录名 -> 表模型（用于通用的重排）
+var catalogTables = map[string]interface{}{
+	biz.CatalogPetModels:  &PetModelDO{},
+	biz.CatalogItems:      &ItemDO{},
+	biz.CatalogCategories: &CategoryModel{},
+}
+
+// CatalogRepo 实现 biz.CatalogRepo（仅 GORM）
+type CatalogRepo struct{ data *Data }
+
+func NewCatalogRepo(d *Data) *CatalogRepo { return &CatalogRepo{data: d} }
+
+// Version 查询目录版本号（从未修改过为 0）
+func (r *CatalogRepo) Version(ctx context.Context, catalog string) (int64, error) {
+	if r.data.Gorm == nil {
+		return 0, nil
+	}
+	var row CatalogVersionDO
+	err := r.data.Gorm.WithContext(ctx).Where("catalog=?", catalog).Take(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	return row.Version, err
+}
+
+// write 在事务中执行目录修改并将版本号 +1
+// 版本号在事务开始时先行自增：同一目录的并发写由该行锁串行化（保证“每类型唯一默认模型”等不变量）
+func (r *CatalogRepo) write(ctx context.Context, catalog string, fn func(tx *gorm.DB) error) (int64, error) {
+	if r.data.Gorm == nil {
+		return 0, biz.ErrDatabaseError
+	}
+	var version int64
+	err := r.data.Gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "catalog"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"version":    gorm.Expr("version+1"),
+				"updated_at": now,
+			}),
+		}).Create(&CatalogVersionDO{Catalog: catalog, Version: 1, UpdatedAt: now}).Error; err != nil {
+			return err
+		}
+		if err := fn(tx); err != nil {
+			return err
+		}
+		var row CatalogVersionDO
+		if err := tx.Where("catalog=?", catalog).Take(&row).Error; err != nil {
+			return err
+		}
+		version = row.Version
+		return nil
+	})
+	return version, err
+}
+
+// mustExist 记录不存在时返回 ErrCatalogNotFound
+func mustExist(tx *gorm.DB, model interface{}, id int64) error {
+	var n int64
+	if err := tx.Model(model).Where("id=?", id).Count(&n).Error; err != nil {
+		return err
+	}
+	if n == 0 {
+		return biz.ErrCatalogNotFound
+	}
+	return nil
+}
+
+// notReferenced 仍有引用时返回 ErrCatalogInUse
+func notReferenced(tx *gorm.DB, table, column string, id int64) error {
+	var n int64
+	if err := tx.Table(table).Where(column+"=?", id).Count(&n).Error; err != nil {
+		return err
+	}
+	if n > 0 {
+		return biz.ErrCatalogInUse
+	}
+	return nil
+}
+
+// SavePetModel 新增/修改宠物模型；设为默认时取消同类型其它默认；路径变化时同步 pets.model_url
+func (r *CatalogRepo) SavePetModel(ctx context.Context, m *biz.PetModel) (int64, error) {
+	return r.write(ctx, biz.CatalogPetModels, func(tx *gorm.DB) error {
+		row := &PetModelDO{ID: m.ID, Name: m.Name, Path: m.Path, Type: m.ModelType, IsDefault: m.IsDefault, SortOrder: m.SortOrder, UnlockLevel: m.UnlockLevel, UnlockCoins: m.UnlockCoins}
+		if m.IsDefault {
+			if err := tx.Model(&PetModelDO{}).
+				Where("type=? AND is_default=? AND id<>?", m.ModelType, true, m.ID).
+				Update("is_default", false).Error; err != nil {
+				return err
+			}
+		}
+		if m.ID == 0 {
+			if err := tx.Create(row).Error; err != nil {
+				return err
+			}
+			m.ID, m.CreatedAt = row.ID, row.CreatedAt
+			return nil
+		}
+		var old PetModelDO
+		if err := tx.Select("path").Take(&old, m.ID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return biz.ErrCatalogNotFound
+			}
+			return err
+		}
+		if err := tx.Model(row).Select("name", "path", "type", "is_default", "sort_order", "unlock_level", "unlock_coins").Updates(row).Error; err != nil {
+			return err
+		}
+		if old.Path != m.Path {
+			return tx.Table("pets").Where("model_id=?", m.ID).Update("model_url", m.Path).Error
+		}
+		return nil
+	})
+}
+
+// DeletePetModel 删除宠物模型（仍被用户选用或已被用户花金币解锁时拒绝）
+func (r *CatalogRepo) DeletePetModel(ctx context.Context, id int64) (int64, error) {
+	return r.write(ctx, biz.CatalogPetModels, func(tx *gorm.DB) error {
+		if err := mustExist(tx, &PetModelDO{}, id); err != nil {
+			return err
+		}
+		if err := notReferenced(tx, "pets", "model_id", id); err != nil {
+			return err
+		}
+		if err := notReferenced(tx, "user_pet_models", "model_id", id); err != nil {
+			return err
+		}
+		return tx.Delete(&PetModelDO{}, id).Error
+	})
+}
+
+// SaveItem 新增/修改道具
+func (r *CatalogRepo) SaveItem(ctx context.Context, it *biz.Item) (int64, error) {
+	return r.write(ctx, biz.CatalogItems, func(tx *gorm.DB) error {
+		row := &ItemDO{ID: it.ID, Name: it.Name, Description: it.Description, IconPath: it.IconPath, CoinCost: it.CoinCost, SortOrder: it.SortOrder, Effects: biz.FormatItemEffects(it.Effects)}
+		if it.ID == 0 {
+			if err := tx.Create(row).Error; err != nil {
+				return err
+			}
+			it.ID, it.CreatedAt = row.ID, row.CreatedAt
+			return nil
+		}
+		if err := mustExist(tx, &ItemDO{}, it.ID); err != nil {
+			return err
+		}
+		return tx.Model(row).Select("name", "description", "icon_path", "coin_cost", "sort_order", "effects").Updates(row).Error
+	})
+}
+
+// DeleteItem 删除道具（仍有用户背包持有时拒绝；数量已用尽的背包记录一并删除）
+func (r *CatalogRepo) DeleteItem(ctx context.Context, id int64) (int64, error) {
+	return r.write(ctx, biz.CatalogItems, func(tx *gorm.DB) error {
+		if err := mustExist(tx, &ItemDO{}, id); err != nil {
+			return err
+		}
+		if err := notReferenced(tx.Where("quantity>0"), "user_items", "item_id", id); err != nil {
+			return err
+		}
+		if err := tx.Where("item_id=?", id).Delete(&UserItemDO{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&ItemDO{}, id).Error
+	})
+}
+
+// SaveCategory 新增/修改帖子分类
+func (r *CatalogRepo) SaveCategory(ctx context.Context, c *biz.Category) (int64, error) {
+	return r.write(ctx, biz.CatalogCategories, func(tx *gorm.DB) error {
+		row := &CategoryModel{ID: c.ID, Name: c.Name, SortOrder: c.SortOrder}
+		if c.ID == 0 {
+			if err := tx.Create(row).Error; err != nil {
+				return err
+			}
+			c.ID = row.ID
+			return nil
+		}
+		if err := mustExist(tx, &CategoryModel{}, c.ID); err != nil {
+			return err
+		}
+		return tx.Model(row).Select("name", "sort_order").Updates(row).Error
+	})
+}
+
+// DeleteCategory 删除帖子分类（仍有帖子时拒绝）
+func (r *CatalogRepo) DeleteCategory(ctx context.Context, id int64) (int64, error) {
+	return r.write(ctx, biz.CatalogCategories, func(tx *gorm.DB) error {
+		if err := mustExist(tx, &CategoryModel{}, id); err != nil {
+			return err
+		}
+		if err := notReferenced(tx, "posts", "category_id", id); err != nil {
+			return err
+		}
+		return tx.Delete(&CategoryModel{}, id).Error
+	})
+}
+
+// Reorder 按 ids 顺序将 sort_order 设为 1..n
+func (r *CatalogRepo) Reorder(ctx context.Context, catalog string, ids []int64) (int64, error) {
+	model, ok := catalogTables[catalog]
+	if !ok {
+		return 0, biz.ErrInvalidCatalog("unknown catalog %q", catalog)
+	}
+	return r.write(ctx, catalog, func(tx *gorm.DB) error {
+		var n int64
+		if err := tx.Model(model).Where("id IN ?", ids).Count(&n).Error; err != nil {
+			return err
+		}
+		if n != int64(len(ids)) {
+			return biz.ErrCatalogNotFound
+		}
+		for i, id := range ids {
+			if err := tx.Model(model).Where("id=?", id).Update("sort_order", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,175 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupCatalogGorm(t *testing.T) *gorm.DB {
+	t.Helper()
+	gdb, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := gdb.Exec(`
+CREATE TABLE pet_models (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, path TEXT NOT NULL, type INTEGER NOT NULL, is_default INTEGER DEFAULT 0, sort_order INTEGER DEFAULT 0, unlock_level INTEGER NOT NULL DEFAULT 0, unlock_coins INTEGER NOT NULL DEFAULT 0, created_at DATETIME);
+CREATE TABLE items (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, description TEXT, icon_path TEXT, coin_cost INTEGER DEFAULT 0, sort_order INTEGER NOT NULL DEFAULT 0, effects TEXT NOT NULL DEFAULT '', created_at DATETIME);
+CREATE TABLE categories (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, sort_order INTEGER DEFAULT 0, created_at DATETIME);
+CREATE TABLE catalog_versions (catalog TEXT PRIMARY KEY, version INTEGER NOT NULL DEFAULT 0, updated_at DATETIME);
+CREATE TABLE pets (id INTEGER PRIMARY KEY, user_id INTEGER NOT NULL DEFAULT 0, model_id INTEGER NOT NULL DEFAULT 0, model_url TEXT);
+CREATE TABLE posts (id INTEGER PRIMARY KEY, category_id INTEGER NOT NULL);
+CREATE TABLE user_pet_models (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, model_id INTEGER NOT NULL, coins INTEGER NOT NULL DEFAULT 0, created_at DATETIME);
+CREATE TABLE user_items (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL, item_id INTEGER NOT NULL, quantity INTEGER NOT NULL DEFAULT 0, created_at DATETIME, updated_at DATETIME);
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	return gdb
+}
+
+func TestCatalogPetModels(t *testing.T) {
+	ctx := context.Background()
+	gdb := setupCatalogGorm(t)
+	d := &Data{Gorm: gdb}
+	uc := biz.NewCatalogUsecase(NewCatalogRepo(d))
+
+	a := &biz.PetModel{Name: "猫A", Path: "/models/a.glb", ModelType: 0, IsDefault: true, SortOrder: 1}
+	if v, err := uc.SavePetModel(ctx, a); err != nil || v != 1 {
+		t.Fatalf("create a: v=%d err=%v", v, err)
+	}
+	b := &biz.PetModel{Name: "猫B", Path: "/models/b.glb", ModelType: 0, IsDefault: true, SortOrder: 2}
+	if v, err := uc.SavePetModel(ctx, b); err != nil || v != 2 {
+		t.Fatalf("create b: v=%d err=%v", v, err)
+	}
+	dog := &biz.PetModel{Name: "狗", Path: "/models/dog.glb", ModelType: 1, IsDefault: true}
+	if _, err := uc.SavePetModel(ctx, dog); err != nil {
+		t.Fatal(err)
+	}
+	// 每种类型只保留一个默认模型
+	var defaults []PetModelDO
+	gdb.Where("is_default=?", true).Order("id").Find(&defaults)
+	if len(defaults) != 2 || defaults[0].ID != b.ID || defaults[1].ID != dog.ID {
+		t.Fatalf("want defaults [b dog], got %+v", defaults)
+	}
+
+	// 字段校验
+	for _, bad := range []*biz.PetModel{
+		{Name: "", Path: "/x"},
+		{Name: "x", Path: "/x", ModelType: 2},
+		{Name: "x", Path: "/x", SortOrder: -1},
+		{Name: "x", Path: "/x", UnlockLevel: biz.MaxPetLevel + 1},
+		{Name: "x", Path: "/x", UnlockCoins: -1},
+		{Name: "x", Path: "/x", IsDefault: true, UnlockLevel: 5},
+	} {
+		if _, err := uc.SavePetModel(ctx, bad); errors.Reason(err) != "INVALID_CATALOG" {
+			t.Fatalf("want INVALID_CATALOG for %+v, got %v", bad, err)
+		}
+	}
+
+	// 修改路径同步到已选用该模型的宠物；被选用的模型不可删除
+	gdb.Exec(`INSERT INTO pets(id, user_id, model_id, model_url) VALUES (1, 1, ?, '/models/a.glb')`, a.ID)
+	a.Path = "/models/a2.glb"
+	if _, err := uc.SavePetModel(ctx, a); err != nil {
+		t.Fatal(err)
+	}
+	var url string
+	gdb.Raw(`SELECT model_url FROM pets WHERE id=1`).Scan(&url)
+	if url != "/models/a2.glb" {
+		t.Fatalf("pet model_url not synced: %q", url)
+	}
+	if _, err := uc.DeletePetModel(ctx, a.ID); !errors.Is(err, biz.ErrCatalogInUse) {
+		t.Fatalf("want in use, got %v", err)
+	}
+	if _, err := uc.SavePetModel(ctx, &biz.PetModel{ID: 999, Name: "x", Path: "/x"}); !errors.Is(err, biz.ErrCatalogNotFound) {
+		t.Fatalf("update missing: want not found, got %v", err)
+	}
+
+	// 重排：ID 须全部存在且不重复；失败不改变版本号
+	before, _ := uc.Version(ctx, biz.CatalogPetModels)
+	if _, err := uc.Reorder(ctx, biz.CatalogPetModels, []int64{dog.ID, 999}); !errors.Is(err, biz.ErrCatalogNotFound) {
+		t.Fatalf("reorder missing: want not found, got %v", err)
+	}
+	if _, err := uc.Reorder(ctx, biz.CatalogPetModels, []int64{dog.ID, dog.ID}); errors.Reason(err) != "INVALID_CATALOG" {
+		t.Fatalf("reorder duplicate: want invalid, got %v", err)
+	}
+	if after, _ := uc.Version(ctx, biz.CatalogPetModels); after != before {
+		t.Fatalf("failed writes must not bump version: %d -> %d", before, after)
+	}
+	v, err := uc.Reorder(ctx, biz.CatalogPetModels, []int64{b.ID, a.ID, dog.ID})
+	if err != nil || v != before+1 {
+		t.Fatalf("reorder: v=%d err=%v", v, err)
+	}
+	var rows []PetModelDO
+	gdb.Order("sort_order").Find(&rows)
+	if rows[0].ID != b.ID || rows[1].ID != a.ID || rows[2].SortOrder != 3 {
+		t.Fatalf("unexpected order: %+v", rows)
+	}
+	// 已被用户付费解锁的模型即使无人选用也不能删除
+	gdb.Exec(`INSERT INTO user_pet_models(user_id, model_id, coins) VALUES (1, ?, 50)`, b.ID)
+	if _, err := uc.DeletePetModel(ctx, b.ID); !errors.Is(err, biz.ErrCatalogInUse) {
+		t.Fatalf("unlocked model: want in use, got %v", err)
+	}
+	gdb.Exec(`DELETE FROM user_pet_models`)
+	if _, err := uc.DeletePetModel(ctx, b.ID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCatalogItemsAndCategories(t *testing.T) {
+	ctx := context.Background()
+	gdb := setupCatalogGorm(t)
+	uc := biz.NewCatalogUsecase(NewCatalogRepo(&Data{Gorm: gdb}))
+
+	it := &biz.Item{Name: "猫粮", CoinCost: 20, SortOrder: 5, Effects: []biz.ItemEffect{{Stat: biz.PetStatHunger, Delta: 30}, {Stat: biz.PetStatEnergy, Delta: -5}}}
+	if _, err := uc.SaveItem(ctx, &biz.Item{Name: "x", Effects: []biz.ItemEffect{{Stat: "weight", Delta: 1}}}); errors.Reason(err) != "INVALID_ITEM_EFFECTS" {
+		t.Fatalf("unknown stat: got %v", err)
+	}
+	if _, err := uc.SaveItem(ctx, it); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.SaveItem(ctx, &biz.Item{Name: "x", CoinCost: -1}); errors.Reason(err) != "INVALID_CATALOG" {
+		t.Fatalf("negative cost: got %v", err)
+	}
+	it.CoinCost = 0 // 零值也应写入
+	if _, err := uc.SaveItem(ctx, it); err != nil {
+		t.Fatal(err)
+	}
+	items, _ := NewAvatarRepo(&Data{Gorm: gdb}).ListItems(ctx)
+	if len(items) != 1 || items[0].CoinCost != 0 || items[0].SortOrder != 5 || biz.FormatItemEffects(items[0].Effects) != "hunger:+30,energy:-5" {
+		t.Fatalf("unexpected items: %+v", items[0])
+	}
+	// 仍在用户背包中的道具不能删除；用尽的背包记录随道具删除
+	gdb.Exec(`INSERT INTO user_items(user_id, item_id, quantity) VALUES (1, ?, 2), (2, ?, 0)`, it.ID, it.ID)
+	if _, err := uc.DeleteItem(ctx, it.ID); !errors.Is(err, biz.ErrCatalogInUse) {
+		t.Fatalf("want in use, got %v", err)
+	}
+	gdb.Exec(`UPDATE user_items SET quantity=0 WHERE user_id=1`)
+	if _, err := uc.DeleteItem(ctx, it.ID); err != nil {
+		t.Fatal(err)
+	}
+	var left int64
+	gdb.Table("user_items").Count(&left)
+	if left != 0 {
+		t.Fatalf("empty inventory rows should be removed, %d left", left)
+	}
+
+	c := &biz.Category{Name: "日常"}
+	if _, err := uc.SaveCategory(ctx, c); err != nil {
+		t.Fatal(err)
+	}
+	gdb.Exec(`INSERT INTO posts(id, category_id) VALUES (1, ?)`, c.ID)
+	if _, err := uc.DeleteCategory(ctx, c.ID); !errors.Is(err, biz.ErrCatalogInUse) {
+		t.Fatalf("want in use, got %v", err)
+	}
+	if v, _ := uc.Version(ctx, biz.CatalogCategories); v != 1 {
+		t.Fatalf("categories version: want 1, got %d", v)
+	}
+	if v, _ := uc.Version(ctx, biz.CatalogItems); v != 3 {
+		t.Fatalf("items version: want 3, got %d", v)
+	}
+}
@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// CheckInDO 映射 check_ins 表
+
+type CheckInDO struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64     `gorm:"column:user_id;not null"`
+	CheckDate string    `gorm:"column:check_date;not null"`
+	Streak    int32     `gorm:"column:streak;not null"`
+	Reward    int32     `gorm:"column:reward;not null"`
+	Timezone  string    `gorm:"column:timezone;not null"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (CheckInDO) TableName() string { return "check_ins" }
+
+func (c *CheckInDO) toBiz() *biz.CheckIn {
+	return &biz.CheckIn{ID: c.ID, UserID: c.UserID, Date: c.CheckDate, Streak: c.Streak, Reward: c.Reward, Timezone: c.Timezone, CreatedAt: c.CreatedAt}
+}
+
+// CheckInRepo 实现 biz.CheckInRepo（GORM；内存模式下保存在进程内）
+// 唯一键 (user_id, check_date) 保证并发签到只有一个请求写入成功
+
+type CheckInRepo struct {
+	data *Data
+
+	mu     sync.Mutex
+	mem    map[int64][]*CheckInDO // user_id -> 按日期升序
+	nextID int64
+}
+
+func NewCheckInRepo(d *Data) *CheckInRepo {
+	return &CheckInRepo{data: d, mem: map[int64][]*CheckInDO{}, nextID: 1}
+}
+
+func (r *CheckInRepo) Last(ctx context.Context, userID int64) (*biz.CheckIn, error) {
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		list := r.mem[userID]
+		if len(list) == 0 {
+			return nil, nil
+		}
+		return list[len(list)-1].toBiz(), nil
+	}
+	var row CheckInDO
+	if err := r.data.db(ctx).Where("user_id=?", userID).Order("check_date desc").Take(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return row.toBiz(), nil
+}
+
+func (r *CheckInRepo) Create(ctx context.Context, c *biz.CheckIn) error {
+	row := &CheckInDO{UserID: c.UserID, CheckDate: c.Date, Streak: c.Streak, Reward: c.Reward, Timezone: c.Timezone, CreatedAt: c.CreatedAt}
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		list := r.mem[c.UserID]
+		for _, v := range list {
+			if v.CheckDate == c.Date {
+				return biz.ErrCheckInExists
+			}
+		}
+		row.ID = r.nextID
+		r.nextID++
+		list = append(list, row)
+		for i := len(list) - 1; i > 0 && list[i].CheckDate < list[i-1].CheckDate; i-- {
+			list[i], list[i-1] = list[i-1], list[i]
+		}
+		r.mem[c.UserID] = list
+		c.ID = row.ID
+		return nil
+	}
+	if err := r.data.db(ctx).Create(row).Error; err != nil {
+		if isDuplicateKey(err) {
+			return biz.ErrCheckInExists
+		}
+		return err
+	}
+	c.ID = row.ID
+	return nil
+}
+
+func (r *CheckInRepo) List(ctx context.Context, userID int64, from, to string) ([]*biz.CheckIn, error) {
+	var rows []*CheckInDO
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		for _, v := range r.mem[userID] {
+			if v.CheckDate >= from && v.CheckDate <= to {
+				rows = append(rows, v)
+			}
+		}
+		r.mu.Unlock()
+	} else if err := r.data.db(ctx).Where("user_id=? AND check_date BETWEEN ? AND ?", userID, from, to).
+		Order("check_date").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.CheckIn, 0, len(rows))
+	for _, v := range rows {
+		out = append(out, v.toBiz())
+	}
+	return out, nil
+}
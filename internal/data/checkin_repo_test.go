@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestCheckIn(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	loc, _ := time.LoadLocation("Asia/Shanghai")
+	now := time.Now().In(loc)
+	today, yesterday := now.Format("2006-01-02"), now.AddDate(0, 0, -1).Format("2006-01-02")
+	if err := d.Gorm.Exec(`INSERT INTO users (id, username, coins) VALUES (1, 'alice', 0);
+INSERT INTO check_ins (user_id, check_date, streak, reward, timezone) VALUES (1, ?, 2, 10, 'Asia/Shanghai');`, yesterday).Error; err != nil {
+		t.Fatal(err)
+	}
+	wallet := biz.NewWalletUsecase(NewWalletRepo(d), d, log.DefaultLogger)
+	uc := biz.NewCheckInUsecase(NewCheckInRepo(d), wallet, d, &conf.Rewards{}, log.DefaultLogger)
+
+	if _, err := uc.CheckIn(ctx, 1, "Mars/Olympus"); !errors.Is(err, biz.ErrInvalidTimezone) {
+		t.Fatalf("want invalid timezone, got %v", err)
+	}
+	// 昨天签到过：连续第 3 天，奖励按默认表为 15
+	res, err := uc.CheckIn(ctx, 1, "Asia/Shanghai")
+	if err != nil || res.AlreadyCheckedIn || res.Date != today || res.Streak != 3 || res.Reward != 15 || res.Coins != 15 {
+		t.Fatalf("check in: %+v %v", res, err)
+	}
+	// 同一天重复签到不再发放奖励
+	again, err := uc.CheckIn(ctx, 1, "")
+	if err != nil || !again.AlreadyCheckedIn || again.ID != res.ID || again.Coins != 15 {
+		t.Fatalf("repeat check in: %+v %v", again, err)
+	}
+	// 切换到更晚的时区也无法回到已签到日期之前重复领取
+	if hop, err := uc.CheckIn(ctx, 1, "Etc/GMT+12"); err != nil || !hop.AlreadyCheckedIn || hop.Coins != 15 {
+		t.Fatalf("timezone hop: %+v %v", hop, err)
+	}
+	var ledger []CoinTransactionDO
+	if err := d.Gorm.Find(&ledger).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(ledger) != 1 || ledger[0].Reason != biz.CoinReasonReward || ledger[0].RefType != biz.CoinRefCheckIn || ledger[0].RefID != res.ID {
+		t.Fatalf("unexpected ledger: %+v", ledger)
+	}
+
+	st, err := uc.Status(ctx, 1, "Asia/Shanghai")
+	if err != nil || st.Today != today || st.Streak != 3 || !st.CheckedInToday || st.NextReward != 20 {
+		t.Fatalf("status: %+v %v", st, err)
+	}
+	daysInMonth := time.Date(now.Year(), now.Month()+1, 0, 0, 0, 0, 0, loc).Day()
+	if len(st.Calendar) != daysInMonth || st.Calendar[0].Date[8:] != "01" {
+		t.Fatalf("calendar should cover the current month: %d days", len(st.Calendar))
+	}
+	if d := st.Calendar[now.Day()-1]; !d.CheckedIn || d.Reward != 15 {
+		t.Fatalf("today in calendar: %+v", d)
+	}
+	// 从未签到：连续天数为 0，下一次奖励为第 1 天
+	if st, err := uc.Status(ctx, 2, ""); err != nil || st.Streak != 0 || st.CheckedInToday || st.NextReward != 5 {
+		t.Fatalf("empty status: %+v %v", st, err)
+	}
+}
+
+func TestCheckInConcurrent(t *testing.T) {
+	ctx := context.Background()
+	m := newMemoryAuth(t, &conf.Auth{JwtSecret: "s"})
+	u, _, err := m.uc.Register(ctx, "alice", "passw0rd", "", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallet := biz.NewWalletUsecase(NewWalletRepo(m.data), m.data, log.DefaultLogger)
+	cfg := &conf.Rewards{CheckIn: &conf.CheckIn{StreakRewards: []int32{7}, DefaultTimezone: "UTC"}}
+	uc := biz.NewCheckInUsecase(NewCheckInRepo(m.data), wallet, m.data, cfg, log.DefaultLogger)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	granted := 0
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := uc.CheckIn(ctx, u.Id, "")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if !res.AlreadyCheckedIn {
+				mu.Lock()
+				granted++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if coins, _ := wallet.Balance(ctx, u.Id); granted != 1 || coins != 7 {
+		t.Fatalf("want exactly one reward, granted=%d coins=%d", granted, coins)
+	}
+}
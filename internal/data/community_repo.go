@@ -13,8 +13,10 @@ import (
 // 表模型定义（仅含社区模块用到的字段）
 
 type CategoryModel struct {
-	ID   int64  `gorm:"column:id;primaryKey"` // 分类ID
-	Name string `gorm:"column:name"`          // 名称
+	ID        int64     `gorm:"column:id;primaryKey"`             // 分类ID
+	Name      string    `gorm:"column:name"`                      // 名称
+	SortOrder int32     `gorm:"column:sort_order"`                // 排序
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"` // 创建时间
 }
 
 func (CategoryModel) TableName() string { return "categories" }
@@ -102,7 +104,7 @@ func (r *CommunityRepoImpl) ListCategories(ctx context.Context) ([]*biz.Category
 	}
 	var rows []CategoryModel
 	if err := r.data.Gorm.WithContext(ctx).
-		Order("id ASC").
+		Order("sort_order ASC, id ASC").
 		Find(&rows).Error; err != nil {
 		return nil, err
 	}
@@ -110,8 +112,9 @@ func (r *CommunityRepoImpl) ListCategories(ctx context.Context) ([]*biz.Category
 	for _, c := range rows {
 		cc := c
 		out = append(out, &biz.Category{
-			ID:   cc.ID,
-			Name: cc.Name,
+			ID:        cc.ID,
+			Name:      cc.Name,
+			SortOrder: cc.SortOrder,
 		})
 	}
 	return out, nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"sync"
+	"time"
 
 	"pet-angel/internal/conf"
 
@@ -11,6 +12,7 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -19,11 +21,13 @@ import (
 // DB: 标准库 *sql.DB（部分场景可用）
 // Gorm: *gorm.DB 主连接
 // Minio: MinIO 对象存储客户端
+// Redis: 可选；未配置或连接失败时为 nil，依赖方回退到进程内实现
 
 type Data struct {
 	logger *log.Helper
 	DB     *sql.DB
 	Gorm   *gorm.DB
+	Redis  *redis.Client
 
 	Minio       *minio.Client
 	MinioBucket string
@@ -32,6 +36,9 @@ type Data struct {
 	userByID       map[int64]*UserDTO
 	userByUsername map[string]*UserDTO
 	nextUserID     int64
+	petByID        map[int64]*PetDO
+	nextPetID      int64
+	petStateByPet  map[int64]*PetStateDO
 	mu             sync.RWMutex
 }
 
@@ -60,9 +67,13 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		logger:         l,
 		DB:             db,
 		Gorm:           gdb,
+		Redis:          newRedis(c, l),
 		userByID:       make(map[int64]*UserDTO),
 		userByUsername: make(map[string]*UserDTO),
 		nextUserID:     1,
+		petByID:        make(map[int64]*PetDO),
+		nextPetID:      1,
+		petStateByPet:  make(map[int64]*PetStateDO),
 	}
 
 	cleanup := func() {
@@ -70,10 +81,60 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		if d.DB != nil {
 			_ = d.DB.Close()
 		}
+		if d.Redis != nil {
+			_ = d.Redis.Close()
+		}
 	}
 	return d, cleanup, nil
 }
 
+type contextTxKey struct{}
+
+// InTx 实现 biz.Transaction：在一个 GORM 事务中执行 fn，事务通过 ctx 传递给仓储（见 db）
+// ctx 中已有事务时直接复用，嵌套调用合并为同一事务；内存模式下直接执行 fn
+func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if d.Gorm == nil {
+		return fn(ctx)
+	}
+	if _, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return d.Gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, contextTxKey{}, tx))
+	})
+}
+
+// db 返回 ctx 中的事务，不在事务中时返回主连接
+func (d *Data) db(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return d.Gorm.WithContext(ctx)
+}
+
+// newRedis 按配置创建 Redis 客户端；未配置或无法连通时返回 nil（不阻断启动）
+func newRedis(c *conf.Data, l *log.Helper) *redis.Client {
+	if c == nil || c.Redis == nil || c.Redis.Addr == "" {
+		return nil
+	}
+	opts := &redis.Options{Network: c.Redis.Network, Addr: c.Redis.Addr}
+	if c.Redis.ReadTimeout != nil {
+		opts.ReadTimeout = c.Redis.ReadTimeout.AsDuration()
+	}
+	if c.Redis.WriteTimeout != nil {
+		opts.WriteTimeout = c.Redis.WriteTimeout.AsDuration()
+	}
+	rdb := redis.NewClient(opts)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		l.Warnf("redis %s unavailable, falling back to in-memory stores: %v", c.Redis.Addr, err)
+		_ = rdb.Close()
+		return nil
+	}
+	return rdb
+}
+
 // InitMinio 创建 MinIO 客户端并确保桶存在
 func (d *Data) InitMinio(ctx context.Context, mc *conf.Minio) error {
 	if mc == nil || mc.Endpoint == "" {
@@ -107,17 +168,34 @@ type UserDTO struct {
 	ID          int64
 	Username    string
 	Password    string
+	Phone       string
 	Nickname    string
 	Avatar      string
-	ModelID     int64
-	ModelURL    string
-	PetName     string
-	PetAvatar   string
-	PetSex      int32
-	Kind        string
-	Weight      int32
-	Hobby       string
+	ActivePetID int64
 	Description string
 	Coins       int32
+	Role        string
 	CreatedAt   int64 // unix seconds
 }
+
+// createPet 内存模式下写入宠物并分配 ID（调用方持有 mu 写锁）
+func (d *Data) createPet(p *PetDO) {
+	p.ID = d.nextPetID
+	d.nextPetID++
+	d.petByID[p.ID] = p
+}
+
+// deletePet 内存模式下删除宠物及其状态（调用方持有 mu 写锁）
+func (d *Data) deletePet(petID int64) {
+	delete(d.petByID, petID)
+	delete(d.petStateByPet, petID)
+}
+
+// activePet 内存模式下用户的当前宠物，无则为 nil（调用方持有 mu 读锁）
+func (d *Data) activePet(userID int64) *PetDO {
+	u, ok := d.userByID[userID]
+	if !ok {
+		return nil
+	}
+	return d.petByID[u.ActivePetID]
+}
@@ -0,0 +1,184 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// UserIdentityDO 映射 user_identities 表
+
+type UserIdentityDO struct {
+	ID          int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID      int64      `gorm:"column:user_id;not null"`
+	Provider    string     `gorm:"column:provider;not null"`
+	Subject     string     `gorm:"column:subject;not null"`
+	Nickname    string     `gorm:"column:nickname"`
+	Avatar      string     `gorm:"column:avatar"`
+	CreatedAt   time.Time  `gorm:"column:created_at"`
+	LastLoginAt *time.Time `gorm:"column:last_login_at"`
+}
+
+func (UserIdentityDO) TableName() string { return "user_identities" }
+
+func (i *UserIdentityDO) toBiz() *biz.UserIdentity {
+	out := &biz.UserIdentity{
+		ID:        i.ID,
+		UserID:    i.UserID,
+		Provider:  i.Provider,
+		Subject:   i.Subject,
+		Nickname:  i.Nickname,
+		Avatar:    i.Avatar,
+		CreatedAt: i.CreatedAt,
+	}
+	if i.LastLoginAt != nil {
+		out.LastLoginAt = *i.LastLoginAt
+	}
+	return out
+}
+
+// IdentityRepo 实现 biz.IdentityRepo（GORM，内存兜底）
+
+type IdentityRepo struct {
+	data *Data
+
+	mu     sync.Mutex
+	mem    map[int64]*UserIdentityDO
+	nextID int64
+}
+
+func NewIdentityRepo(d *Data) *IdentityRepo {
+	return &IdentityRepo{data: d, mem: make(map[int64]*UserIdentityDO), nextID: 1}
+}
+
+// Get 按渠道与外部ID查询绑定
+func (r *IdentityRepo) Get(ctx context.Context, provider, subject string) (*biz.UserIdentity, error) {
+	if r.data.Gorm != nil {
+		var row UserIdentityDO
+		if err := r.data.Gorm.WithContext(ctx).Where("provider=? AND subject=?", provider, subject).Take(&row).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, biz.ErrIdentityNotFound
+			}
+			return nil, err
+		}
+		return row.toBiz(), nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, v := range r.mem {
+		if v.Provider == provider && v.Subject == subject {
+			return v.toBiz(), nil
+		}
+	}
+	return nil, biz.ErrIdentityNotFound
+}
+
+// Create 写入绑定；唯一键（provider+subject、user_id+provider）兜底并发冲突
+func (r *IdentityRepo) Create(ctx context.Context, i *biz.UserIdentity) (int64, error) {
+	row := &UserIdentityDO{
+		UserID:    i.UserID,
+		Provider:  i.Provider,
+		Subject:   i.Subject,
+		Nickname:  i.Nickname,
+		Avatar:    i.Avatar,
+		CreatedAt: i.CreatedAt,
+	}
+	if !i.LastLoginAt.IsZero() {
+		t := i.LastLoginAt
+		row.LastLoginAt = &t
+	}
+	if r.data.Gorm != nil {
+		err := r.data.Gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := r.checkConflict(tx, i); err != nil {
+				return err
+			}
+			return tx.Create(row).Error
+		})
+		if isDuplicateKey(err) {
+			// 并发写入时唯一键冲突：重新判断是哪一种冲突
+			if cerr := r.checkConflict(r.data.Gorm.WithContext(ctx), i); cerr != nil {
+				return 0, cerr
+			}
+			return 0, biz.ErrIdentityLinked
+		}
+		if err != nil {
+			return 0, err
+		}
+		return row.ID, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, v := range r.mem {
+		if v.Provider == i.Provider && v.Subject == i.Subject {
+			return 0, biz.ErrIdentityLinked
+		}
+		if v.UserID == i.UserID && v.Provider == i.Provider {
+			return 0, biz.ErrProviderLinked
+		}
+	}
+	row.ID = r.nextID
+	r.nextID++
+	r.mem[row.ID] = row
+	return row.ID, nil
+}
+
+func (r *IdentityRepo) checkConflict(tx *gorm.DB, i *biz.UserIdentity) error {
+	var n int64
+	if err := tx.Model(&UserIdentityDO{}).Where("provider=? AND subject=?", i.Provider, i.Subject).Count(&n).Error; err != nil {
+		return err
+	}
+	if n > 0 {
+		return biz.ErrIdentityLinked
+	}
+	if err := tx.Model(&UserIdentityDO{}).Where("user_id=? AND provider=?", i.UserID, i.Provider).Count(&n).Error; err != nil {
+		return err
+	}
+	if n > 0 {
+		return biz.ErrProviderLinked
+	}
+	return nil
+}
+
+// ListByUser 用户绑定的外部账号（按绑定时间先后）
+func (r *IdentityRepo) ListByUser(ctx context.Context, userID int64) ([]*biz.UserIdentity, error) {
+	var rows []*UserIdentityDO
+	if r.data.Gorm != nil {
+		if err := r.data.Gorm.WithContext(ctx).Where("user_id=?", userID).Order("id").Find(&rows).Error; err != nil {
+			return nil, err
+		}
+	} else {
+		r.mu.Lock()
+		for _, v := range r.mem {
+			if v.UserID == userID {
+				cp := *v
+				rows = append(rows, &cp)
+			}
+		}
+		r.mu.Unlock()
+		sort.Slice(rows, func(a, b int) bool { return rows[a].ID < rows[b].ID })
+	}
+	out := make([]*biz.UserIdentity, 0, len(rows))
+	for _, v := range rows {
+		out = append(out, v.toBiz())
+	}
+	return out, nil
+}
+
+// TouchLogin 记录最近一次通过该渠道登录的时间
+func (r *IdentityRepo) TouchLogin(ctx context.Context, id int64, at time.Time) error {
+	if r.data.Gorm != nil {
+		return r.data.Gorm.WithContext(ctx).Model(&UserIdentityDO{}).Where("id=?", id).Update("last_login_at", at).Error
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if v, ok := r.mem[id]; ok {
+		v.LastLoginAt = &at
+	}
+	return nil
+}
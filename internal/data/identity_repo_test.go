@@ -0,0 +1,88 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+	"pet-angel/internal/oauth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestOAuthLoginAndLink(t *testing.T) {
+	ctx := context.Background()
+	c := &conf.Auth{JwtSecret: "s", OauthProviders: []*conf.OAuthProvider{{Name: "wechat", Driver: "fake"}}}
+	m := newMemoryAuth(t, c)
+	providers, err := oauth.NewProviders(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uc := biz.NewOAuthUsecase(m.uc, NewIdentityRepo(m.data), NewAccountRepo(m.data), providers, log.DefaultLogger)
+
+	// 首次登录自动注册并绑定
+	u, pair, created, err := uc.Login(ctx, "wechat", "wx-1:小白", "", biz.ClientInfo{})
+	if err != nil || !created || pair.AccessToken == "" || u.Nickname != "小白" {
+		t.Fatalf("first login: %+v %+v created=%v err=%v", u, pair, created, err)
+	}
+	if err := biz.ValidateUsername(u.Username); err != nil {
+		t.Fatalf("generated username %q: %v", u.Username, err)
+	}
+	// 再次登录命中同一用户
+	again, _, created, err := uc.Login(ctx, "wechat", "wx-1", "", biz.ClientInfo{})
+	if err != nil || created || again.Id != u.Id {
+		t.Fatalf("second login: %+v created=%v err=%v", again, created, err)
+	}
+	// 第三方注册的账号没有密码，不可用于密码登录
+	if _, _, err := m.uc.Login(ctx, u.Username, "", biz.ClientInfo{}); !errors.Is(err, biz.ErrInvalidCredentials) {
+		t.Fatalf("want invalid credentials, got %v", err)
+	}
+
+	for code, want := range map[string]error{"": biz.ErrOAuthExchange, "invalid-code": biz.ErrOAuthExchange} {
+		if _, _, _, err := uc.Login(ctx, "wechat", code, "", biz.ClientInfo{}); !errors.Is(err, want) {
+			t.Fatalf("code %q: want %v, got %v", code, want, err)
+		}
+	}
+	if _, _, _, err := uc.Login(ctx, "apple", "x", "", biz.ClientInfo{}); !errors.Is(err, biz.ErrUnknownOAuthProvider) {
+		t.Fatalf("want unknown provider, got %v", err)
+	}
+
+	// 已有账号绑定：外部账号被占用 / 同渠道只能绑定一个 / 重复绑定本人视为成功
+	alice, _, err := m.uc.Register(ctx, "alice", "passw0rd", "", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.Link(ctx, alice.Id, "wechat", "wx-1", ""); !errors.Is(err, biz.ErrIdentityLinked) {
+		t.Fatalf("want identity linked, got %v", err)
+	}
+	if err := uc.Link(ctx, alice.Id, "wechat", "wx-2", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.Link(ctx, alice.Id, "wechat", "wx-3", ""); !errors.Is(err, biz.ErrProviderLinked) {
+		t.Fatalf("want provider linked, got %v", err)
+	}
+	if err := uc.Link(ctx, alice.Id, "wechat", "wx-2", ""); err != nil {
+		t.Fatalf("relink own identity: %v", err)
+	}
+	if got, _, _, err := uc.Login(ctx, "wechat", "wx-2", "", biz.ClientInfo{}); err != nil || got.Id != alice.Id {
+		t.Fatalf("login linked alice: %+v %v", got, err)
+	}
+	list, err := uc.ListIdentities(ctx, alice.Id)
+	if err != nil || len(list) != 1 || list[0].Subject != "wx-2" || list[0].LastLoginAt.IsZero() {
+		t.Fatalf("list identities: %+v %v", list, err)
+	}
+}
+
+func TestNewOAuthProviders(t *testing.T) {
+	for _, bad := range [][]*conf.OAuthProvider{
+		{{Name: "WeChat", Driver: "fake"}},
+		{{Name: "wechat", Driver: "fake"}, {Name: "wechat", Driver: "fake"}},
+		{{Name: "wechat", Driver: "unknown"}},
+	} {
+		if _, err := oauth.NewProviders(&conf.Auth{OauthProviders: bad}); err == nil {
+			t.Fatalf("want error for %+v", bad)
+		}
+	}
+}
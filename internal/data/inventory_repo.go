@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserItemDO 映射 user_items 表（用户背包）
+type UserItemDO struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"` // 记录ID
+	UserID    int64     `gorm:"column:user_id;not null"`            // 用户ID
+	ItemID    int64     `gorm:"column:item_id;not null"`            // 道具ID
+	Quantity  int32     `gorm:"column:quantity;not null"`           // 持有数量
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`   // 首次获得时间
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`   // 最近变动时间
+}
+
+func (UserItemDO) TableName() string { return "user_items" }
+
+// ItemPurchaseDO 映射 item_purchases 表（购买记录）
+type ItemPurchaseDO struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"` // 购买记录ID
+	UserID    int64     `gorm:"column:user_id;not null"`            // 用户ID
+	ItemID    int64     `gorm:"column:item_id;not null"`            // 道具ID
+	Quantity  int32     `gorm:"column:quantity;not null"`           // 购买数量
+	UnitCost  int32     `gorm:"column:unit_cost;not null"`          // 单价
+	TotalCost int32     `gorm:"column:total_cost;not null"`         // 总花费
+	CoinTxID  int64     `gorm:"column:coin_tx_id;not null"`         // 金币流水ID
+	CreatedAt time.Time `gorm:"column:created_at"`                  // 购买时间
+}
+
+func (ItemPurchaseDO) TableName() string { return "item_purchases" }
+
+// InventoryRepo 实现 biz.InventoryRepo（GORM）
+
+type InventoryRepo struct{ data *Data }
+
+func NewInventoryRepo(d *Data) *InventoryRepo { return &InventoryRepo{data: d} }
+
+// Add 增加持有数量：唯一键 (user_id, item_id) 冲突时累加
+func (r *InventoryRepo) Add(ctx context.Context, userID, itemID int64, quantity int32) (int32, error) {
+	db := r.data.db(ctx)
+	row := &UserItemDO{UserID: userID, ItemID: itemID, Quantity: quantity}
+	if err := db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "item_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"quantity":   gorm.Expr("quantity + ?", quantity),
+			"updated_at": time.Now(),
+		}),
+	}).Create(row).Error; err != nil {
+		return 0, err
+	}
+	return r.quantity(ctx, userID, itemID)
+}
+
+// Consume 条件更新扣减数量，数量不足时不更新
+func (r *InventoryRepo) Consume(ctx context.Context, userID, itemID int64, quantity int32) (int32, error) {
+	res := r.data.db(ctx).Model(&UserItemDO{}).
+		Where("user_id=? AND item_id=? AND quantity>=?", userID, itemID, quantity).
+		Updates(map[string]interface{}{"quantity": gorm.Expr("quantity - ?", quantity), "updated_at": time.Now()})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return 0, biz.ErrPropNotOwned
+	}
+	return r.quantity(ctx, userID, itemID)
+}
+
+func (r *InventoryRepo) quantity(ctx context.Context, userID, itemID int64) (int32, error) {
+	var q int32
+	err := r.data.db(ctx).Model(&UserItemDO{}).Select("quantity").Where("user_id=? AND item_id=?", userID, itemID).Take(&q).Error
+	return q, err
+}
+
+// List 背包道具（联表 items，按道具排序）
+func (r *InventoryRepo) List(ctx context.Context, userID int64) ([]*biz.InventoryItem, error) {
+	type row struct {
+		ItemDO
+		Quantity  int32
+		UpdatedAt time.Time
+	}
+	var rows []row
+	if err := r.data.db(ctx).Table("user_items ui").
+		Select("i.id, i.name, i.description, i.icon_path, i.coin_cost, i.sort_order, i.effects, i.created_at, ui.quantity, ui.updated_at").
+		Joins("JOIN items i ON i.id = ui.item_id").
+		Where("ui.user_id=? AND ui.quantity>0", userID).
+		Order("i.sort_order asc, i.id asc").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.InventoryItem, 0, len(rows))
+	for _, v := range rows {
+		out = append(out, &biz.InventoryItem{
+			Item:      v.ItemDO.toBiz(),
+			Quantity:  v.Quantity,
+			UpdatedAt: v.UpdatedAt,
+		})
+	}
+	return out, nil
+}
+
+func (r *InventoryRepo) CreatePurchase(ctx context.Context, p *biz.ItemPurchase) error {
+	row := &ItemPurchaseDO{UserID: p.UserID, ItemID: p.ItemID, Quantity: p.Quantity, UnitCost: p.UnitCost, TotalCost: p.TotalCost, CoinTxID: p.CoinTxID, CreatedAt: p.CreatedAt}
+	if err := r.data.db(ctx).Create(row).Error; err != nil {
+		return err
+	}
+	p.ID = row.ID
+	return nil
+}
+
+// ListPurchases 购买记录（左联 items 取名称，道具被删除时名称为空）
+func (r *InventoryRepo) ListPurchases(ctx context.Context, userID, beforeID int64, limit int) ([]*biz.ItemPurchase, error) {
+	type row struct {
+		ItemPurchaseDO
+		ItemName string
+	}
+	q := r.data.db(ctx).Table("item_purchases p").
+		Select("p.*, COALESCE(i.name, '') AS item_name").
+		Joins("LEFT JOIN items i ON i.id = p.item_id").
+		Where("p.user_id=?", userID)
+	if beforeID > 0 {
+		q = q.Where("p.id<?", beforeID)
+	}
+	var rows []row
+	if err := q.Order("p.id desc").Limit(limit).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.ItemPurchase, 0, len(rows))
+	for _, v := range rows {
+		out = append(out, &biz.ItemPurchase{
+			ID: v.ID, UserID: v.UserID, ItemID: v.ItemID, ItemName: v.ItemName, Quantity: v.Quantity,
+			UnitCost: v.UnitCost, TotalCost: v.TotalCost, CoinTxID: v.CoinTxID, CreatedAt: v.CreatedAt,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestInventory(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, coins, active_pet_id) VALUES (1, 'alice', 100, 1);
+INSERT INTO pets (id, user_id, name) VALUES (1, 1, 'Mimi'), (2, 1, 'Wangcai');
+INSERT INTO items (id, name, coin_cost, sort_order, effects) VALUES (1, '小鱼干', 10, 2, 'hunger:+30'), (2, '毛线球', 0, 1, '');
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	wallet := biz.NewWalletUsecase(NewWalletRepo(d), d, log.DefaultLogger)
+	uc := biz.NewInventoryUsecase(NewInventoryRepo(d), NewAvatarRepo(d), wallet, biz.NewPetStateUsecase(NewPetStateRepo(d), NewPetRepo(d), d), nil, d)
+
+	if _, err := uc.Use(ctx, 1, 0, 1); !errors.Is(err, biz.ErrPropNotOwned) {
+		t.Fatalf("want not owned, got %v", err)
+	}
+	if _, err := uc.Purchase(ctx, 1, 99, 1); !errors.Is(err, biz.ErrPropNotFound) {
+		t.Fatalf("want not found, got %v", err)
+	}
+	if _, err := uc.Purchase(ctx, 1, 1, 100); !errors.Is(err, biz.ErrInvalidParameter) {
+		t.Fatalf("want invalid quantity, got %v", err)
+	}
+	res, err := uc.Purchase(ctx, 1, 1, 3)
+	if err != nil || res.Owned != 3 || res.Coins != 70 || res.Purchase.TotalCost != 30 || res.Purchase.CoinTxID == 0 {
+		t.Fatalf("purchase: %+v %v", res, err)
+	}
+	if res, err = uc.Purchase(ctx, 1, 1, 0); err != nil || res.Owned != 4 || res.Coins != 60 {
+		t.Fatalf("purchase default quantity: %+v %v", res, err)
+	}
+	// 金币不足时整笔回滚：背包与购买记录均不变
+	if _, err := uc.Purchase(ctx, 1, 1, 7); !errors.Is(err, biz.ErrInsufficientCoins) {
+		t.Fatalf("want insufficient coins, got %v", err)
+	}
+	if res, err = uc.Purchase(ctx, 1, 2, 1); err != nil || res.Owned != 1 || res.Coins != 60 || res.Purchase.CoinTxID != 0 {
+		t.Fatalf("free item: %+v %v", res, err)
+	}
+
+	// 使用道具消耗背包数量，不再扣金币
+	used, err := uc.Use(ctx, 1, 0, 1)
+	if err != nil || used.Remaining != 3 || used.State.PetID != 1 || used.State.Hunger != 100 || used.State.Mood != 80 {
+		t.Fatalf("use: %+v %v", used, err)
+	}
+	if coins, _ := wallet.Balance(ctx, 1); coins != 60 {
+		t.Fatalf("using an owned item must not charge coins, balance %d", coins)
+	}
+	// 可以指定宠物；不属于自己的宠物不能使用，且不消耗道具
+	if _, err := uc.Use(ctx, 1, 99, 2); !errors.Is(err, biz.ErrPetNotFound) {
+		t.Fatalf("want pet not found, got %v", err)
+	}
+	if used, err := uc.Use(ctx, 1, 2, 2); err != nil || used.State.PetID != 2 {
+		t.Fatalf("use on pet 2: %+v %v", used, err)
+	}
+	if _, err := uc.Use(ctx, 1, 0, 2); !errors.Is(err, biz.ErrPropNotOwned) {
+		t.Fatalf("want not owned after using up, got %v", err)
+	}
+	mine, err := uc.ListMine(ctx, 1)
+	if err != nil || len(mine) != 1 || mine[0].Item.Name != "小鱼干" || mine[0].Quantity != 3 {
+		t.Fatalf("my items: %+v %v", mine, err)
+	}
+
+	page, next, err := uc.ListPurchases(ctx, 1, "", 2)
+	if err != nil || len(page) != 2 || next == "" || page[0].ItemName != "毛线球" || page[1].Quantity != 1 {
+		t.Fatalf("purchases page 1: %+v %q %v", page, next, err)
+	}
+	if page, next, err = uc.ListPurchases(ctx, 1, next, 2); err != nil || len(page) != 1 || next != "" || page[0].Quantity != 3 || page[0].UnitCost != 10 {
+		t.Fatalf("purchases page 2: %+v %q %v", page, next, err)
+	}
+	var n int64
+	d.Gorm.Model(&CoinTransactionDO{}).Where("reason=? AND ref_type=? AND ref_id=1", biz.CoinReasonItemPurchase, biz.CoinRefItem).Count(&n)
+	if n != 2 {
+		t.Fatalf("want 2 purchase ledger entries, got %d", n)
+	}
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginFailKeyPrefix = "auth:login:fail:"
+	loginLockKeyPrefix = "auth:login:lock:"
+
+	// 进程内最多跟踪的 用户名/IP 数量，超出后淘汰最久未访问的记录
+	loginAttemptLRUSize = 10000
+)
+
+type loginAttemptEntry struct {
+	failures    int32
+	expiresAt   time.Time // 失败计数过期时间
+	lockedUntil time.Time
+}
+
+// LoginAttemptRepo 实现 biz.LoginAttemptRepo
+// 配置了 Redis 时使用 INCR/PEXPIRE（多实例共享）；否则使用进程内 LRU
+
+type LoginAttemptRepo struct {
+	data *Data
+
+	mu  sync.Mutex
+	mem *lru.Cache[string, *loginAttemptEntry]
+}
+
+func NewLoginAttemptRepo(d *Data) *LoginAttemptRepo {
+	cache, _ := lru.New[string, *loginAttemptEntry](loginAttemptLRUSize)
+	return &LoginAttemptRepo{data: d, mem: cache}
+}
+
+// Get 查询失败次数与锁定状态
+func (r *LoginAttemptRepo) Get(ctx context.Context, key string) (*biz.LoginAttempt, error) {
+	if rdb := r.data.Redis; rdb != nil {
+		var fails *redis.StringCmd
+		var lockTTL *redis.DurationCmd
+		if _, err := rdb.Pipelined(ctx, func(p redis.Pipeliner) error {
+			fails = p.Get(ctx, loginFailKeyPrefix+key)
+			lockTTL = p.PTTL(ctx, loginLockKeyPrefix+key)
+			return nil
+		}); err != nil && !errors.Is(err, redis.Nil) {
+			return nil, err
+		}
+		a := &biz.LoginAttempt{}
+		if n, err := fails.Int(); err == nil {
+			a.Failures = int32(n)
+		}
+		if d := lockTTL.Val(); d > 0 {
+			a.LockedUntil = time.Now().Add(d)
+		}
+		return a, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.mem.Get(key)
+	if !ok {
+		return &biz.LoginAttempt{}, nil
+	}
+	a := &biz.LoginAttempt{LockedUntil: e.lockedUntil}
+	if time.Now().Before(e.expiresAt) {
+		a.Failures = e.failures
+	}
+	return a, nil
+}
+
+// RecordFailure 失败次数 +1（滑动窗口）
+func (r *LoginAttemptRepo) RecordFailure(ctx context.Context, key string, window time.Duration) (int32, error) {
+	if rdb := r.data.Redis; rdb != nil {
+		var incr *redis.IntCmd
+		if _, err := rdb.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			incr = p.Incr(ctx, loginFailKeyPrefix+key)
+			p.PExpire(ctx, loginFailKeyPrefix+key, window)
+			return nil
+		}); err != nil {
+			return 0, err
+		}
+		return int32(incr.Val()), nil
+	}
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.mem.Get(key)
+	if !ok {
+		e = &loginAttemptEntry{}
+		r.mem.Add(key, e)
+	}
+	if !now.Before(e.expiresAt) {
+		e.failures = 0
+	}
+	e.failures++
+	if exp := now.Add(window); exp.After(e.expiresAt) {
+		e.expiresAt = exp
+	}
+	return e.failures, nil
+}
+
+// Lock 锁定 d 时长；失败计数至少保留到解锁后一个窗口，使下一次失败继续翻倍
+func (r *LoginAttemptRepo) Lock(ctx context.Context, key string, d, window time.Duration) error {
+	if rdb := r.data.Redis; rdb != nil {
+		_, err := rdb.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.Set(ctx, loginLockKeyPrefix+key, 1, d)
+			p.PExpire(ctx, loginFailKeyPrefix+key, d+window)
+			return nil
+		})
+		return err
+	}
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.mem.Get(key)
+	if !ok {
+		e = &loginAttemptEntry{}
+		r.mem.Add(key, e)
+	}
+	e.lockedUntil = now.Add(d)
+	if exp := e.lockedUntil.Add(window); exp.After(e.expiresAt) {
+		e.expiresAt = exp
+	}
+	return nil
+}
+
+// Reset 清除计数与锁定
+func (r *LoginAttemptRepo) Reset(ctx context.Context, key string) error {
+	if rdb := r.data.Redis; rdb != nil {
+		return rdb.Del(ctx, loginFailKeyPrefix+key, loginLockKeyPrefix+key).Err()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.mem.Remove(key)
+	return nil
+}
@@ -0,0 +1,184 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginCodeKeyPrefix  = "auth:sms:code:"
+	loginCodeRatePrefix = "auth:sms:rate:"
+
+	// 进程内最多保存的 验证码/发送计数 数量，超出后淘汰最久未访问的记录
+	loginCodeLRUSize = 10000
+)
+
+var (
+	// 仅在验证码存在时递增尝试次数，避免 HINCRBY 创建无过期时间的空 key
+	loginCodeIncrScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then return -1 end
+return redis.call('HINCRBY', KEYS[1], 'attempts', 1)`)
+	// 比较并删除：哈希一致才删除，保证同一验证码只能成功使用一次
+	loginCodeConsumeScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'hash') == ARGV[1] then return redis.call('DEL', KEYS[1]) end
+return 0`)
+	// 固定窗口计数：首次计数时设置过期时间
+	loginCodeHitScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then redis.call('PEXPIRE', KEYS[1], ARGV[1]) end
+return {n, redis.call('PTTL', KEYS[1])}`)
+)
+
+type loginCodeRate struct {
+	count     int64
+	expiresAt time.Time
+}
+
+// LoginCodeRepo 实现 biz.LoginCodeRepo
+// 配置了 Redis 时使用 HASH + Lua 脚本（多实例共享、原子消费）；否则使用进程内 LRU
+
+type LoginCodeRepo struct {
+	data *Data
+
+	mu    sync.Mutex
+	codes *lru.Cache[string, *biz.LoginCode]
+	rates *lru.Cache[string, *loginCodeRate]
+}
+
+func NewLoginCodeRepo(d *Data) *LoginCodeRepo {
+	codes, _ := lru.New[string, *biz.LoginCode](loginCodeLRUSize)
+	rates, _ := lru.New[string, *loginCodeRate](loginCodeLRUSize)
+	return &LoginCodeRepo{data: d, codes: codes, rates: rates}
+}
+
+// Save 覆盖写入验证码，到期自动删除
+func (r *LoginCodeRepo) Save(ctx context.Context, c *biz.LoginCode) error {
+	ttl := time.Until(c.ExpiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	if rdb := r.data.Redis; rdb != nil {
+		key := loginCodeKeyPrefix + c.Phone
+		_, err := rdb.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.Del(ctx, key)
+			p.HSet(ctx, key,
+				"hash", c.CodeHash,
+				"attempts", c.Attempts,
+				"sent_at", c.SentAt.UnixMilli(),
+				"expires_at", c.ExpiresAt.UnixMilli(),
+			)
+			p.PExpire(ctx, key, ttl)
+			return nil
+		})
+		return err
+	}
+	cp := *c
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.codes.Add(c.Phone, &cp)
+	return nil
+}
+
+// Get 读取未过期的验证码
+func (r *LoginCodeRepo) Get(ctx context.Context, phone string) (*biz.LoginCode, error) {
+	if rdb := r.data.Redis; rdb != nil {
+		m, err := rdb.HGetAll(ctx, loginCodeKeyPrefix+phone).Result()
+		if err != nil {
+			return nil, err
+		}
+		if m["hash"] == "" {
+			return nil, biz.ErrInvalidLoginCode
+		}
+		attempts, _ := strconv.ParseInt(m["attempts"], 10, 32)
+		sentAt, _ := strconv.ParseInt(m["sent_at"], 10, 64)
+		expiresAt, _ := strconv.ParseInt(m["expires_at"], 10, 64)
+		c := &biz.LoginCode{
+			Phone:     phone,
+			CodeHash:  m["hash"],
+			Attempts:  int32(attempts),
+			SentAt:    time.UnixMilli(sentAt),
+			ExpiresAt: time.UnixMilli(expiresAt),
+		}
+		if !time.Now().Before(c.ExpiresAt) {
+			return nil, biz.ErrInvalidLoginCode
+		}
+		return c, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.codes.Get(phone)
+	if !ok || !time.Now().Before(c.ExpiresAt) {
+		return nil, biz.ErrInvalidLoginCode
+	}
+	cp := *c
+	return &cp, nil
+}
+
+// IncrAttempts 尝试次数 +1
+func (r *LoginCodeRepo) IncrAttempts(ctx context.Context, phone string) (int32, error) {
+	if rdb := r.data.Redis; rdb != nil {
+		n, err := loginCodeIncrScript.Run(ctx, rdb, []string{loginCodeKeyPrefix + phone}).Int64()
+		if err != nil {
+			return 0, err
+		}
+		if n < 0 {
+			return 0, biz.ErrInvalidLoginCode
+		}
+		return int32(n), nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.codes.Get(phone)
+	if !ok || !time.Now().Before(c.ExpiresAt) {
+		return 0, biz.ErrInvalidLoginCode
+	}
+	c.Attempts++
+	return c.Attempts, nil
+}
+
+// Consume 原子地比较并删除验证码
+func (r *LoginCodeRepo) Consume(ctx context.Context, phone, codeHash string) (bool, error) {
+	if rdb := r.data.Redis; rdb != nil {
+		n, err := loginCodeConsumeScript.Run(ctx, rdb, []string{loginCodeKeyPrefix + phone}, codeHash).Int64()
+		if err != nil {
+			return false, err
+		}
+		return n > 0, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.codes.Get(phone)
+	if !ok || c.CodeHash != codeHash || !time.Now().Before(c.ExpiresAt) {
+		return false, nil
+	}
+	r.codes.Remove(phone)
+	return true, nil
+}
+
+// Hit 固定窗口发送计数 +1
+func (r *LoginCodeRepo) Hit(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	if rdb := r.data.Redis; rdb != nil {
+		res, err := loginCodeHitScript.Run(ctx, rdb, []string{loginCodeRatePrefix + key}, window.Milliseconds()).Int64Slice()
+		if err != nil {
+			return 0, 0, err
+		}
+		return res[0], time.Duration(res[1]) * time.Millisecond, nil
+	}
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.rates.Get(key)
+	if !ok || !now.Before(e.expiresAt) {
+		e = &loginCodeRate{expiresAt: now.Add(window)}
+		r.rates.Add(key, e)
+	}
+	e.count++
+	return e.count, e.expiresAt.Sub(now), nil
+}
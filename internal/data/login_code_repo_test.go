@@ -0,0 +1,123 @@
+package data
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// smsOutbox 记录发送的短信
+type smsOutbox struct{ last map[string]string }
+
+var smsCodePattern = regexp.MustCompile(`\d{6}`)
+
+func (o *smsOutbox) Send(_ context.Context, phone, content string) error {
+	o.last[phone] = smsCodePattern.FindString(content)
+	return nil
+}
+
+func newPhoneLogin(t *testing.T, c *conf.LoginCode) (*memoryAuth, *biz.PhoneLoginUsecase, *smsOutbox) {
+	t.Helper()
+	cfg := &conf.Auth{JwtSecret: "s", LoginCode: c}
+	m := newMemoryAuth(t, cfg)
+	sms := &smsOutbox{last: map[string]string{}}
+	return m, biz.NewPhoneLoginUsecase(m.uc, NewLoginCodeRepo(m.data), sms, cfg, log.DefaultLogger), sms
+}
+
+func TestPhoneLogin(t *testing.T) {
+	ctx := context.Background()
+	m, uc, sms := newPhoneLogin(t, &conf.LoginCode{ResendInterval: durationpb.New(0), MaxAttempts: 2})
+	client := biz.ClientInfo{IP: "10.0.0.1"}
+
+	if _, _, err := uc.SendCode(ctx, "12345", client); !errors.Is(err, biz.ErrInvalidPhone) {
+		t.Fatalf("want invalid phone, got %v", err)
+	}
+	ttl, _, err := uc.SendCode(ctx, "138 0013 8000", client)
+	if err != nil || ttl != 5*time.Minute {
+		t.Fatalf("send: %v %v", ttl, err)
+	}
+	code := sms.last["+8613800138000"]
+	if code == "" {
+		t.Fatal("code should be sent to the normalized phone")
+	}
+
+	// 错误验证码不消耗验证码；首次登录自动注册
+	if _, _, _, err := uc.Login(ctx, "13800138000", "000000x", client); !errors.Is(err, biz.ErrInvalidLoginCode) {
+		t.Fatalf("want invalid code, got %v", err)
+	}
+	u, pair, created, err := uc.Login(ctx, "+8613800138000", code, client)
+	if err != nil || !created || pair.AccessToken == "" || u.Phone != "+8613800138000" || u.Nickname != "用户8000" {
+		t.Fatalf("first login: %+v %v %v", u, created, err)
+	}
+	// 单次有效
+	if _, _, _, err := uc.Login(ctx, "13800138000", code, client); !errors.Is(err, biz.ErrInvalidLoginCode) {
+		t.Fatalf("code must be single-use, got %v", err)
+	}
+	// 验证码注册的用户没有密码，不能用密码登录
+	if _, _, err := m.uc.Login(ctx, u.Username, "", client); !errors.Is(err, biz.ErrInvalidCredentials) {
+		t.Fatalf("phone user must not log in with empty password, got %v", err)
+	}
+
+	// 再次登录复用同一用户
+	if _, _, err := uc.SendCode(ctx, "13800138000", client); err != nil {
+		t.Fatal(err)
+	}
+	got, _, created, err := uc.Login(ctx, "13800138000", sms.last["+8613800138000"], client)
+	if err != nil || created || got.Id != u.Id {
+		t.Fatalf("second login: %+v %v %v", got, created, err)
+	}
+
+	// 超过尝试次数后验证码作废
+	if _, _, err := uc.SendCode(ctx, "13800138000", client); err != nil {
+		t.Fatal(err)
+	}
+	code = sms.last["+8613800138000"]
+	for i := 0; i < 2; i++ {
+		if _, _, _, err := uc.Login(ctx, "13800138000", "wrong", client); !errors.Is(err, biz.ErrInvalidLoginCode) {
+			t.Fatalf("want invalid code, got %v", err)
+		}
+	}
+	if _, _, _, err := uc.Login(ctx, "13800138000", code, client); !errors.Is(err, biz.ErrInvalidLoginCode) {
+		t.Fatalf("code must be void after too many attempts, got %v", err)
+	}
+}
+
+func TestPhoneLoginThrottle(t *testing.T) {
+	ctx := context.Background()
+	_, uc, _ := newPhoneLogin(t, &conf.LoginCode{MaxPerPhone: 2, MaxPerIp: 3})
+	client := biz.ClientInfo{IP: "10.0.0.2"}
+
+	if _, _, err := uc.SendCode(ctx, "13900139000", client); err != nil {
+		t.Fatal(err)
+	}
+	// 重发间隔内
+	se := errors.FromError(func() error { _, _, err := uc.SendCode(ctx, "13900139000", client); return err }())
+	if se.Code != 429 || se.Reason != "LOGIN_CODE_THROTTLED" || se.Metadata["retry_after"] != "60" {
+		t.Fatalf("want resend throttled, got %v", se)
+	}
+
+	// 按 IP 计数：不同手机号共享同一 IP 的额度
+	_, uc, _ = newPhoneLogin(t, &conf.LoginCode{ResendInterval: durationpb.New(0), MaxPerPhone: 2, MaxPerIp: 3})
+	for _, phone := range []string{"13900139001", "13900139001"} {
+		if _, _, err := uc.SendCode(ctx, phone, client); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := uc.SendCode(ctx, "13900139001", client); errors.FromError(err).Code != 429 {
+		t.Fatalf("want per-phone limit, got %v", err)
+	}
+	if _, _, err := uc.SendCode(ctx, "13900139002", client); errors.FromError(err).Code != 429 {
+		t.Fatalf("want per-ip limit, got %v", err)
+	}
+	if _, _, err := uc.SendCode(ctx, "13900139002", biz.ClientInfo{IP: "10.0.0.3"}); err != nil {
+		t.Fatalf("other ip should pass: %v", err)
+	}
+}
@@ -29,8 +29,8 @@ type MessageRepoImpl struct{ data *Data }
 
 func NewMessageRepo(d *Data) *MessageRepoImpl { return &MessageRepoImpl{data: d} }
 
-// ListMessages 倒序分页
-func (r *MessageRepoImpl) ListMessages(ctx context.Context, userID int64, onlyNotes bool, page, pageSize int32) (int32, []*biz.Message, error) {
+// ListMessages 倒序分页（聊天记录与提醒仅限 petID 这只宠物，小纸条全部返回）
+func (r *MessageRepoImpl) ListMessages(ctx context.Context, userID, petID int64, onlyNotes bool, page, pageSize int32) (int32, []*biz.Message, error) {
 	if r.data.Gorm == nil {
 		return 0, []*biz.Message{}, nil
 	}
@@ -39,6 +39,8 @@ func (r *MessageRepoImpl) ListMessages(ctx context.Context, userID int64, onlyNo
 		Where("user_id=?", userID)
 	if onlyNotes {
 		q = q.Where("message_type=?", 1)
+	} else {
+		q = q.Where("message_type=1 OR pet_id=?", petID)
 	}
 	var total int64
 	if err := q.Count(&total).Error; err != nil {
@@ -59,6 +61,7 @@ func (r *MessageRepoImpl) ListMessages(ctx context.Context, userID int64, onlyNo
 		list = append(list, &biz.Message{
 			ID:          vv.ID,
 			UserID:      vv.UserID,
+			PetID:       vv.PetID,
 			Sender:      vv.Sender,
 			MessageType: vv.MessageType,
 			IsLocked:    vv.IsLocked,
@@ -88,6 +91,7 @@ func (r *MessageRepoImpl) GetMessageByID(ctx context.Context, userID, messageID
 	return &biz.Message{
 		ID:          m.ID,
 		UserID:      m.UserID,
+		PetID:       m.PetID,
 		Sender:      m.Sender,
 		MessageType: m.MessageType,
 		IsLocked:    m.IsLocked,
@@ -97,78 +101,31 @@ func (r *MessageRepoImpl) GetMessageByID(ctx context.Context, userID, messageID
 	}, nil
 }
 
-// UnlockMessage 事务扣金币并解锁
-func (r *MessageRepoImpl) UnlockMessage(ctx context.Context, userID, messageID int64) (int32, *biz.Message, error) {
+// LockNote 锁定并读取小纸条（SELECT ... FOR UPDATE，须在事务内调用）
+func (r *MessageRepoImpl) LockNote(ctx context.Context, userID, messageID int64) (*biz.Message, error) {
 	if r.data.Gorm == nil {
-		return 0, nil, biz.ErrMessageNotFound
+		return nil, biz.ErrMessageNotFound
 	}
-	var remaining int32
-	var out *biz.Message
-	err := r.data.Gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 锁定消息记录
-		var m MessageDO
-		if err := tx.
-			Clauses(clause.Locking{Strength: "UPDATE"}).
-			Where("id=? AND user_id=? AND message_type=?", messageID, userID, 1).
-			Take(&m).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return biz.ErrMessageNotFound
-			}
-			return err
-		}
-		if !m.IsLocked {
-			// 已解锁，直接返回当前信息与余额
-			type urow struct{ Coins int32 }
-			var ur urow
-			if err := tx.
-				Table("users").
-				Select("coins").
-				Where("id=?", userID).
-				Take(&ur).Error; err != nil {
-				return err
-			}
-			remaining = ur.Coins
-			out = &biz.Message{ID: m.ID, UserID: m.UserID, Sender: m.Sender, MessageType: m.MessageType, IsLocked: m.IsLocked, UnlockCoins: m.UnlockCoins, Content: m.Content, CreatedAt: m.CreatedAt}
-			return nil
-		}
-		// 扣金币
-		type urow struct{ Coins int32 }
-		var ur urow
-		if err := tx.
-			Table("users").
-			Clauses(clause.Locking{Strength: "UPDATE"}).
-			Select("coins").
-			Where("id=?", userID).
-			Take(&ur).Error; err != nil {
-			return err
-		}
-		if ur.Coins < m.UnlockCoins {
-			return biz.ErrInsufficientCoins
-		}
-		remaining = ur.Coins - m.UnlockCoins
-		if err := tx.Exec("UPDATE users SET coins=?, updated_at=NOW() WHERE id=?", remaining, userID).Error; err != nil {
-			return err
-		}
-		// 解锁消息
-		if err := tx.
-			Model(&MessageDO{}).
-			Where("id=?", m.ID).
-			Update("is_locked", false).Error; err != nil {
-			return err
-		}
-		// 记录解锁流水
-		rec := &UserUnlockRecordDO{UserID: userID, MessageID: m.ID, Coins: m.UnlockCoins}
-		if err := tx.Create(rec).Error; err != nil {
-			return err
+	var m MessageDO
+	if err := r.data.db(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id=? AND user_id=? AND message_type=?", messageID, userID, 1).
+		Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrMessageNotFound
 		}
-		// 返回最新消息
-		out = &biz.Message{ID: m.ID, UserID: m.UserID, Sender: m.Sender, MessageType: m.MessageType, IsLocked: false, UnlockCoins: m.UnlockCoins, Content: m.Content, CreatedAt: m.CreatedAt}
-		return nil
-	})
-	if err != nil {
-		return 0, nil, err
+		return nil, err
 	}
-	return remaining, out, nil
+	return &biz.Message{ID: m.ID, UserID: m.UserID, PetID: m.PetID, Sender: m.Sender, MessageType: m.MessageType, IsLocked: m.IsLocked, UnlockCoins: m.UnlockCoins, Content: m.Content, CreatedAt: m.CreatedAt}, nil
+}
+
+// MarkUnlocked 标记小纸条已解锁并记录解锁记录
+func (r *MessageRepoImpl) MarkUnlocked(ctx context.Context, userID, messageID int64, coins int32) error {
+	db := r.data.db(ctx)
+	if err := db.Model(&MessageDO{}).Where("id=? AND user_id=?", messageID, userID).Update("is_locked", false).Error; err != nil {
+		return err
+	}
+	return db.Create(&UserUnlockRecordDO{UserID: userID, MessageID: messageID, Coins: coins}).Error
 }
 
 // CreateLockedNote 生成一条锁定的小纸条（AI 个性化内容）
@@ -187,3 +144,19 @@ func (r *MessageRepoImpl) CreateLockedNote(ctx context.Context, userID int64, un
 	}
 	return row.ID, nil
 }
+
+// CreateMessage 写入一条消息（提醒等系统消息）
+func (r *MessageRepoImpl) CreateMessage(ctx context.Context, m *biz.Message) error {
+	if r.data.Gorm == nil {
+		return nil
+	}
+	row := &MessageDO{
+		UserID: m.UserID, PetID: m.PetID, Sender: m.Sender, MessageType: m.MessageType, IsLocked: m.IsLocked,
+		UnlockCoins: m.UnlockCoins, Content: m.Content, CreatedAt: m.CreatedAt,
+	}
+	if err := r.data.db(ctx).Create(row).Error; err != nil {
+		return err
+	}
+	m.ID = row.ID
+	return nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -21,6 +24,7 @@ CREATE TABLE users (id INTEGER PRIMARY KEY, coins INTEGER DEFAULT 0);
 CREATE TABLE messages (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
   user_id INTEGER NOT NULL,
+  pet_id INTEGER NOT NULL DEFAULT 0,
   sender INTEGER NOT NULL,
   message_type INTEGER NOT NULL,
   is_locked INTEGER NOT NULL,
@@ -34,6 +38,17 @@ CREATE TABLE user_unlock_records (
   message_id INTEGER NOT NULL,
   coins_spent INTEGER NOT NULL
 );
+CREATE TABLE coin_transactions (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  amount INTEGER NOT NULL,
+  balance INTEGER NOT NULL,
+  reason TEXT NOT NULL,
+  ref_type TEXT NOT NULL DEFAULT '',
+  ref_id INTEGER NOT NULL DEFAULT 0,
+  counterparty_id INTEGER NOT NULL DEFAULT 0,
+  created_at DATETIME
+);
 `).Error; err != nil {
 		t.Fatal(err)
 	}
@@ -44,7 +59,7 @@ func TestUnlockMessage(t *testing.T) {
 	gdb := setupTestGorm(t)
 	sqlDB, _ := gdb.DB()
 	d := &Data{Gorm: gdb, DB: sqlDB}
-	repo := NewMessageRepo(d)
+	uc := biz.NewMessageUsecase(NewMessageRepo(d), nil, biz.NewWalletUsecase(NewWalletRepo(d), d, log.DefaultLogger), d)
 	// seed user 1 with 100 coins and a locked note cost 20
 	if err := gdb.Exec(`INSERT INTO users(id,coins) VALUES (1,100);`).Error; err != nil {
 		t.Fatal(err)
@@ -55,7 +70,7 @@ func TestUnlockMessage(t *testing.T) {
 	// find message id
 	var mid int64
 	_ = gdb.Raw(`SELECT id FROM messages LIMIT 1`).Scan(&mid).Error
-	remain, msg, err := repo.UnlockMessage(context.Background(), 1, mid)
+	remain, msg, err := uc.Unlock(context.Background(), 1, mid)
 	if err != nil {
 		t.Fatalf("unlock err: %v", err)
 	}
@@ -66,11 +81,19 @@ func TestUnlockMessage(t *testing.T) {
 		t.Fatal("message should be unlocked")
 	}
 	// repeat unlock should be idempotent
-	remain2, _, err := repo.UnlockMessage(context.Background(), 1, mid)
+	remain2, _, err := uc.Unlock(context.Background(), 1, mid)
 	if err != nil {
 		t.Fatalf("second unlock err: %v", err)
 	}
 	if remain2 != 80 {
 		t.Fatalf("remain should stay 80 got %d", remain2)
 	}
+	// 扣费写入金币流水，重复解锁不再记账
+	var txs []CoinTransactionDO
+	if err := gdb.Find(&txs).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(txs) != 1 || txs[0].Amount != -20 || txs[0].Balance == nil || *txs[0].Balance != 80 || txs[0].Reason != biz.CoinReasonNoteUnlock || txs[0].RefType != biz.CoinRefMessage || txs[0].RefID != mid {
+		t.Fatalf("unexpected ledger: %+v", txs)
+	}
 }
@@ -0,0 +1,169 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// PasswordResetDO 映射 password_resets 表
+
+type PasswordResetDO struct {
+	ID        int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64      `gorm:"column:user_id;not null"`
+	CodeHash  string     `gorm:"column:code_hash;not null"`
+	Attempts  int32      `gorm:"column:attempts;not null"`
+	ExpiresAt time.Time  `gorm:"column:expires_at;not null"`
+	UsedAt    *time.Time `gorm:"column:used_at"`
+	CreatedAt time.Time  `gorm:"column:created_at"`
+}
+
+func (PasswordResetDO) TableName() string { return "password_resets" }
+
+func (p *PasswordResetDO) toBiz() *biz.PasswordReset {
+	return &biz.PasswordReset{
+		ID:        p.ID,
+		UserID:    p.UserID,
+		CodeHash:  p.CodeHash,
+		Attempts:  p.Attempts,
+		ExpiresAt: p.ExpiresAt,
+		UsedAt:    p.UsedAt,
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+// PasswordResetRepo 实现 biz.PasswordResetRepo（GORM，内存兜底）
+
+type PasswordResetRepo struct {
+	data *Data
+
+	mu     sync.Mutex
+	mem    map[int64]*PasswordResetDO
+	nextID int64
+}
+
+func NewPasswordResetRepo(d *Data) *PasswordResetRepo {
+	return &PasswordResetRepo{data: d, mem: make(map[int64]*PasswordResetDO), nextID: 1}
+}
+
+// Create 写入新验证码并作废旧的未使用验证码
+func (r *PasswordResetRepo) Create(ctx context.Context, p *biz.PasswordReset) (int64, error) {
+	now := time.Now()
+	row := &PasswordResetDO{
+		UserID:    p.UserID,
+		CodeHash:  p.CodeHash,
+		ExpiresAt: p.ExpiresAt,
+		CreatedAt: now,
+	}
+	if r.data.Gorm != nil {
+		err := r.data.Gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Model(&PasswordResetDO{}).
+				Where("user_id=? AND used_at IS NULL", p.UserID).
+				Update("used_at", now).Error; err != nil {
+				return err
+			}
+			return tx.Create(row).Error
+		})
+		if err != nil {
+			return 0, err
+		}
+		return row.ID, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, v := range r.mem {
+		if v.UserID == p.UserID && v.UsedAt == nil {
+			t := now
+			v.UsedAt = &t
+		}
+	}
+	row.ID = r.nextID
+	r.nextID++
+	r.mem[row.ID] = row
+	return row.ID, nil
+}
+
+// GetActive 最新一条未使用且未过期的验证码
+func (r *PasswordResetRepo) GetActive(ctx context.Context, userID int64, now time.Time) (*biz.PasswordReset, error) {
+	if r.data.Gorm != nil {
+		var row PasswordResetDO
+		if err := r.data.Gorm.WithContext(ctx).
+			Where("user_id=? AND used_at IS NULL AND expires_at>?", userID, now).
+			Order("id desc").
+			First(&row).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, biz.ErrInvalidResetCode
+			}
+			return nil, err
+		}
+		return row.toBiz(), nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var latest *PasswordResetDO
+	for _, v := range r.mem {
+		if v.UserID == userID && v.UsedAt == nil && now.Before(v.ExpiresAt) && (latest == nil || v.ID > latest.ID) {
+			latest = v
+		}
+	}
+	if latest == nil {
+		return nil, biz.ErrInvalidResetCode
+	}
+	return latest.toBiz(), nil
+}
+
+// TakeAttempt 占用一次尝试（条件更新，并发下总次数不超过 max）
+func (r *PasswordResetRepo) TakeAttempt(ctx context.Context, id int64, max int32) error {
+	if r.data.Gorm != nil {
+		res := r.data.Gorm.WithContext(ctx).
+			Model(&PasswordResetDO{}).
+			Where("id=? AND attempts<?", id, max).
+			UpdateColumn("attempts", gorm.Expr("attempts+1"))
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrInvalidResetCode
+		}
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v, ok := r.mem[id]
+	if !ok || v.Attempts >= max {
+		return biz.ErrInvalidResetCode
+	}
+	v.Attempts++
+	return nil
+}
+
+// Consume 标记已使用（并发下只有一个请求能成功）
+func (r *PasswordResetRepo) Consume(ctx context.Context, id int64) error {
+	now := time.Now()
+	if r.data.Gorm != nil {
+		res := r.data.Gorm.WithContext(ctx).
+			Model(&PasswordResetDO{}).
+			Where("id=? AND used_at IS NULL", id).
+			Update("used_at", now)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrInvalidResetCode
+		}
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v, ok := r.mem[id]
+	if !ok || v.UsedAt != nil {
+		return biz.ErrInvalidResetCode
+	}
+	v.UsedAt = &now
+	return nil
+}
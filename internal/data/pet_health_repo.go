@@ -0,0 +1,261 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// PetWeightDO 映射 pet_weights 表（体重记录）
+type PetWeightDO struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement"` // 记录ID
+	UserID     int64     `gorm:"column:user_id;not null"`            // 用户ID
+	PetID      int64     `gorm:"column:pet_id;not null"`             // 宠物ID
+	WeightKg   float64   `gorm:"column:weight_kg;type:decimal(6,2)"` // 体重（kg）
+	MeasuredOn string    `gorm:"column:measured_on;type:char(10)"`   // 测量日期
+	Note       string    `gorm:"column:note;type:varchar(255)"`      // 备注
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`   // 创建时间
+}
+
+func (PetWeightDO) TableName() string { return "pet_weights" }
+
+// PetCareRecordDO 映射 pet_care_records 表（疫苗/驱虫记录）
+type PetCareRecordDO struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"` // 记录ID
+	UserID    int64     `gorm:"column:user_id;not null"`            // 用户ID
+	PetID     int64     `gorm:"column:pet_id;not null"`             // 宠物ID
+	Type      string    `gorm:"column:type;type:varchar(16)"`       // vaccination/deworming
+	Name      string    `gorm:"column:name;type:varchar(100)"`      // 疫苗/药品名称
+	GivenOn   string    `gorm:"column:given_on;type:char(10)"`      // 接种/驱虫日期
+	NextDueOn string    `gorm:"column:next_due_on;type:char(10)"`   // 下次到期日期（空表示无）
+	Clinic    string    `gorm:"column:clinic;type:varchar(100)"`    // 医院/机构
+	Note      string    `gorm:"column:note;type:varchar(500)"`      // 备注
+	CreatedAt time.Time `gorm:"column:created_at"`                  // 创建时间
+	UpdatedAt time.Time `gorm:"column:updated_at"`                  // 更新时间
+}
+
+func (PetCareRecordDO) TableName() string { return "pet_care_records" }
+
+// PetVetVisitDO 映射 pet_vet_visits 表（就诊记录）
+type PetVetVisitDO struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"` // 记录ID
+	UserID      int64     `gorm:"column:user_id;not null"`            // 用户ID
+	PetID       int64     `gorm:"column:pet_id;not null"`             // 宠物ID
+	VisitedOn   string    `gorm:"column:visited_on;type:char(10)"`    // 就诊日期
+	Clinic      string    `gorm:"column:clinic;type:varchar(100)"`    // 医院
+	Reason      string    `gorm:"column:reason;type:varchar(255)"`    // 就诊原因
+	Diagnosis   string    `gorm:"column:diagnosis;type:text"`         // 诊断
+	Notes       string    `gorm:"column:notes;type:text"`             // 医嘱/备注
+	Attachments string    `gorm:"column:attachments;type:text"`       // 附件 URL（逗号分隔）
+	CreatedAt   time.Time `gorm:"column:created_at"`                  // 创建时间
+	UpdatedAt   time.Time `gorm:"column:updated_at"`                  // 更新时间
+}
+
+func (PetVetVisitDO) TableName() string { return "pet_vet_visits" }
+
+func (w *PetWeightDO) toBiz() *biz.WeightEntry {
+	return &biz.WeightEntry{ID: w.ID, UserID: w.UserID, PetID: w.PetID, WeightKg: w.WeightKg, MeasuredOn: w.MeasuredOn, Note: w.Note, CreatedAt: w.CreatedAt}
+}
+
+func (c *PetCareRecordDO) toBiz() *biz.CareRecord {
+	return &biz.CareRecord{
+		ID: c.ID, UserID: c.UserID, PetID: c.PetID, Type: c.Type, Name: c.Name, GivenOn: c.GivenOn, NextDueOn: c.NextDueOn,
+		Clinic: c.Clinic, Note: c.Note, CreatedAt: c.CreatedAt, UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func careDO(c *biz.CareRecord) *PetCareRecordDO {
+	return &PetCareRecordDO{
+		ID: c.ID, UserID: c.UserID, PetID: c.PetID, Type: c.Type, Name: c.Name, GivenOn: c.GivenOn, NextDueOn: c.NextDueOn,
+		Clinic: c.Clinic, Note: c.Note, CreatedAt: c.CreatedAt, UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func (v *PetVetVisitDO) toBiz() *biz.VetVisit {
+	return &biz.VetVisit{
+		ID: v.ID, UserID: v.UserID, PetID: v.PetID, VisitedOn: v.VisitedOn, Clinic: v.Clinic, Reason: v.Reason,
+		Diagnosis: v.Diagnosis, Notes: v.Notes, Attachments: splitCSV(v.Attachments), CreatedAt: v.CreatedAt, UpdatedAt: v.UpdatedAt,
+	}
+}
+
+func visitDO(v *biz.VetVisit) *PetVetVisitDO {
+	return &PetVetVisitDO{
+		ID: v.ID, UserID: v.UserID, PetID: v.PetID, VisitedOn: v.VisitedOn, Clinic: v.Clinic, Reason: v.Reason,
+		Diagnosis: v.Diagnosis, Notes: v.Notes, Attachments: joinCSV(v.Attachments), CreatedAt: v.CreatedAt, UpdatedAt: v.UpdatedAt,
+	}
+}
+
+// PetHealthRepo 实现 biz.PetHealthRepo（GORM）
+
+type PetHealthRepo struct{ data *Data }
+
+func NewPetHealthRepo(d *Data) *PetHealthRepo { return &PetHealthRepo{data: d} }
+
+// take 按 ID 与归属用户读取一条记录，不存在返回 ErrHealthRecordNotFound
+func (r *PetHealthRepo) take(ctx context.Context, dst interface{}, userID, id int64) error {
+	if err := r.data.db(ctx).Where("id=? AND user_id=?", id, userID).Take(dst).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return biz.ErrHealthRecordNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// remove 按 ID 与归属用户删除一条记录，不存在返回 ErrHealthRecordNotFound
+func (r *PetHealthRepo) remove(ctx context.Context, model interface{}, userID, id int64) error {
+	res := r.data.db(ctx).Where("id=? AND user_id=?", id, userID).Delete(model)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrHealthRecordNotFound
+	}
+	return nil
+}
+
+func (r *PetHealthRepo) CreateWeight(ctx context.Context, w *biz.WeightEntry) error {
+	row := &PetWeightDO{UserID: w.UserID, PetID: w.PetID, WeightKg: w.WeightKg, MeasuredOn: w.MeasuredOn, Note: w.Note}
+	if err := r.data.db(ctx).Create(row).Error; err != nil {
+		return err
+	}
+	w.ID, w.CreatedAt = row.ID, row.CreatedAt
+	return nil
+}
+
+func (r *PetHealthRepo) GetWeight(ctx context.Context, userID, id int64) (*biz.WeightEntry, error) {
+	var row PetWeightDO
+	if err := r.take(ctx, &row, userID, id); err != nil {
+		return nil, err
+	}
+	return row.toBiz(), nil
+}
+
+func (r *PetHealthRepo) ListWeights(ctx context.Context, userID, petID int64, from, to string) ([]*biz.WeightEntry, error) {
+	q := r.data.db(ctx).Where("user_id=? AND pet_id=?", userID, petID)
+	if from != "" {
+		q = q.Where("measured_on>=?", from)
+	}
+	if to != "" {
+		q = q.Where("measured_on<=?", to)
+	}
+	var rows []PetWeightDO
+	if err := q.Order("measured_on, id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.WeightEntry, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toBiz())
+	}
+	return out, nil
+}
+
+func (r *PetHealthRepo) LatestWeight(ctx context.Context, userID, petID int64) (*biz.WeightEntry, error) {
+	var row PetWeightDO
+	if err := r.data.db(ctx).Where("user_id=? AND pet_id=?", userID, petID).
+		Order("measured_on desc, id desc").Take(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return row.toBiz(), nil
+}
+
+func (r *PetHealthRepo) DeleteWeight(ctx context.Context, userID, id int64) error {
+	return r.remove(ctx, &PetWeightDO{}, userID, id)
+}
+
+func (r *PetHealthRepo) SetPetWeight(ctx context.Context, petID int64, kg int32) error {
+	return r.data.db(ctx).Model(&PetDO{}).Where("id=?", petID).
+		Updates(map[string]interface{}{"weight": kg, "updated_at": time.Now()}).Error
+}
+
+func (r *PetHealthRepo) CreateCare(ctx context.Context, c *biz.CareRecord) error {
+	row := careDO(c)
+	if err := r.data.db(ctx).Create(row).Error; err != nil {
+		return err
+	}
+	c.ID = row.ID
+	return nil
+}
+
+func (r *PetHealthRepo) GetCare(ctx context.Context, userID, id int64) (*biz.CareRecord, error) {
+	var row PetCareRecordDO
+	if err := r.take(ctx, &row, userID, id); err != nil {
+		return nil, err
+	}
+	return row.toBiz(), nil
+}
+
+func (r *PetHealthRepo) ListCare(ctx context.Context, userID, petID int64, typ string) ([]*biz.CareRecord, error) {
+	q := r.data.db(ctx).Where("user_id=? AND pet_id=?", userID, petID)
+	if typ != "" {
+		q = q.Where("type=?", typ)
+	}
+	var rows []PetCareRecordDO
+	if err := q.Order("given_on desc, id desc").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.CareRecord, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toBiz())
+	}
+	return out, nil
+}
+
+func (r *PetHealthRepo) UpdateCare(ctx context.Context, c *biz.CareRecord) error {
+	row := careDO(c)
+	return r.data.db(ctx).Model(row).Where("user_id=?", c.UserID).
+		Select("name", "given_on", "next_due_on", "clinic", "note", "updated_at").
+		Updates(row).Error
+}
+
+func (r *PetHealthRepo) DeleteCare(ctx context.Context, userID, id int64) error {
+	return r.remove(ctx, &PetCareRecordDO{}, userID, id)
+}
+
+func (r *PetHealthRepo) CreateVisit(ctx context.Context, v *biz.VetVisit) error {
+	row := visitDO(v)
+	if err := r.data.db(ctx).Create(row).Error; err != nil {
+		return err
+	}
+	v.ID = row.ID
+	return nil
+}
+
+func (r *PetHealthRepo) GetVisit(ctx context.Context, userID, id int64) (*biz.VetVisit, error) {
+	var row PetVetVisitDO
+	if err := r.take(ctx, &row, userID, id); err != nil {
+		return nil, err
+	}
+	return row.toBiz(), nil
+}
+
+func (r *PetHealthRepo) ListVisits(ctx context.Context, userID, petID int64) ([]*biz.VetVisit, error) {
+	var rows []PetVetVisitDO
+	if err := r.data.db(ctx).Where("user_id=? AND pet_id=?", userID, petID).
+		Order("visited_on desc, id desc").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.VetVisit, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toBiz())
+	}
+	return out, nil
+}
+
+func (r *PetHealthRepo) UpdateVisit(ctx context.Context, v *biz.VetVisit) error {
+	row := visitDO(v)
+	return r.data.db(ctx).Model(row).Where("user_id=?", v.UserID).
+		Select("visited_on", "clinic", "reason", "diagnosis", "notes", "attachments", "updated_at").
+		Updates(row).Error
+}
+
+func (r *PetHealthRepo) DeleteVisit(ctx context.Context, userID, id int64) error {
+	return r.remove(ctx, &PetVetVisitDO{}, userID, id)
+}
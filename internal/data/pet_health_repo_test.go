@@ -0,0 +1,170 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+)
+
+func TestPetHealth(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, active_pet_id) VALUES (1, 'alice', 1), (2, 'bob', 3);
+INSERT INTO pets (id, user_id, name, weight) VALUES (1, 1, 'Mimi', 3), (2, 1, 'Wangcai', 0), (3, 2, 'Bob cat', 0);
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "document"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "document", "lab.pdf"), []byte("%PDF"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pets := biz.NewPetUsecase(NewPetRepo(d), NewAvatarRepo(d), nil, d)
+	uc := biz.NewPetHealthUsecase(NewPetHealthRepo(d), pets, NewLocalUploadStore(&conf.Storage{LocalRoot: root, PublicPrefix: "/static/"}), d)
+	day := func(offset int) string { return time.Now().AddDate(0, 0, offset).Format("2006-01-02") }
+	petWeight := func(id int64) int32 {
+		var p PetDO
+		d.Gorm.Take(&p, id)
+		return p.Weight
+	}
+
+	// 体重：两位小数，最新记录同步到宠物资料（取整）
+	if _, err := uc.AddWeight(ctx, &biz.WeightEntry{UserID: 1, WeightKg: 0}); err == nil {
+		t.Fatal("want invalid weight")
+	}
+	if _, err := uc.AddWeight(ctx, &biz.WeightEntry{UserID: 1, WeightKg: 4, MeasuredOn: day(3)}); err == nil {
+		t.Fatal("want future date rejected")
+	}
+	if _, err := uc.AddWeight(ctx, &biz.WeightEntry{UserID: 1, PetID: 3, WeightKg: 4}); !errors.Is(err, biz.ErrPetNotFound) {
+		t.Fatalf("other user's pet: want not found, got %v", err)
+	}
+	first, err := uc.AddWeight(ctx, &biz.WeightEntry{UserID: 1, WeightKg: 4.126, MeasuredOn: day(-10)})
+	if err != nil || first.PetID != 1 || first.WeightKg != 4.13 {
+		t.Fatalf("add weight: %+v %v", first, err)
+	}
+	if _, err := uc.AddWeight(ctx, &biz.WeightEntry{UserID: 1, WeightKg: 4.4, MeasuredOn: day(-1)}); err != nil {
+		t.Fatal(err)
+	}
+	latest, err := uc.AddWeight(ctx, &biz.WeightEntry{UserID: 1, WeightKg: 4.6, MeasuredOn: day(-1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 补录更早的记录不影响宠物资料体重
+	if _, err := uc.AddWeight(ctx, &biz.WeightEntry{UserID: 1, WeightKg: 3.9, MeasuredOn: day(-200)}); err != nil {
+		t.Fatal(err)
+	}
+	if w := petWeight(1); w != 5 {
+		t.Fatalf("pet weight: want 5, got %d", w)
+	}
+	if list, err := uc.ListWeights(ctx, 1, 0, "", ""); err != nil || len(list) != 4 || list[0].WeightKg != 3.9 {
+		t.Fatalf("list weights: %+v %v", list, err)
+	}
+	if list, err := uc.ListWeights(ctx, 1, 0, day(-30), ""); err != nil || len(list) != 3 {
+		t.Fatalf("list weights from: %+v %v", list, err)
+	}
+
+	// 趋势：同一天取最后一条
+	trend, err := uc.WeightTrend(ctx, 1, 0, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trend.Points) != 2 || trend.Points[1].WeightKg != 4.6 || trend.Latest != 4.6 || trend.Min != 4.13 || trend.Max != 4.6 || trend.Change != 0.47 {
+		t.Fatalf("trend: %+v %+v", trend, trend.Points)
+	}
+	if trend.To != day(0) || trend.From != day(-29) {
+		t.Fatalf("trend range: %s ~ %s", trend.From, trend.To)
+	}
+	if trend, err = uc.WeightTrend(ctx, 1, 2, 0); err != nil || len(trend.Points) != 0 || trend.Latest != 0 {
+		t.Fatalf("empty trend: %+v %v", trend, err)
+	}
+
+	// 删除最新记录后按剩余的最新记录同步
+	if err := uc.DeleteWeight(ctx, 2, latest.ID); !errors.Is(err, biz.ErrHealthRecordNotFound) {
+		t.Fatalf("want not found, got %v", err)
+	}
+	if err := uc.DeleteWeight(ctx, 1, latest.ID); err != nil {
+		t.Fatal(err)
+	}
+	if w := petWeight(1); w != 4 {
+		t.Fatalf("pet weight after delete: want 4, got %d", w)
+	}
+
+	// 疫苗/驱虫
+	if _, err := uc.CreateCare(ctx, &biz.CareRecord{UserID: 1, Type: "bath", Name: "x", GivenOn: day(0)}); err == nil {
+		t.Fatal("want invalid type")
+	}
+	if _, err := uc.CreateCare(ctx, &biz.CareRecord{UserID: 1, Type: biz.CareVaccination, Name: "狂犬", GivenOn: day(0), NextDueOn: day(-1)}); err == nil {
+		t.Fatal("want next_due_on before given_on rejected")
+	}
+	vac, err := uc.CreateCare(ctx, &biz.CareRecord{UserID: 1, Type: biz.CareVaccination, Name: " 狂犬 ", GivenOn: day(-30), NextDueOn: day(335), Clinic: "安安"})
+	if err != nil || vac.Name != "狂犬" || vac.PetID != 1 {
+		t.Fatalf("create care: %+v %v", vac, err)
+	}
+	if _, err := uc.CreateCare(ctx, &biz.CareRecord{UserID: 1, Type: biz.CareDeworming, Name: "体内驱虫", GivenOn: day(-5)}); err != nil {
+		t.Fatal(err)
+	}
+	if list, err := uc.ListCare(ctx, 1, 0, ""); err != nil || len(list) != 2 || list[0].Type != biz.CareDeworming {
+		t.Fatalf("list care: %+v %v", list, err)
+	}
+	if list, err := uc.ListCare(ctx, 1, 0, biz.CareVaccination); err != nil || len(list) != 1 || list[0].NextDueOn != day(335) {
+		t.Fatalf("list vaccinations: %+v %v", list, err)
+	}
+	if _, err := uc.UpdateCare(ctx, &biz.CareRecord{ID: vac.ID, UserID: 2, Name: "x", GivenOn: day(0)}); !errors.Is(err, biz.ErrHealthRecordNotFound) {
+		t.Fatalf("want not found, got %v", err)
+	}
+	upd, err := uc.UpdateCare(ctx, &biz.CareRecord{ID: vac.ID, UserID: 1, Type: biz.CareDeworming, Name: "狂犬", GivenOn: day(-30)})
+	if err != nil || upd.Type != biz.CareVaccination || upd.NextDueOn != "" || upd.Clinic != "" {
+		t.Fatalf("update care: %+v %v", upd, err)
+	}
+	if got, _ := NewPetHealthRepo(d).GetCare(ctx, 1, vac.ID); got.NextDueOn != "" || got.Type != biz.CareVaccination {
+		t.Fatalf("stored care: %+v", got)
+	}
+
+	// 就诊：附件须为本地上传文件
+	if _, err := uc.CreateVisit(ctx, &biz.VetVisit{UserID: 1, VisitedOn: day(0), Attachments: []string{"https://cdn.example.com/x.pdf"}}); err == nil {
+		t.Fatal("want external attachment rejected")
+	}
+	if _, err := uc.CreateVisit(ctx, &biz.VetVisit{UserID: 1, VisitedOn: day(0), Attachments: []string{"/static/document/missing.pdf"}}); err == nil {
+		t.Fatal("want missing attachment rejected")
+	}
+	visit, err := uc.CreateVisit(ctx, &biz.VetVisit{
+		UserID: 1, PetID: 2, VisitedOn: day(-2), Clinic: "安安", Reason: "呕吐", Diagnosis: "肠胃炎",
+		Attachments: []string{"/static/document/lab.pdf", "/static/document/lab.pdf", ""},
+	})
+	if err != nil || len(visit.Attachments) != 1 {
+		t.Fatalf("create visit: %+v %v", visit, err)
+	}
+	if list, err := uc.ListVisits(ctx, 1, 2); err != nil || len(list) != 1 || list[0].Attachments[0] != "/static/document/lab.pdf" || list[0].Diagnosis != "肠胃炎" {
+		t.Fatalf("list visits: %+v %v", list, err)
+	}
+	if list, err := uc.ListVisits(ctx, 1, 0); err != nil || len(list) != 0 {
+		t.Fatalf("active pet visits: %+v %v", list, err)
+	}
+	if v, err := uc.UpdateVisit(ctx, &biz.VetVisit{ID: visit.ID, UserID: 1, VisitedOn: day(-2), Notes: "复查"}); err != nil || len(v.Attachments) != 0 || v.PetID != 2 {
+		t.Fatalf("update visit: %+v %v", v, err)
+	}
+	if err := uc.DeleteVisit(ctx, 2, visit.ID); !errors.Is(err, biz.ErrHealthRecordNotFound) {
+		t.Fatalf("want not found, got %v", err)
+	}
+
+	// 删除宠物时健康档案一并删除
+	if _, err := pets.Delete(ctx, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	for table, want := range map[string]int64{"pet_weights": 0, "pet_care_records": 0, "pet_vet_visits": 1} {
+		var n int64
+		d.Gorm.Table(table).Count(&n)
+		if n != want {
+			t.Fatalf("%s: want %d rows, got %d", table, want, n)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PetLevelDO 映射 pet_levels 表（每只宠物一行，累计经验）
+
+type PetLevelDO struct {
+	PetID     int64     `gorm:"column:pet_id;primaryKey;autoIncrement:false"`
+	UserID    int64     `gorm:"column:user_id;not null"`
+	XP        int64     `gorm:"column:xp;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (PetLevelDO) TableName() string { return "pet_levels" }
+
+// PetXPLogDO 映射 pet_xp_logs 表
+
+type PetXPLogDO struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64     `gorm:"column:user_id;not null"`
+	PetID     int64     `gorm:"column:pet_id;not null"`
+	Source    string    `gorm:"column:source;not null"`
+	XP        int32     `gorm:"column:xp;not null"`
+	XPDate    string    `gorm:"column:xp_date;not null"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (PetXPLogDO) TableName() string { return "pet_xp_logs" }
+
+// UserPetModelDO 映射 user_pet_models 表（用金币解锁的模型）
+
+type UserPetModelDO struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64     `gorm:"column:user_id;not null"`
+	ModelID   int64     `gorm:"column:model_id;not null"`
+	Coins     int32     `gorm:"column:coins;not null"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (UserPetModelDO) TableName() string { return "user_pet_models" }
+
+// PetLevelRepo 实现 biz.PetLevelRepo（GORM；内存模式下保存在进程内）
+
+type PetLevelRepo struct {
+	data *Data
+
+	mu       sync.Mutex
+	levels   map[int64]*PetLevelDO
+	logs     []*PetXPLogDO
+	unlocked map[int64][]int64
+}
+
+func NewPetLevelRepo(d *Data) *PetLevelRepo {
+	return &PetLevelRepo{data: d, levels: map[int64]*PetLevelDO{}, unlocked: map[int64][]int64{}}
+}
+
+func (r *PetLevelRepo) GetXP(ctx context.Context, petID int64) (int64, error) {
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if v, ok := r.levels[petID]; ok {
+			return v.XP, nil
+		}
+		return 0, nil
+	}
+	var row PetLevelDO
+	if err := r.data.db(ctx).Where("pet_id=?", petID).Take(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return row.XP, nil
+}
+
+func (r *PetLevelRepo) MaxXP(ctx context.Context, userID int64) (int64, error) {
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		var n int64
+		for _, v := range r.levels {
+			if v.UserID == userID && v.XP > n {
+				n = v.XP
+			}
+		}
+		return n, nil
+	}
+	var n int64
+	err := r.data.db(ctx).Model(&PetLevelDO{}).Select("COALESCE(MAX(xp), 0)").Where("user_id=?", userID).Scan(&n).Error
+	return n, err
+}
+
+// LockXP 先 INSERT IGNORE 保证行存在，再 SELECT ... FOR UPDATE（须在事务内调用）
+func (r *PetLevelRepo) LockXP(ctx context.Context, userID, petID int64) (int64, error) {
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		v, ok := r.levels[petID]
+		if !ok {
+			v = &PetLevelDO{PetID: petID, UserID: userID, UpdatedAt: time.Now()}
+			r.levels[petID] = v
+		}
+		return v.XP, nil
+	}
+	db := r.data.db(ctx)
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&PetLevelDO{PetID: petID, UserID: userID, UpdatedAt: time.Now()}).Error; err != nil {
+		return 0, err
+	}
+	var row PetLevelDO
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("pet_id=?", petID).Take(&row).Error; err != nil {
+		return 0, err
+	}
+	return row.XP, nil
+}
+
+func (r *PetLevelRepo) SumOnDate(ctx context.Context, userID int64, source, date string) (int32, error) {
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		var n int32
+		for _, v := range r.logs {
+			if v.UserID == userID && v.Source == source && v.XPDate == date {
+				n += v.XP
+			}
+		}
+		return n, nil
+	}
+	var n int64
+	err := r.data.db(ctx).Model(&PetXPLogDO{}).Select("COALESCE(SUM(xp), 0)").
+		Where("user_id=? AND source=? AND xp_date=?", userID, source, date).Scan(&n).Error
+	return int32(n), err
+}
+
+func (r *PetLevelRepo) AddXP(ctx context.Context, l *biz.PetXPLog) error {
+	row := &PetXPLogDO{UserID: l.UserID, PetID: l.PetID, Source: l.Source, XP: l.XP, XPDate: l.XPDate, CreatedAt: l.CreatedAt}
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		row.ID = int64(len(r.logs) + 1)
+		r.logs = append(r.logs, row)
+		if v, ok := r.levels[l.PetID]; ok {
+			v.XP += int64(l.XP)
+			v.UpdatedAt = time.Now()
+		}
+		l.ID = row.ID
+		return nil
+	}
+	db := r.data.db(ctx)
+	if err := db.Create(row).Error; err != nil {
+		return err
+	}
+	l.ID = row.ID
+	return db.Model(&PetLevelDO{}).Where("pet_id=?", l.PetID).
+		Updates(map[string]interface{}{"xp": gorm.Expr("xp + ?", l.XP), "updated_at": time.Now()}).Error
+}
+
+func (r *PetLevelRepo) UnlockedModels(ctx context.Context, userID int64) ([]int64, error) {
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return append([]int64(nil), r.unlocked[userID]...), nil
+	}
+	var ids []int64
+	err := r.data.db(ctx).Model(&UserPetModelDO{}).Where("user_id=?", userID).Pluck("model_id", &ids).Error
+	return ids, err
+}
+
+func (r *PetLevelRepo) CreateModelUnlock(ctx context.Context, u *biz.ModelUnlock) error {
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		for _, id := range r.unlocked[u.UserID] {
+			if id == u.ModelID {
+				return biz.ErrModelAlreadyUnlocked
+			}
+		}
+		r.unlocked[u.UserID] = append(r.unlocked[u.UserID], u.ModelID)
+		return nil
+	}
+	row := &UserPetModelDO{UserID: u.UserID, ModelID: u.ModelID, Coins: u.Coins, CreatedAt: u.CreatedAt}
+	if err := r.data.db(ctx).Create(row).Error; err != nil {
+		if isDuplicateKey(err) {
+			return biz.ErrModelAlreadyUnlocked
+		}
+		return err
+	}
+	return nil
+}
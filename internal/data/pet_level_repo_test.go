@@ -0,0 +1,154 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestPetLevelCurve(t *testing.T) {
+	for _, c := range []struct {
+		xp    int64
+		level int32
+	}{{0, 1}, {99, 1}, {100, 2}, {299, 2}, {300, 3}, {1000, 5}, {1 << 40, biz.MaxPetLevel}} {
+		if got := biz.PetLevelForXP(c.xp); got != c.level {
+			t.Fatalf("xp %d: want level %d, got %d", c.xp, c.level, got)
+		}
+	}
+	if l := biz.NewPetLevel(150); l.LevelXP != 100 || l.NextLevelXP != 300 {
+		t.Fatalf("progress: %+v", l)
+	}
+	if l := biz.NewPetLevel(1 << 40); l.NextLevelXP != 0 {
+		t.Fatalf("max level must not have next level: %+v", l)
+	}
+}
+
+func TestPetXPFromEvents(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, active_pet_id) VALUES (1, 'alice', 1), (2, 'bob', 0);
+INSERT INTO pets (id, user_id, name) VALUES (1, 1, 'Mimi'), (2, 1, 'Wangcai');
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	cfg := &conf.Rewards{PetXp: &conf.PetXP{Rules: []*conf.PetXPRule{
+		{Event: biz.EventPetChatted, Xp: 2, DailyCap: 3},
+		{Event: biz.EventItemUsed, Xp: 5},
+	}}}
+	uc := biz.NewPetLevelUsecase(NewPetLevelRepo(d), NewPetRepo(d), biz.NewWalletUsecase(NewWalletRepo(d), d, log.DefaultLogger), d, cfg, log.DefaultLogger)
+
+	// 聊天每次 2 点、每天上限 3 点：第二次只补足到上限，之后不再增加；未配置的事件不加经验
+	// 未指定宠物的事件记到当前宠物；每日上限按用户计算，不因宠物不同而重置
+	now := time.Now()
+	for _, e := range []*biz.ActivityEvent{
+		{Type: biz.EventPetChatted}, {Type: biz.EventPetChatted, PetID: 2}, {Type: biz.EventPetChatted, PetID: 2},
+		{Type: biz.EventItemUsed, PetID: 2}, {Type: biz.EventPostCreated},
+	} {
+		e.UserID, e.At = 1, now
+		if err := uc.Handle(ctx, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for petID, want := range map[int64]int64{1: 2, 2: 6} {
+		l, err := uc.Get(ctx, 1, petID)
+		if err != nil || l.XP != want || l.Level != 1 {
+			t.Fatalf("pet %d level: want xp %d, got %+v %v", petID, want, l, err)
+		}
+	}
+	if l, err := uc.Get(ctx, 1, 0); err != nil || l.XP != 2 {
+		t.Fatalf("active pet level: %+v %v", l, err)
+	}
+	var n int64
+	d.Gorm.Model(&PetXPLogDO{}).Where("user_id=1 AND pet_id=2").Count(&n)
+	if n != 2 {
+		t.Fatalf("want 2 xp logs for pet 2, got %d", n)
+	}
+	// 尚无宠物的用户不获得经验
+	if err := uc.Handle(ctx, &biz.ActivityEvent{Type: biz.EventItemUsed, UserID: 2, At: now}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.Get(ctx, 2, 0); !errors.Is(err, biz.ErrPetNotFound) {
+		t.Fatalf("want pet not found, got %v", err)
+	}
+	d.Gorm.Model(&PetLevelDO{}).Where("user_id=2").Count(&n)
+	if n != 0 {
+		t.Fatalf("user without pets must not get a level row, got %d", n)
+	}
+}
+
+func TestPetModelUnlock(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, coins) VALUES (1, 'alice', 100);
+INSERT INTO pet_models (id, name, path, type, is_default, unlock_level, unlock_coins) VALUES
+  (1, '默认', '/m/1.glb', 0, 1, 0, 0), (2, '粉', '/m/2.glb', 0, 0, 3, 0), (3, '蓝', '/m/3.glb', 0, 0, 0, 60), (4, '金', '/m/4.glb', 0, 0, 2, 30);
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	wallet := biz.NewWalletUsecase(NewWalletRepo(d), d, log.DefaultLogger)
+	levels := biz.NewPetLevelUsecase(NewPetLevelRepo(d), NewPetRepo(d), wallet, d, &conf.Rewards{}, log.DefaultLogger)
+	uc := biz.NewAvatarUsecase(NewAvatarRepo(d), biz.NewPetUsecase(NewPetRepo(d), NewAvatarRepo(d), levels, d), levels, nil, nil)
+
+	locked := func(viewer int64) []bool {
+		list, err := uc.GetModels(ctx, viewer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := make([]bool, 0, len(list))
+		for _, m := range list {
+			out = append(out, m.Locked)
+		}
+		return out
+	}
+	if got := locked(1); got[0] || !got[1] || !got[2] || !got[3] {
+		t.Fatalf("level 1: %v", got)
+	}
+	if err := uc.SetPetModel(ctx, 1, 2); !errors.Is(err, biz.ErrAvatarLocked) {
+		t.Fatalf("want locked, got %v", err)
+	}
+	// 等级不足时不能用金币解锁
+	if _, err := uc.UnlockPetModel(ctx, 1, 4); !errors.Is(err, biz.ErrAvatarLocked) {
+		t.Fatalf("want locked by level, got %v", err)
+	}
+	if coins, err := uc.UnlockPetModel(ctx, 1, 3); err != nil || coins != 40 {
+		t.Fatalf("unlock: %d %v", coins, err)
+	}
+	if coins, err := uc.UnlockPetModel(ctx, 1, 3); err != nil || coins != 40 {
+		t.Fatalf("unlock again must not charge: %d %v", coins, err)
+	}
+
+	// 任一宠物升到 3 级：等级解锁的模型对用户可用，需付费的模型仍锁定
+	d.Gorm.Create(&PetLevelDO{PetID: 1, UserID: 1, XP: 100})
+	d.Gorm.Create(&PetLevelDO{PetID: 2, UserID: 1, XP: 300})
+	if got := locked(1); got[1] || got[2] || !got[3] {
+		t.Fatalf("level 3: %v", got)
+	}
+	if _, err := uc.UnlockPetModel(ctx, 1, 4); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.UnlockPetModel(ctx, 1, 2); err != nil {
+		t.Fatalf("model without price: %v", err)
+	}
+	if got := locked(0); got[0] || !got[1] || !got[2] || !got[3] {
+		t.Fatalf("anonymous: %v", got)
+	}
+	if coins, _ := wallet.Balance(ctx, 1); coins != 10 {
+		t.Fatalf("balance: want 10, got %d", coins)
+	}
+	var n int64
+	d.Gorm.Model(&CoinTransactionDO{}).Where("reason=? AND ref_type=?", biz.CoinReasonModelUnlock, biz.CoinRefPetModel).Count(&n)
+	if n != 2 {
+		t.Fatalf("want 2 unlock ledger entries, got %d", n)
+	}
+	if _, err := uc.UnlockPetModel(ctx, 1, 99); !errors.Is(err, biz.ErrAvatarNotFound) {
+		t.Fatalf("want not found, got %v", err)
+	}
+}
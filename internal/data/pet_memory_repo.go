@@ -0,0 +1,241 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	aiclient "pet-angel/internal/ai"
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PetMemoryDO 映射 pet_memories 表（宠物的长期记忆：聊天摘要与事实）
+type PetMemoryDO struct {
+	ID            int64     `gorm:"column:id;primaryKey;autoIncrement"` // 记忆ID
+	UserID        int64     `gorm:"column:user_id;not null"`            // 用户ID
+	PetID         int64     `gorm:"column:pet_id;not null"`             // 宠物ID
+	Kind          string    `gorm:"column:kind;type:varchar(16)"`       // summary/fact
+	Content       string    `gorm:"column:content;type:text"`           // 内容
+	FromMessageID int64     `gorm:"column:from_message_id;not null"`    // 来源聊天消息范围（起）
+	ToMessageID   int64     `gorm:"column:to_message_id;not null"`      // 来源聊天消息范围（止）
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime"`   // 创建时间
+}
+
+func (PetMemoryDO) TableName() string { return "pet_memories" }
+
+func (m *PetMemoryDO) toBiz() *biz.PetMemory {
+	return &biz.PetMemory{
+		ID: m.ID, UserID: m.UserID, PetID: m.PetID, Kind: m.Kind, Content: m.Content,
+		FromMessageID: m.FromMessageID, ToMessageID: m.ToMessageID, CreatedAt: m.CreatedAt,
+	}
+}
+
+// PetMemoryCursorDO 映射 pet_memory_cursors 表（每只宠物已整理到的聊天消息）
+type PetMemoryCursorDO struct {
+	PetID       int64      `gorm:"column:pet_id;primaryKey"`   // 宠物ID
+	UserID      int64      `gorm:"column:user_id;not null"`    // 用户ID
+	MessageID   int64      `gorm:"column:message_id;not null"` // 已整理到的聊天消息ID
+	AttemptedAt *time.Time `gorm:"column:attempted_at"`        // 最近一次整理尝试时间
+	UpdatedAt   time.Time  `gorm:"column:updated_at"`          // 更新时间
+}
+
+func (PetMemoryCursorDO) TableName() string { return "pet_memory_cursors" }
+
+// PetMemoryRepo 实现 biz.PetMemoryRepo（GORM）
+
+type PetMemoryRepo struct{ data *Data }
+
+func NewPetMemoryRepo(d *Data) *PetMemoryRepo { return &PetMemoryRepo{data: d} }
+
+func (r *PetMemoryRepo) Create(ctx context.Context, mems []*biz.PetMemory) error {
+	rows := make([]*PetMemoryDO, 0, len(mems))
+	for _, m := range mems {
+		rows = append(rows, &PetMemoryDO{
+			UserID: m.UserID, PetID: m.PetID, Kind: m.Kind, Content: m.Content,
+			FromMessageID: m.FromMessageID, ToMessageID: m.ToMessageID,
+		})
+	}
+	if err := r.data.db(ctx).Create(&rows).Error; err != nil {
+		return err
+	}
+	for i, row := range rows {
+		mems[i].ID, mems[i].CreatedAt = row.ID, row.CreatedAt
+	}
+	return nil
+}
+
+func (r *PetMemoryRepo) List(ctx context.Context, userID, petID int64, kind string) ([]*biz.PetMemory, error) {
+	// 内存模式没有记忆表
+	if r.data.Gorm == nil {
+		return nil, nil
+	}
+	q := r.data.db(ctx).Where("user_id=?", userID)
+	if petID != 0 {
+		q = q.Where("pet_id=?", petID)
+	}
+	if kind != "" {
+		q = q.Where("kind=?", kind)
+	}
+	var rows []PetMemoryDO
+	if err := q.Order("id DESC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.PetMemory, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toBiz())
+	}
+	return out, nil
+}
+
+func (r *PetMemoryRepo) Delete(ctx context.Context, userID, id int64) error {
+	res := r.data.db(ctx).Where("id=? AND user_id=?", id, userID).Delete(&PetMemoryDO{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrMemoryNotFound
+	}
+	return nil
+}
+
+func (r *PetMemoryRepo) Cursor(ctx context.Context, petID int64) (int64, error) {
+	var row PetMemoryCursorDO
+	if err := r.data.db(ctx).Where("pet_id=?", petID).Take(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return row.MessageID, nil
+}
+
+func (r *PetMemoryRepo) SetCursor(ctx context.Context, userID, petID, prev, messageID int64) (bool, error) {
+	now := time.Now()
+	res := r.data.db(ctx).Model(&PetMemoryCursorDO{}).Where("pet_id=? AND message_id=?", petID, prev).
+		Updates(map[string]interface{}{"message_id": messageID, "updated_at": now})
+	if res.Error != nil || res.RowsAffected > 0 || prev != 0 {
+		return res.RowsAffected > 0, res.Error
+	}
+	// 尚无游标：插入，已被其他实例抢先插入时不覆盖
+	res = r.data.db(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&PetMemoryCursorDO{PetID: petID, UserID: userID, MessageID: messageID, UpdatedAt: now})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *PetMemoryRepo) Attempt(ctx context.Context, userID, petID int64, at time.Time) error {
+	return r.data.db(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "pet_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"attempted_at"}),
+	}).Create(&PetMemoryCursorDO{PetID: petID, UserID: userID, AttemptedAt: &at, UpdatedAt: at}).Error
+}
+
+func (r *PetMemoryRepo) ListTargets(ctx context.Context, minMessages, limit int) ([]*biz.MemoryTarget, error) {
+	// 内存模式没有聊天记录，整理任务空转
+	if r.data.Gorm == nil {
+		return nil, nil
+	}
+	var out []*biz.MemoryTarget
+	err := r.data.db(ctx).Table("messages AS m").
+		Select("m.user_id, m.pet_id").
+		Joins("LEFT JOIN pet_memory_cursors c ON c.pet_id=m.pet_id").
+		Where("m.message_type=0 AND m.pet_id>0 AND m.id>COALESCE(c.message_id,0)").
+		Group("m.user_id, m.pet_id").
+		Having("COUNT(*)>=?", minMessages).
+		// 从未尝试过的（NULL）排最前，其余按最近一次尝试时间，失败的宠物不会饿死其他宠物
+		Order("MAX(c.attempted_at), m.pet_id").
+		Limit(limit).
+		Scan(&out).Error
+	return out, err
+}
+
+func (r *PetMemoryRepo) ListMessagesAfter(ctx context.Context, userID, petID, afterID int64, limit int) ([]*biz.ChatMsg, error) {
+	var rows []MessageDO
+	if err := r.data.db(ctx).
+		Where("user_id=? AND pet_id=? AND message_type=0 AND id>?", userID, petID, afterID).
+		Order("id").Limit(limit).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.ChatMsg, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toChatMsg())
+	}
+	return out, nil
+}
+
+func (r *PetMemoryRepo) PruneFacts(ctx context.Context, petID int64, keep int) error {
+	// 第 keep+1 新的事实及更早的删除（MySQL 不支持 IN 子查询中的 LIMIT，先取边界 ID）
+	var ids []int64
+	if err := r.data.db(ctx).Model(&PetMemoryDO{}).Where("pet_id=? AND kind=?", petID, biz.MemoryFact).
+		Order("id DESC").Offset(keep).Limit(1).Pluck("id", &ids).Error; err != nil || len(ids) == 0 {
+		return err
+	}
+	return r.data.db(ctx).Where("pet_id=? AND kind=? AND id<=?", petID, biz.MemoryFact, ids[0]).Delete(&PetMemoryDO{}).Error
+}
+
+// MemorySummarizer 实现 biz.MemorySummarizer（调用默认 AI 客户端）
+type MemorySummarizer struct{}
+
+func NewMemorySummarizer() *MemorySummarizer { return &MemorySummarizer{} }
+
+const memorySystemPrompt = `你负责整理宠物与主人的聊天记录，帮宠物记住重要的事。只输出一个 JSON 对象，不要输出其他内容：
+{"summary":"用不超过100字概括这段聊天","facts":["值得长期记住的关于主人的事实，如名字、生日、家人、喜好、近期的安排和经历，每条不超过30字"]}
+已知事实不要重复提取；没有新事实时 facts 为空数组。`
+
+func (s *MemorySummarizer) Summarize(ctx context.Context, pet *biz.Pet, known []string, msgs []*biz.ChatMsg) (*biz.MemoryDigest, error) {
+	c := aiclient.Default()
+	if c == nil {
+		aiclient.SetClient(aiclient.NewClient(aiclient.Config{}))
+		c = aiclient.Default()
+	}
+	name := "宠物"
+	if pet != nil && pet.Name != "" {
+		name = pet.Name
+	}
+	var b strings.Builder
+	if len(known) > 0 {
+		b.WriteString("已知事实：\n")
+		for _, f := range known {
+			b.WriteString("- " + f + "\n")
+		}
+	}
+	b.WriteString("聊天记录：\n")
+	for _, m := range msgs {
+		who := name
+		if m.Sender == 0 {
+			who = "主人"
+		}
+		fmt.Fprintf(&b, "%s：%s\n", who, m.Content)
+	}
+	reply, err := c.Chat(ctx, aiclient.Prompt(memorySystemPrompt, b.String()))
+	if err != nil {
+		return nil, err
+	}
+	return parseMemoryDigest(reply), nil
+}
+
+// parseMemoryDigest 解析模型输出中的 JSON（可能带有思考过程或代码块）；解析失败时整段作为摘要
+func parseMemoryDigest(reply string) *biz.MemoryDigest {
+	var out struct {
+		Summary string   `json:"summary"`
+		Facts   []string `json:"facts"`
+	}
+	if k := strings.LastIndex(reply, "</think>"); k >= 0 {
+		reply = reply[k+len("</think>"):]
+	}
+	if i, j := strings.Index(reply, "{"), strings.LastIndex(reply, "}"); i >= 0 && j > i {
+		if json.Unmarshal([]byte(reply[i:j+1]), &out) == nil {
+			return &biz.MemoryDigest{Summary: out.Summary, Facts: out.Facts}
+		}
+	}
+	summary := []rune(strings.TrimSpace(reply))
+	if len(summary) > 200 {
+		summary = summary[:200]
+	}
+	return &biz.MemoryDigest{Summary: string(summary)}
+}
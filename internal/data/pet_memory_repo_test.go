@@ -0,0 +1,237 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeSummarizer 记录每次整理收到的聊天与已知事实
+type fakeSummarizer struct {
+	calls int
+	known []string
+	msgs  []*biz.ChatMsg
+}
+
+func (f *fakeSummarizer) Summarize(ctx context.Context, pet *biz.Pet, known []string, msgs []*biz.ChatMsg) (*biz.MemoryDigest, error) {
+	f.calls++
+	f.known, f.msgs = known, msgs
+	return &biz.MemoryDigest{Summary: fmt.Sprintf("第%d段", f.calls), Facts: []string{"主人叫小明", " 主人周五有考试 ", ""}}, nil
+}
+
+func TestPetMemories(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, active_pet_id) VALUES (1, 'alice', 1), (2, 'bob', 3);
+INSERT INTO pets (id, user_id, name) VALUES (1, 1, '咪咪'), (2, 1, '旺财'), (3, 2, 'Bob cat');
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	chat := func(userID, petID int64, n int) {
+		for i := 0; i < n; i++ {
+			d.Gorm.Create(&MessageDO{UserID: userID, PetID: petID, Sender: int32(i % 2), Content: fmt.Sprintf("m%d", i)})
+		}
+	}
+	chat(1, 1, 45)
+	chat(2, 3, 5)
+	// 小纸条与提醒不参与整理
+	d.Gorm.Create(&MessageDO{UserID: 1, PetID: 1, Sender: 1, MessageType: biz.MessageTypeReminder, Content: "reminder"})
+
+	pets := biz.NewPetUsecase(NewPetRepo(d), NewAvatarRepo(d), nil, d)
+	repo := NewPetMemoryRepo(d)
+	sum := &fakeSummarizer{}
+	uc := biz.NewPetMemoryUsecase(repo, sum, pets, d, log.DefaultLogger)
+
+	// 只有最近上下文窗口之外攒够一段的宠物才整理，每次整理最早的一段
+	n, err := uc.Consolidate(ctx)
+	if err != nil || n != 1 || len(sum.msgs) != biz.MemoryChunkMessages || sum.msgs[0].Content != "m0" || len(sum.known) != 0 {
+		t.Fatalf("consolidate: %d %v %+v", n, err, sum)
+	}
+	list, err := uc.List(ctx, 1, 0, "")
+	if err != nil || len(list) != 3 || list[2].Kind != biz.MemorySummary || list[1].Content != "主人叫小明" || list[0].Content != "主人周五有考试" {
+		t.Fatalf("list: %+v %v", list, err)
+	}
+	if list[0].FromMessageID != sum.msgs[0].ID || list[0].ToMessageID != sum.msgs[19].ID {
+		t.Fatalf("source range: %+v", list[0])
+	}
+	if n, err := uc.Consolidate(ctx); err != nil || n != 0 {
+		t.Fatalf("nothing new to consolidate: %d %v", n, err)
+	}
+
+	// 再攒一段：已知事实交给模型，重复的事实不再写入
+	chat(1, 1, 15)
+	if n, err := uc.Consolidate(ctx); err != nil || n != 1 || len(sum.known) != 2 || sum.msgs[0].Content != "m20" {
+		t.Fatalf("second round: %d %v %+v", n, err, sum)
+	}
+	if facts, _ := uc.List(ctx, 1, 1, biz.MemoryFact); len(facts) != 2 {
+		t.Fatalf("facts must be deduplicated: %+v", facts)
+	}
+	if list, _ := uc.List(ctx, 1, 2, ""); len(list) != 0 {
+		t.Fatalf("other pet: %+v", list)
+	}
+	if _, err := uc.List(ctx, 1, 3, ""); err == nil {
+		t.Fatal("must not list memories of another user's pet")
+	}
+
+	// 删除：只能删自己的；删除摘要后同一段聊天不会被重新整理
+	if err := uc.Delete(ctx, 2, list[0].ID); !errors.Is(err, biz.ErrMemoryNotFound) {
+		t.Fatalf("want not found, got %v", err)
+	}
+	summaries, _ := uc.List(ctx, 1, 1, biz.MemorySummary)
+	for _, m := range summaries {
+		if err := uc.Delete(ctx, 1, m.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n, _ := uc.Consolidate(ctx); n != 0 || sum.calls != 2 {
+		t.Fatalf("deleted memories must not be rebuilt: %d %d", n, sum.calls)
+	}
+
+	// 事实超出上限时删除最早的
+	if err := repo.PruneFacts(ctx, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	if facts, _ := uc.List(ctx, 1, 1, biz.MemoryFact); len(facts) != 1 || facts[0].Content != "主人周五有考试" {
+		t.Fatalf("prune: %+v", facts)
+	}
+
+	// 聊天时相关记忆进入系统提示
+	prompts := biz.NewPromptUsecase(NewPromptRepo(d), NewUserRepo(d), NewAvatarRepo(d), pets, biz.NewPetStateUsecase(NewPetStateRepo(d), NewPetRepo(d), d), uc, d, log.DefaultLogger)
+	pet, _ := pets.Active(ctx, 1)
+	if s := prompts.ChatPrompt(ctx, 1, pet, "考试好难"); !strings.Contains(s, "主人周五有考试") {
+		t.Fatalf("system prompt: %s", s)
+	}
+	if s, err := uc.Prompt(ctx, 1, pet, "考试"); err != nil || !strings.Contains(s, "主人周五有考试") {
+		t.Fatalf("prompt: %q %v", s, err)
+	}
+	if s, _ := uc.Prompt(ctx, 1, nil, "考试"); s != "" {
+		t.Fatalf("no pet, no memories: %q", s)
+	}
+}
+
+// racingSummarizer 整理期间模拟另一个实例抢先推进了游标
+type racingSummarizer struct {
+	fakeSummarizer
+	repo *PetMemoryRepo
+}
+
+func (f *racingSummarizer) Summarize(ctx context.Context, pet *biz.Pet, known []string, msgs []*biz.ChatMsg) (*biz.MemoryDigest, error) {
+	if _, err := f.repo.SetCursor(ctx, pet.UserID, pet.ID, 0, msgs[len(msgs)-1].ID); err != nil {
+		return nil, err
+	}
+	return f.fakeSummarizer.Summarize(ctx, pet, known, msgs)
+}
+
+func TestPetMemoryTargetsAndCursor(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, active_pet_id) VALUES (1, 'alice', 1);
+INSERT INTO pets (id, user_id, name) VALUES (1, 1, '咪咪'), (2, 1, '旺财');
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	for _, petID := range []int64{1, 2} {
+		for i := 0; i < 3; i++ {
+			d.Gorm.Create(&MessageDO{UserID: 1, PetID: petID, Content: fmt.Sprintf("m%d", i)})
+		}
+	}
+	repo := NewPetMemoryRepo(d)
+
+	// 最久未尝试的宠物在前：失败的宠物不会一直占住名额
+	next := func() int64 {
+		list, err := repo.ListTargets(ctx, 3, 1)
+		if err != nil || len(list) != 1 {
+			t.Fatalf("targets: %+v %v", list, err)
+		}
+		return list[0].PetID
+	}
+	now := time.Now()
+	if id := next(); id != 1 {
+		t.Fatalf("first target: %d", id)
+	}
+	if err := repo.Attempt(ctx, 1, 1, now); err != nil {
+		t.Fatal(err)
+	}
+	if id := next(); id != 2 {
+		t.Fatalf("attempted pet must move to the back: %d", id)
+	}
+	if err := repo.Attempt(ctx, 1, 2, now.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if id := next(); id != 1 {
+		t.Fatalf("least recently attempted first: %d", id)
+	}
+
+	// 游标只在仍为 prev 时推进；尚无游标时只有一个实例能插入
+	if ok, err := repo.SetCursor(ctx, 1, 1, 0, 2); err != nil || !ok {
+		t.Fatalf("advance: %v %v", ok, err)
+	}
+	if ok, err := repo.SetCursor(ctx, 1, 1, 0, 3); err != nil || ok {
+		t.Fatalf("stale prev must not advance: %v %v", ok, err)
+	}
+	if c, _ := repo.Cursor(ctx, 1); c != 2 {
+		t.Fatalf("cursor: %d", c)
+	}
+	if ok, err := repo.SetCursor(ctx, 1, 3, 0, 5); err != nil || !ok {
+		t.Fatalf("insert: %v %v", ok, err)
+	}
+	if ok, err := repo.SetCursor(ctx, 1, 3, 0, 6); err != nil || ok {
+		t.Fatalf("concurrent insert must not advance: %v %v", ok, err)
+	}
+
+	// 整理期间游标被推进时放弃本次结果，不写入重复的记忆
+	d.Gorm.Exec("DELETE FROM pet_memory_cursors")
+	for i := 0; i < biz.ChatHistoryTurns*2+biz.MemoryChunkMessages; i++ {
+		d.Gorm.Create(&MessageDO{UserID: 1, PetID: 2, Content: fmt.Sprintf("n%d", i)})
+	}
+	pets := biz.NewPetUsecase(NewPetRepo(d), NewAvatarRepo(d), nil, d)
+	racer := &racingSummarizer{repo: repo}
+	uc := biz.NewPetMemoryUsecase(repo, racer, pets, d, log.DefaultLogger)
+	if _, err := uc.Consolidate(ctx); err != nil || racer.calls != 1 {
+		t.Fatalf("consolidate: %v %d", err, racer.calls)
+	}
+	if list, _ := uc.List(ctx, 1, 2, ""); len(list) != 0 {
+		t.Fatalf("raced consolidation must not write memories: %+v", list)
+	}
+}
+
+func TestMemoryPrompt(t *testing.T) {
+	list := []*biz.PetMemory{
+		{ID: 5, Kind: biz.MemoryFact, Content: "主人喜欢喝奶茶"},
+		{ID: 4, Kind: biz.MemorySummary, Content: "聊了周末去公园"},
+		{ID: 3, Kind: biz.MemoryFact, Content: "主人周五有考试"},
+		{ID: 2, Kind: biz.MemorySummary, Content: "聊了新买的猫爬架"},
+		{ID: 1, Kind: biz.MemorySummary, Content: "最早的摘要"},
+	}
+	// 与本轮输入相关的事实排在前面，摘要按时间顺序，只取最近两段
+	s := biz.MemoryPrompt(list, "明天考试了", 500)
+	if s != "你记得关于主人的这些事：主人周五有考试；主人喜欢喝奶茶。你们之前聊过：聊了新买的猫爬架 聊了周末去公园。" {
+		t.Fatalf("prompt: %s", s)
+	}
+	// 预算不足时放不下的记忆跳过
+	if s := biz.MemoryPrompt(list, "考试", biz.EstimateTokens("聊了周末去公园")+biz.EstimateTokens("主人周五有考试")); s != "你记得关于主人的这些事：主人周五有考试。你们之前聊过：聊了周末去公园。" {
+		t.Fatalf("budget: %s", s)
+	}
+	if s := biz.MemoryPrompt(nil, "hi", 500); s != "" {
+		t.Fatalf("empty: %q", s)
+	}
+}
+
+func TestParseMemoryDigest(t *testing.T) {
+	d := parseMemoryDigest("<think>先想想 {x}</think>\n```json\n{\"summary\":\"聊了考试\",\"facts\":[\"主人叫小明\"]}\n```")
+	if d.Summary != "聊了考试" || len(d.Facts) != 1 || d.Facts[0] != "主人叫小明" {
+		t.Fatalf("json: %+v", d)
+	}
+	if d := parseMemoryDigest(" 只是一段话 "); d.Summary != "只是一段话" || len(d.Facts) != 0 {
+		t.Fatalf("plain: %+v", d)
+	}
+}
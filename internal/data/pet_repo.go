@@ -0,0 +1,184 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// PetDO 映射 pets 表（用户的宠物档案；当前宠物由 users.active_pet_id 指向）
+
+type PetDO struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64     `gorm:"column:user_id;not null"`
+	Name      string    `gorm:"column:name;type:varchar(50);not null"`
+	Avatar    string    `gorm:"column:avatar;type:varchar(255);not null"`
+	Sex       int32     `gorm:"column:sex;not null"`
+	Kind      string    `gorm:"column:kind;type:varchar(50);not null"`
+	Weight    int32     `gorm:"column:weight;not null"`
+	Hobby     string    `gorm:"column:hobby;type:varchar(255);not null"`
+	ModelID   int64     `gorm:"column:model_id;not null"`
+	ModelURL  string    `gorm:"column:model_url;type:varchar(255);not null"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (PetDO) TableName() string { return "pets" }
+
+func (p *PetDO) toBiz() *biz.Pet {
+	return &biz.Pet{
+		ID: p.ID, UserID: p.UserID, Name: p.Name, Avatar: p.Avatar, Sex: p.Sex, Kind: p.Kind, Weight: p.Weight, Hobby: p.Hobby,
+		ModelID: p.ModelID, ModelURL: p.ModelURL, CreatedAt: p.CreatedAt, UpdatedAt: p.UpdatedAt,
+	}
+}
+
+func petDO(p *biz.Pet) *PetDO {
+	return &PetDO{
+		ID: p.ID, UserID: p.UserID, Name: p.Name, Avatar: p.Avatar, Sex: p.Sex, Kind: p.Kind, Weight: p.Weight, Hobby: p.Hobby,
+		ModelID: p.ModelID, ModelURL: p.ModelURL, CreatedAt: p.CreatedAt, UpdatedAt: p.UpdatedAt,
+	}
+}
+
+// PetRepo 实现 biz.PetRepo（GORM；内存模式下与内存用户共用 Data 中的存储）
+
+type PetRepo struct{ data *Data }
+
+func NewPetRepo(d *Data) *PetRepo { return &PetRepo{data: d} }
+
+func (r *PetRepo) List(ctx context.Context, userID int64) ([]*biz.Pet, error) {
+	if r.data.Gorm == nil {
+		r.data.mu.RLock()
+		defer r.data.mu.RUnlock()
+		out := []*biz.Pet{}
+		for _, p := range r.data.petByID {
+			if p.UserID == userID {
+				out = append(out, p.toBiz())
+			}
+		}
+		sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+		return out, nil
+	}
+	var rows []PetDO
+	if err := r.data.db(ctx).Where("user_id=?", userID).Order("id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.Pet, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toBiz())
+	}
+	return out, nil
+}
+
+func (r *PetRepo) Get(ctx context.Context, userID, petID int64) (*biz.Pet, error) {
+	if r.data.Gorm == nil {
+		r.data.mu.RLock()
+		defer r.data.mu.RUnlock()
+		if p, ok := r.data.petByID[petID]; ok && p.UserID == userID {
+			return p.toBiz(), nil
+		}
+		return nil, biz.ErrPetNotFound
+	}
+	var row PetDO
+	if err := r.data.db(ctx).Where("id=? AND user_id=?", petID, userID).Take(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrPetNotFound
+		}
+		return nil, err
+	}
+	return row.toBiz(), nil
+}
+
+func (r *PetRepo) Active(ctx context.Context, userID int64) (*biz.Pet, error) {
+	if r.data.Gorm == nil {
+		r.data.mu.RLock()
+		defer r.data.mu.RUnlock()
+		if p := r.data.activePet(userID); p != nil {
+			return p.toBiz(), nil
+		}
+		return nil, nil
+	}
+	var row PetDO
+	if err := r.data.db(ctx).
+		Joins("JOIN users ON users.active_pet_id=pets.id").
+		Where("users.id=?", userID).
+		Take(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return row.toBiz(), nil
+}
+
+func (r *PetRepo) Create(ctx context.Context, p *biz.Pet) error {
+	row := petDO(p)
+	if r.data.Gorm == nil {
+		r.data.mu.Lock()
+		defer r.data.mu.Unlock()
+		r.data.createPet(row)
+		p.ID = row.ID
+		return nil
+	}
+	if err := r.data.db(ctx).Create(row).Error; err != nil {
+		return err
+	}
+	p.ID = row.ID
+	return nil
+}
+
+func (r *PetRepo) Update(ctx context.Context, p *biz.Pet) error {
+	row := petDO(p)
+	if r.data.Gorm == nil {
+		r.data.mu.Lock()
+		defer r.data.mu.Unlock()
+		old, ok := r.data.petByID[p.ID]
+		if !ok || old.UserID != p.UserID {
+			return biz.ErrPetNotFound
+		}
+		row.CreatedAt = old.CreatedAt
+		r.data.petByID[p.ID] = row
+		return nil
+	}
+	return r.data.db(ctx).Model(row).Where("user_id=?", p.UserID).
+		Select("name", "avatar", "sex", "kind", "weight", "hobby", "model_id", "model_url", "updated_at").
+		Updates(row).Error
+}
+
+// Delete 删除宠物及其聊天记录（小纸条不属于某只宠物，保留）
+func (r *PetRepo) Delete(ctx context.Context, userID, petID int64) error {
+	if r.data.Gorm == nil {
+		r.data.mu.Lock()
+		defer r.data.mu.Unlock()
+		if p, ok := r.data.petByID[petID]; ok && p.UserID == userID {
+			r.data.deletePet(petID)
+		}
+		return nil
+	}
+	db := r.data.db(ctx)
+	// 该宠物的聊天记录、健康档案、提醒、记忆、状态与等级一并删除
+	for _, m := range []interface{}{&MessageDO{}, &PetWeightDO{}, &PetCareRecordDO{}, &PetVetVisitDO{}, &ReminderDO{}, &PetMemoryDO{}, &PetMemoryCursorDO{}, &PetStateDO{}, &PetLevelDO{}} {
+		if err := db.Where("user_id=? AND pet_id=?", userID, petID).Delete(m).Error; err != nil {
+			return err
+		}
+	}
+	return db.Where("id=? AND user_id=?", petID, userID).Delete(&PetDO{}).Error
+}
+
+func (r *PetRepo) SetActive(ctx context.Context, userID, petID int64) error {
+	if r.data.Gorm == nil {
+		r.data.mu.Lock()
+		defer r.data.mu.Unlock()
+		u, ok := r.data.userByID[userID]
+		if !ok {
+			return biz.ErrUserNotFound
+		}
+		u.ActivePetID = petID
+		return nil
+	}
+	return r.data.db(ctx).Model(&UserModel{}).Where("id=?", userID).Update("active_pet_id", petID).Error
+}
@@ -0,0 +1,147 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestPets(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, nickname) VALUES (1, 'alice', 'Alice'), (2, 'bob', 'Bob');
+INSERT INTO pet_models (id, name, path, type, is_default, unlock_level, unlock_coins) VALUES (1, '猫', '/m/cat.glb', 0, 1, 0, 0), (2, '金', '/m/gold.glb', 0, 0, 5, 0);
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	wallet := biz.NewWalletUsecase(NewWalletRepo(d), d, log.DefaultLogger)
+	levels := biz.NewPetLevelUsecase(NewPetLevelRepo(d), NewPetRepo(d), wallet, d, &conf.Rewards{}, log.DefaultLogger)
+	uc := biz.NewPetUsecase(NewPetRepo(d), NewAvatarRepo(d), levels, d)
+	avatars := biz.NewAvatarUsecase(NewAvatarRepo(d), uc, levels, nil, nil)
+	msgs := biz.NewMessageUsecase(NewMessageRepo(d), uc, wallet, d)
+	users := NewAuthRepo(d)
+
+	if _, err := uc.Create(ctx, &biz.Pet{UserID: 1}, false); err == nil {
+		t.Fatal("want name required")
+	}
+	if _, err := uc.Create(ctx, &biz.Pet{UserID: 1, Name: "x", ModelID: 2}, false); !errors.Is(err, biz.ErrAvatarLocked) {
+		t.Fatalf("want locked model, got %v", err)
+	}
+	// 第一只宠物自动成为当前宠物
+	cat, err := uc.Create(ctx, &biz.Pet{UserID: 1, Name: "咪咪", Kind: "cat", Hobby: "晒太阳", ModelID: 1}, false)
+	if err != nil || !cat.Active || cat.ModelURL != "/m/cat.glb" {
+		t.Fatalf("create cat: %+v %v", cat, err)
+	}
+	dog, err := uc.Create(ctx, &biz.Pet{UserID: 1, Name: "旺财", Kind: "dog", Weight: 12}, false)
+	if err != nil || dog.Active || dog.ModelURL != biz.DefaultPetModelURL {
+		t.Fatalf("create dog: %+v %v", dog, err)
+	}
+	list, err := uc.List(ctx, 1)
+	if err != nil || len(list) != 2 || !list[0].Active || list[1].Active {
+		t.Fatalf("list: %+v %v", list, err)
+	}
+
+	// GetUserInfo 返回当前宠物的资料
+	u, err := users.GetByID(ctx, 1)
+	if err != nil || u.ActivePetID != cat.ID || u.PetName != "咪咪" || u.Kind != "cat" || u.ModelURL != "/m/cat.glb" {
+		t.Fatalf("user info: %+v %v", u, err)
+	}
+
+	// 聊天记录按宠物隔离，小纸条对所有宠物可见
+	if _, err := avatars.SaveUserMessage(ctx, 1, cat, "喵"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := avatars.SaveUserMessage(ctx, 1, dog, "汪"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewMessageRepo(d).CreateLockedNote(ctx, 1, 20, "note"); err != nil {
+		t.Fatal(err)
+	}
+	if total, page, err := msgs.GetList(ctx, 1, 0, false, 1, 20); err != nil || total != 2 || page[1].Content != "喵" {
+		t.Fatalf("active pet chat: %d %+v %v", total, page, err)
+	}
+	if total, page, err := msgs.GetList(ctx, 1, dog.ID, false, 1, 20); err != nil || total != 2 || page[1].Content != "汪" || page[1].PetID != dog.ID {
+		t.Fatalf("dog chat: %d %+v %v", total, page, err)
+	}
+	if _, _, err := msgs.GetList(ctx, 2, dog.ID, false, 1, 20); !errors.Is(err, biz.ErrPetNotFound) {
+		t.Fatalf("other user's pet: want not found, got %v", err)
+	}
+
+	// 切换当前宠物
+	if _, err := uc.SetActive(ctx, 2, dog.ID); !errors.Is(err, biz.ErrPetNotFound) {
+		t.Fatalf("want not found, got %v", err)
+	}
+	if _, err := uc.SetActive(ctx, 1, dog.ID); err != nil {
+		t.Fatal(err)
+	}
+	if u, _ = users.GetByID(ctx, 1); u.PetName != "旺财" || u.Weight != 12 {
+		t.Fatalf("user info after switch: %+v", u)
+	}
+	if p, err := uc.Update(ctx, &biz.Pet{ID: dog.ID, UserID: 1, Hobby: "捡球"}); err != nil || p.Name != "旺财" || p.Hobby != "捡球" || !p.Active {
+		t.Fatalf("update: %+v %v", p, err)
+	}
+	if _, err := uc.Update(ctx, &biz.Pet{ID: dog.ID, UserID: 1, Weight: 5000}); err == nil {
+		t.Fatal("want invalid weight")
+	}
+
+	// 删除当前宠物：切换到另一只，删除的宠物的聊天一并删除
+	active, err := uc.Delete(ctx, 1, dog.ID)
+	if err != nil || active != cat.ID {
+		t.Fatalf("delete: %d %v", active, err)
+	}
+	var n int64
+	d.Gorm.Model(&MessageDO{}).Where("user_id=1").Count(&n)
+	if n != 2 {
+		t.Fatalf("want cat chat and note left, got %d messages", n)
+	}
+	if active, err = uc.Delete(ctx, 1, cat.ID); err != nil || active != 0 {
+		t.Fatalf("delete last: %d %v", active, err)
+	}
+	if u, _ = users.GetByID(ctx, 1); u.ActivePetID != 0 || u.PetName != "" {
+		t.Fatalf("user info without pets: %+v", u)
+	}
+
+	for i := 0; i < biz.MaxPetsPerUser; i++ {
+		if _, err := uc.Create(ctx, &biz.Pet{UserID: 2, Name: "p"}, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := uc.Create(ctx, &biz.Pet{UserID: 2, Name: "p"}, false); !errors.Is(err, biz.ErrPetLimitReached) {
+		t.Fatalf("want limit, got %v", err)
+	}
+}
+
+// 内存模式：注册创建第一只宠物，UpdateUserInfo 的宠物字段写入当前宠物
+func TestUpdateUserInfoWritesActivePet(t *testing.T) {
+	ctx := context.Background()
+	m := newMemoryAuth(t, &conf.Auth{JwtSecret: "s"})
+	u, _, err := m.uc.Register(ctx, "alice", "passw0rd", "", biz.ClientInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pets := biz.NewPetUsecase(NewPetRepo(m.data), NewAvatarRepo(m.data), nil, m.data)
+	first, err := pets.Active(ctx, u.Id)
+	if err != nil || first == nil {
+		t.Fatalf("register must create the first pet: %+v %v", first, err)
+	}
+	second, err := pets.Create(ctx, &biz.Pet{UserID: u.Id, Name: "旺财"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.uc.UpdateUserInfo(ctx, &biz.User{Id: u.Id, PetName: "大黄", Hobby: "捡球"}); err != nil {
+		t.Fatal(err)
+	}
+	info, err := m.uc.GetUserInfo(ctx, u.Id)
+	if err != nil || info.ActivePetID != second.ID || info.PetName != "大黄" || info.Hobby != "捡球" {
+		t.Fatalf("user info: %+v %v", info, err)
+	}
+	if p, _ := NewPetRepo(m.data).Get(ctx, u.Id, first.ID); p.Name == "大黄" {
+		t.Fatal("inactive pet must not change")
+	}
+}
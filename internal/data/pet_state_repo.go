@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PetStateDO 映射 pet_states 表（每只宠物一行，保存 updated_at 时刻的属性值）
+
+type PetStateDO struct {
+	PetID       int64     `gorm:"column:pet_id;primaryKey;autoIncrement:false"`
+	UserID      int64     `gorm:"column:user_id;not null"`
+	Hunger      float64   `gorm:"column:hunger;not null"`
+	Mood        float64   `gorm:"column:mood;not null"`
+	Energy      float64   `gorm:"column:energy;not null"`
+	Cleanliness float64   `gorm:"column:cleanliness;not null"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime:false"`
+}
+
+func (PetStateDO) TableName() string { return "pet_states" }
+
+func (p *PetStateDO) toBiz() *biz.PetState {
+	return &biz.PetState{PetID: p.PetID, UserID: p.UserID, Hunger: p.Hunger, Mood: p.Mood, Energy: p.Energy, Cleanliness: p.Cleanliness, UpdatedAt: p.UpdatedAt}
+}
+
+func petStateDO(s *biz.PetState) *PetStateDO {
+	return &PetStateDO{PetID: s.PetID, UserID: s.UserID, Hunger: s.Hunger, Mood: s.Mood, Energy: s.Energy, Cleanliness: s.Cleanliness, UpdatedAt: s.UpdatedAt}
+}
+
+// PetStateRepo 实现 biz.PetStateRepo（GORM；内存模式下保存在 Data 中，随宠物/账号一并删除）
+
+type PetStateRepo struct {
+	data *Data
+}
+
+func NewPetStateRepo(d *Data) *PetStateRepo {
+	return &PetStateRepo{data: d}
+}
+
+func (r *PetStateRepo) Get(ctx context.Context, petID int64) (*biz.PetState, error) {
+	if r.data.Gorm == nil {
+		r.data.mu.RLock()
+		defer r.data.mu.RUnlock()
+		if v, ok := r.data.petStateByPet[petID]; ok {
+			return v.toBiz(), nil
+		}
+		return nil, nil
+	}
+	var row PetStateDO
+	if err := r.data.db(ctx).Where("pet_id=?", petID).Take(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return row.toBiz(), nil
+}
+
+func (r *PetStateRepo) Ensure(ctx context.Context, s *biz.PetState) error {
+	if r.data.Gorm == nil {
+		r.data.mu.Lock()
+		defer r.data.mu.Unlock()
+		if _, ok := r.data.petStateByPet[s.PetID]; !ok {
+			r.data.petStateByPet[s.PetID] = petStateDO(s)
+		}
+		return nil
+	}
+	return r.data.db(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(petStateDO(s)).Error
+}
+
+// GetForUpdate SELECT ... FOR UPDATE（须在事务内调用）
+func (r *PetStateRepo) GetForUpdate(ctx context.Context, petID int64) (*biz.PetState, error) {
+	if r.data.Gorm == nil {
+		return r.Get(ctx, petID)
+	}
+	var row PetStateDO
+	if err := r.data.db(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("pet_id=?", petID).Take(&row).Error; err != nil {
+		return nil, err
+	}
+	return row.toBiz(), nil
+}
+
+func (r *PetStateRepo) Save(ctx context.Context, s *biz.PetState) error {
+	if r.data.Gorm == nil {
+		r.data.mu.Lock()
+		defer r.data.mu.Unlock()
+		r.data.petStateByPet[s.PetID] = petStateDO(s)
+		return nil
+	}
+	return r.data.db(ctx).Save(petStateDO(s)).Error
+}
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestPetStateDecayAndEffects(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	uc := biz.NewPetStateUsecase(NewPetStateRepo(d), NewPetRepo(d), d)
+
+	// 尚无宠物
+	if _, err := uc.Get(ctx, 1, 0); !errors.Is(err, biz.ErrPetNotFound) {
+		t.Fatalf("want pet not found, got %v", err)
+	}
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, active_pet_id) VALUES (1, 'alice', 1), (2, 'bob', 3);
+INSERT INTO pets (id, user_id, name) VALUES (1, 1, 'Mimi'), (2, 1, 'Wangcai'), (3, 2, 'Bobby');
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// 尚无记录：返回初始状态且不落库
+	s, err := uc.Get(ctx, 1, 0)
+	if err != nil || s.PetID != 1 || s.Hunger != 80 || s.Cleanliness != 80 {
+		t.Fatalf("initial: %+v %v", s, err)
+	}
+	var n int64
+	d.Gorm.Model(&PetStateDO{}).Count(&n)
+	if n != 0 {
+		t.Fatalf("get must not create a row, got %d", n)
+	}
+
+	// 10 小时前保存的状态：饱食度 -40、心情 -20、精力 -30、清洁度降到 0 为止
+	past := time.Now().Add(-10 * time.Hour)
+	if err := d.Gorm.Create(&PetStateDO{PetID: 1, UserID: 1, Hunger: 50, Mood: 90, Energy: 60, Cleanliness: 10, UpdatedAt: past}).Error; err != nil {
+		t.Fatal(err)
+	}
+	s, err = uc.Get(ctx, 1, 1)
+	near := func(got, want float64) bool { return math.Abs(got-want) < 0.1 }
+	if err != nil || !near(s.Hunger, 10) || !near(s.Mood, 70) || !near(s.Energy, 30) || s.Cleanliness != 0 {
+		t.Fatalf("decayed: %+v %v", s, err)
+	}
+
+	// 使用道具时先结算衰减再叠加效果，结果限制在 0-100
+	s, err = uc.ApplyEffects(ctx, 1, 0, []biz.ItemEffect{{Stat: biz.PetStatHunger, Delta: 30}, {Stat: biz.PetStatMood, Delta: 50}, {Stat: biz.PetStatEnergy, Delta: -40}})
+	if err != nil || !near(s.Hunger, 40) || s.Mood != 100 || s.Energy != 0 {
+		t.Fatalf("apply: %+v %v", s, err)
+	}
+	var row PetStateDO
+	d.Gorm.First(&row, 1)
+	if !near(row.Hunger, 40) || row.Mood != 100 || row.UpdatedAt.Before(past.Add(time.Hour)) {
+		t.Fatalf("saved: %+v", row)
+	}
+
+	// 每只宠物各自一份状态；不能读写他人的宠物
+	if s, err = uc.ApplyEffects(ctx, 1, 2, []biz.ItemEffect{{Stat: biz.PetStatCleanliness, Delta: -50}}); err != nil || s.PetID != 2 || s.Cleanliness != 30 || s.Hunger != 80 {
+		t.Fatalf("second pet: %+v %v", s, err)
+	}
+	if s, err = uc.Get(ctx, 1, 1); err != nil || s.Cleanliness != 0 {
+		t.Fatalf("first pet must be unaffected: %+v %v", s, err)
+	}
+	if _, err := uc.Get(ctx, 1, 3); !errors.Is(err, biz.ErrPetNotFound) {
+		t.Fatalf("foreign pet: want pet not found, got %v", err)
+	}
+	if _, err := uc.ApplyEffects(ctx, 2, 1, []biz.ItemEffect{{Stat: biz.PetStatMood, Delta: 10}}); !errors.Is(err, biz.ErrPetNotFound) {
+		t.Fatalf("foreign pet: want pet not found, got %v", err)
+	}
+}
+
+func TestParseItemEffects(t *testing.T) {
+	effects, err := biz.ParseItemEffects(" hunger:+30, mood:-5 ")
+	if err != nil || len(effects) != 2 || effects[1] != (biz.ItemEffect{Stat: biz.PetStatMood, Delta: -5}) {
+		t.Fatalf("parse: %+v %v", effects, err)
+	}
+	if s := biz.FormatItemEffects(effects); s != "hunger:+30,mood:-5" {
+		t.Fatalf("format: %q", s)
+	}
+	for _, bad := range []string{"hunger", "hunger:abc", "weight:+1", "hunger:+1,hunger:+2", "mood:0", "mood:+101"} {
+		if _, err := biz.ParseItemEffects(bad); errors.Reason(err) != "INVALID_ITEM_EFFECTS" {
+			t.Fatalf("%q: want INVALID_ITEM_EFFECTS, got %v", bad, err)
+		}
+	}
+}
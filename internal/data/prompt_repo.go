@@ -0,0 +1,115 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// PromptTemplateDO 映射 prompt_templates 表（提示词模板，按适用范围分版本保存）
+type PromptTemplateDO struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"` // 模板ID
+	Scene     string    `gorm:"column:scene;type:varchar(16)"`      // 场景 chat/note
+	ModelType int32     `gorm:"column:model_type;not null"`         // 适用的模型类型（-1 为全部）
+	ModelID   int64     `gorm:"column:model_id;not null"`           // 适用的模型（0 为不限）
+	Version   int32     `gorm:"column:version;not null"`            // 版本号
+	Content   string    `gorm:"column:content;type:text"`           // 模板内容
+	Note      string    `gorm:"column:note;type:varchar(255)"`      // 修改说明
+	IsActive  bool      `gorm:"column:is_active;not null"`          // 是否启用
+	CreatedBy int64     `gorm:"column:created_by;not null"`         // 创建人
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`   // 创建时间
+}
+
+func (PromptTemplateDO) TableName() string { return "prompt_templates" }
+
+func (t *PromptTemplateDO) toBiz() *biz.PromptTemplate {
+	return &biz.PromptTemplate{
+		ID: t.ID, Scene: t.Scene, ModelType: t.ModelType, ModelID: t.ModelID, Version: t.Version, Content: t.Content,
+		Note: t.Note, Active: t.IsActive, CreatedBy: t.CreatedBy, CreatedAt: t.CreatedAt,
+	}
+}
+
+// PromptRepo 实现 biz.PromptRepo（GORM）
+
+type PromptRepo struct{ data *Data }
+
+func NewPromptRepo(d *Data) *PromptRepo { return &PromptRepo{data: d} }
+
+func (r *PromptRepo) find(q *gorm.DB) ([]*biz.PromptTemplate, error) {
+	var rows []PromptTemplateDO
+	if err := q.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.PromptTemplate, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toBiz())
+	}
+	return out, nil
+}
+
+func (r *PromptRepo) List(ctx context.Context, scene string) ([]*biz.PromptTemplate, error) {
+	if r.data.Gorm == nil {
+		return nil, nil
+	}
+	q := r.data.db(ctx)
+	if scene != "" {
+		q = q.Where("scene=?", scene)
+	}
+	return r.find(q.Order("scene, model_id, model_type, version DESC"))
+}
+
+func (r *PromptRepo) ListActive(ctx context.Context, scene string) ([]*biz.PromptTemplate, error) {
+	// 内存模式没有模板表，使用内置模板
+	if r.data.Gorm == nil {
+		return nil, nil
+	}
+	return r.find(r.data.db(ctx).Where("scene=? AND is_active=?", scene, true))
+}
+
+func (r *PromptRepo) Get(ctx context.Context, id int64) (*biz.PromptTemplate, error) {
+	if r.data.Gorm == nil {
+		return nil, biz.ErrPromptTemplateNotFound
+	}
+	var row PromptTemplateDO
+	if err := r.data.db(ctx).Take(&row, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrPromptTemplateNotFound
+		}
+		return nil, err
+	}
+	return row.toBiz(), nil
+}
+
+func (r *PromptRepo) Create(ctx context.Context, t *biz.PromptTemplate) error {
+	row := &PromptTemplateDO{
+		Scene: t.Scene, ModelType: t.ModelType, ModelID: t.ModelID, Version: t.Version, Content: t.Content,
+		Note: t.Note, IsActive: t.Active, CreatedBy: t.CreatedBy,
+	}
+	if err := r.data.db(ctx).Create(row).Error; err != nil {
+		return err
+	}
+	t.ID, t.CreatedAt = row.ID, row.CreatedAt
+	return nil
+}
+
+func (r *PromptRepo) MaxVersion(ctx context.Context, scene string, modelType int32, modelID int64) (int32, error) {
+	var v int32
+	err := r.data.db(ctx).Model(&PromptTemplateDO{}).
+		Where("scene=? AND model_type=? AND model_id=?", scene, modelType, modelID).
+		Select("COALESCE(MAX(version),0)").Scan(&v).Error
+	return v, err
+}
+
+func (r *PromptRepo) Activate(ctx context.Context, t *biz.PromptTemplate) error {
+	db := r.data.db(ctx)
+	if err := db.Model(&PromptTemplateDO{}).
+		Where("scene=? AND model_type=? AND model_id=? AND id<>?", t.Scene, t.ModelType, t.ModelID, t.ID).
+		Update("is_active", false).Error; err != nil {
+		return err
+	}
+	return db.Model(&PromptTemplateDO{}).Where("id=?", t.ID).Update("is_active", true).Error
+}
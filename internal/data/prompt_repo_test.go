@@ -0,0 +1,176 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestPromptTemplates(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, nickname, active_pet_id) VALUES (1, 'alice', '小明', 1);
+INSERT INTO pet_models (id, name, path, type) VALUES (1, '橘猫', 'cat.glb', 0), (2, '柴犬', 'dog.glb', 1), (3, '黑猫', 'black.glb', 0);
+INSERT INTO pets (id, user_id, name, sex, kind, hobby, model_id) VALUES (1, 1, '咪咪', 2, '橘猫', '晒太阳', 1), (2, 1, '旺财', 1, '柴犬', '', 2);
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	pets := biz.NewPetUsecase(NewPetRepo(d), NewAvatarRepo(d), nil, d)
+	memories := biz.NewPetMemoryUsecase(NewPetMemoryRepo(d), &fakeSummarizer{}, pets, d, log.DefaultLogger)
+	uc := biz.NewPromptUsecase(NewPromptRepo(d), NewUserRepo(d), NewAvatarRepo(d), pets, biz.NewPetStateUsecase(NewPetStateRepo(d), NewPetRepo(d), d), memories, d, log.DefaultLogger)
+	mimi, _ := pets.Resolve(ctx, 1, 1)
+	wangcai, _ := pets.Resolve(ctx, 1, 2)
+
+	// 没有模板时使用内置模板
+	s := uc.ChatPrompt(ctx, 1, mimi, "hi")
+	if !strings.Contains(s, "我的名字是咪咪") || !strings.Contains(s, "主人的昵称是小明") || !strings.Contains(s, "我当前的状态") {
+		t.Fatalf("default chat: %s", s)
+	}
+	if s := uc.NotePrompt(ctx, 1); !strings.Contains(s, "我的名字是咪咪") {
+		t.Fatalf("default note: %s", s)
+	}
+	// 状态按聊天对象宠物取值
+	d.Gorm.Create(&PetStateDO{PetID: 2, UserID: 1, Hunger: 10, Mood: 80, Energy: 80, Cleanliness: 80, UpdatedAt: time.Now()})
+	if s := uc.ChatPrompt(ctx, 1, wangcai, "hi"); !strings.Contains(s, "我现在很饿") {
+		t.Fatalf("wangcai state: %s", s)
+	}
+	if s := uc.ChatPrompt(ctx, 1, mimi, "hi"); strings.Contains(s, "饿") {
+		t.Fatalf("mimi must not share wangcai's state: %s", s)
+	}
+
+	create := func(modelType int32, modelID int64, content string) *biz.PromptTemplate {
+		t.Helper()
+		tpl, err := uc.Create(ctx, &biz.PromptTemplate{Scene: biz.PromptSceneChat, ModelType: modelType, ModelID: modelID, Content: content, CreatedBy: 9})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tpl
+	}
+	// 选用顺序：指定模型 > 模型类型 > 全部
+	create(biz.PromptAnyModelType, 0, "全部 {{.PetName}}")
+	if s := uc.ChatPrompt(ctx, 1, mimi, ""); s != "全部 咪咪" {
+		t.Fatalf("all: %s", s)
+	}
+	cat1 := create(0, 0, "猫 {{.PetName}} {{.PetSex}}")
+	if s := uc.ChatPrompt(ctx, 1, mimi, ""); s != "猫 咪咪 女孩" {
+		t.Fatalf("by type: %s", s)
+	}
+	if s := uc.ChatPrompt(ctx, 1, wangcai, ""); s != "全部 旺财" {
+		t.Fatalf("other type: %s", s)
+	}
+	model := create(1, 1, "专属 {{.PetName}}")
+	if model.ModelType != biz.PromptAnyModelType || model.Version != 1 {
+		t.Fatalf("model template: %+v", model)
+	}
+	if s := uc.ChatPrompt(ctx, 1, mimi, ""); s != "专属 咪咪" {
+		t.Fatalf("by model: %s", s)
+	}
+	// 其它场景不受影响
+	if s := uc.NotePrompt(ctx, 1); strings.Contains(s, "专属") {
+		t.Fatalf("note must use its own template: %s", s)
+	}
+
+	// 新版本启用后旧版本停用，重新启用旧版本即回滚
+	cat2 := create(0, 0, "猫v2 {{.PetName}}")
+	if cat2.Version != 2 || !cat2.Active {
+		t.Fatalf("version: %+v", cat2)
+	}
+	if s := uc.ChatPrompt(ctx, 3, &biz.Pet{UserID: 3, Name: "小黑", ModelID: 3}, ""); s != "猫v2 小黑" {
+		t.Fatalf("v2: %s", s)
+	}
+	if _, err := uc.Activate(ctx, cat1.ID); err != nil {
+		t.Fatal(err)
+	}
+	list, err := uc.List(ctx, biz.PromptSceneChat)
+	if err != nil || len(list) != 4 {
+		t.Fatalf("list: %+v %v", list, err)
+	}
+	active := map[int64]bool{}
+	for _, tpl := range list {
+		active[tpl.ID] = tpl.Active
+	}
+	if !active[cat1.ID] || active[cat2.ID] || !active[model.ID] {
+		t.Fatalf("rollback: %+v", active)
+	}
+	if s := uc.ChatPrompt(ctx, 3, &biz.Pet{UserID: 3, Name: "小黑", ModelID: 3}, ""); s != "猫 小黑" {
+		t.Fatalf("rolled back: %s", s)
+	}
+	if _, err := uc.Activate(ctx, 999); !errors.Is(err, biz.ErrPromptTemplateNotFound) {
+		t.Fatalf("want not found, got %v", err)
+	}
+
+	// 校验：场景、适用范围、内容与模板语法
+	for _, bad := range []*biz.PromptTemplate{
+		{Scene: "post", ModelType: -1, Content: "x"},
+		{Scene: biz.PromptSceneChat, ModelType: 2, Content: "x"},
+		{Scene: biz.PromptSceneChat, ModelType: -1, Content: "  "},
+		{Scene: biz.PromptSceneChat, ModelType: -1, Content: "{{.PetName"},
+		{Scene: biz.PromptSceneChat, ModelType: -1, Content: "{{.PetAge}}"},
+		{Scene: biz.PromptSceneChat, ModelType: -1, Content: strings.Repeat("字", 4001)},
+	} {
+		if _, err := uc.Create(ctx, bad); errors.Reason(err) != "INVALID_PROMPT_TEMPLATE" {
+			t.Fatalf("%+v: want invalid, got %v", bad, err)
+		}
+	}
+	if _, err := uc.Create(ctx, &biz.PromptTemplate{Scene: biz.PromptSceneChat, ModelID: 99, Content: "x"}); !errors.Is(err, biz.ErrAvatarNotFound) {
+		t.Fatalf("unknown model: %v", err)
+	}
+
+	// 预览：未保存的内容 > 指定版本 > 当前选用的模板
+	p, err := uc.Preview(ctx, 1, biz.PromptSceneChat, 0, 0, "{{.OwnerNickname}}的{{.PetName}}（{{.PetKind}}，喜欢{{.PetHobby}}）", "")
+	if err != nil || p.Prompt != "小明的咪咪（橘猫，喜欢晒太阳）" || p.TemplateID != 0 {
+		t.Fatalf("preview content: %+v %v", p, err)
+	}
+	if p, err := uc.Preview(ctx, 1, biz.PromptSceneChat, 1, cat2.ID, "", ""); err != nil || p.Prompt != "猫v2 咪咪" || p.Version != 2 {
+		t.Fatalf("preview version: %+v %v", p, err)
+	}
+	if p, err := uc.Preview(ctx, 1, biz.PromptSceneChat, 2, 0, "", ""); err != nil || p.Prompt != "全部 旺财" {
+		t.Fatalf("preview active: %+v %v", p, err)
+	}
+	if p, err := uc.Preview(ctx, 1, biz.PromptSceneNote, 0, 0, "", ""); err != nil || p.TemplateID != 0 || !strings.Contains(p.Prompt, "咪咪") {
+		t.Fatalf("preview builtin: %+v %v", p, err)
+	}
+	if _, err := uc.Preview(ctx, 1, biz.PromptSceneNote, 0, cat1.ID, "", ""); errors.Reason(err) != "INVALID_PROMPT_TEMPLATE" {
+		t.Fatalf("scene mismatch: %v", err)
+	}
+	if _, err := uc.Preview(ctx, 1, biz.PromptSceneChat, 0, 0, "{{.PetAge}}", ""); errors.Reason(err) != "INVALID_PROMPT_TEMPLATE" {
+		t.Fatalf("bad content: %v", err)
+	}
+}
+
+func TestPickPromptTemplate(t *testing.T) {
+	list := []*biz.PromptTemplate{
+		{ID: 1, ModelType: biz.PromptAnyModelType},
+		{ID: 2, ModelType: 0},
+		{ID: 3, ModelType: biz.PromptAnyModelType, ModelID: 5},
+	}
+	for _, c := range []struct {
+		modelID   int64
+		modelType int32
+		want      int64
+	}{
+		{5, 1, 3}, {6, 0, 2}, {6, 1, 1}, {0, biz.PromptAnyModelType, 1},
+	} {
+		if got := biz.PickPromptTemplate(list, c.modelID, c.modelType); got.ID != c.want {
+			t.Fatalf("model %d type %d: got %d want %d", c.modelID, c.modelType, got.ID, c.want)
+		}
+	}
+	if got := biz.PickPromptTemplate(list[1:2], 0, 1); got != nil {
+		t.Fatalf("no match: %+v", got)
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	for h, want := range map[int]string{0: "凌晨", 7: "早上", 10: "上午", 12: "中午", 15: "下午", 18: "傍晚", 22: "晚上"} {
+		if got := biz.TimeOfDay(time.Date(2024, 1, 1, h, 0, 0, 0, time.UTC)); got != want {
+			t.Fatalf("%d: got %s want %s", h, got, want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// ReminderDO 映射 reminders 表（护理提醒）
+type ReminderDO struct {
+	ID         int64      `gorm:"column:id;primaryKey;autoIncrement"` // 提醒ID
+	UserID     int64      `gorm:"column:user_id;not null"`            // 用户ID
+	PetID      int64      `gorm:"column:pet_id;not null"`             // 宠物ID
+	Kind       string     `gorm:"column:kind;type:varchar(16)"`       // 类型
+	Title      string     `gorm:"column:title;type:varchar(50)"`      // 标题
+	Note       string     `gorm:"column:note;type:varchar(255)"`      // 备注
+	RRule      string     `gorm:"column:rrule;type:varchar(255)"`     // 重复规则
+	Timezone   string     `gorm:"column:timezone;type:varchar(64)"`   // IANA 时区
+	StartAt    string     `gorm:"column:start_at;type:char(16)"`      // 起始时间（提醒时区）
+	Enabled    bool       `gorm:"column:enabled;not null"`            // 是否启用
+	NextRunAt  *time.Time `gorm:"column:next_run_at"`                 // 下次提醒时间
+	LastRunAt  *time.Time `gorm:"column:last_run_at"`                 // 上次提醒时间
+	FiredCount int32      `gorm:"column:fired_count;not null"`        // 已提醒次数
+	CreatedAt  time.Time  `gorm:"column:created_at"`                  // 创建时间
+	UpdatedAt  time.Time  `gorm:"column:updated_at"`                  // 更新时间
+}
+
+func (ReminderDO) TableName() string { return "reminders" }
+
+func (r *ReminderDO) toBiz() *biz.Reminder {
+	return &biz.Reminder{
+		ID: r.ID, UserID: r.UserID, PetID: r.PetID, Kind: r.Kind, Title: r.Title, Note: r.Note, RRule: r.RRule,
+		Timezone: r.Timezone, StartAt: r.StartAt, Enabled: r.Enabled, NextRunAt: r.NextRunAt, LastRunAt: r.LastRunAt,
+		FiredCount: r.FiredCount, CreatedAt: r.CreatedAt, UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func reminderDO(r *biz.Reminder) *ReminderDO {
+	return &ReminderDO{
+		ID: r.ID, UserID: r.UserID, PetID: r.PetID, Kind: r.Kind, Title: r.Title, Note: r.Note, RRule: r.RRule,
+		Timezone: r.Timezone, StartAt: r.StartAt, Enabled: r.Enabled, NextRunAt: utcTime(r.NextRunAt), LastRunAt: utcTime(r.LastRunAt),
+		FiredCount: r.FiredCount, CreatedAt: r.CreatedAt, UpdatedAt: r.UpdatedAt,
+	}
+}
+
+// utcTime 提醒时间统一按 UTC 写入与比较（next_run_at 位于各提醒自己的时区，驱动按文本保存时偏移不一致会影响比较）
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	u := t.UTC()
+	return &u
+}
+
+// ReminderRepo 实现 biz.ReminderRepo（GORM）
+
+type ReminderRepo struct{ data *Data }
+
+func NewReminderRepo(d *Data) *ReminderRepo { return &ReminderRepo{data: d} }
+
+func (r *ReminderRepo) Create(ctx context.Context, rem *biz.Reminder) error {
+	row := reminderDO(rem)
+	if err := r.data.db(ctx).Create(row).Error; err != nil {
+		return err
+	}
+	rem.ID = row.ID
+	return nil
+}
+
+func (r *ReminderRepo) Get(ctx context.Context, userID, id int64) (*biz.Reminder, error) {
+	var row ReminderDO
+	if err := r.data.db(ctx).Where("id=? AND user_id=?", id, userID).Take(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrReminderNotFound
+		}
+		return nil, err
+	}
+	return row.toBiz(), nil
+}
+
+func (r *ReminderRepo) List(ctx context.Context, userID, petID int64) ([]*biz.Reminder, error) {
+	q := r.data.db(ctx).Where("user_id=?", userID)
+	if petID != 0 {
+		q = q.Where("pet_id=?", petID)
+	}
+	var rows []ReminderDO
+	if err := q.Order("id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.Reminder, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toBiz())
+	}
+	return out, nil
+}
+
+func (r *ReminderRepo) Count(ctx context.Context, userID int64) (int64, error) {
+	var n int64
+	err := r.data.db(ctx).Model(&ReminderDO{}).Where("user_id=?", userID).Count(&n).Error
+	return n, err
+}
+
+func (r *ReminderRepo) Update(ctx context.Context, rem *biz.Reminder) error {
+	row := reminderDO(rem)
+	return r.data.db(ctx).Model(row).Where("user_id=?", rem.UserID).
+		Select("kind", "title", "note", "rrule", "timezone", "start_at", "enabled", "next_run_at", "updated_at").
+		Updates(row).Error
+}
+
+func (r *ReminderRepo) Delete(ctx context.Context, userID, id int64) error {
+	res := r.data.db(ctx).Where("id=? AND user_id=?", id, userID).Delete(&ReminderDO{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrReminderNotFound
+	}
+	return nil
+}
+
+func (r *ReminderRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]*biz.Reminder, error) {
+	// 内存模式没有提醒表，调度器空转
+	if r.data.Gorm == nil {
+		return nil, nil
+	}
+	var rows []ReminderDO
+	if err := r.data.db(ctx).Where("enabled=? AND next_run_at<=?", true, now.UTC()).
+		Order("next_run_at, id").Limit(limit).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.Reminder, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toBiz())
+	}
+	return out, nil
+}
+
+func (r *ReminderRepo) Advance(ctx context.Context, id int64, prev time.Time, next *time.Time, firedAt time.Time) (bool, error) {
+	res := r.data.db(ctx).Model(&ReminderDO{}).Where("id=? AND enabled=? AND next_run_at=?", id, true, prev.UTC()).
+		Updates(map[string]interface{}{
+			"next_run_at": utcTime(next),
+			"last_run_at": firedAt.UTC(),
+			"fired_count": gorm.Expr("fired_count+1"),
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *ReminderRepo) Postpone(ctx context.Context, id int64, prev, until time.Time) (bool, error) {
+	res := r.data.db(ctx).Model(&ReminderDO{}).Where("id=? AND enabled=? AND next_run_at=?", id, true, prev.UTC()).
+		Update("next_run_at", until.UTC())
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
@@ -0,0 +1,279 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestRecurrence(t *testing.T) {
+	sh, _ := time.LoadLocation("Asia/Shanghai")
+	ny, _ := time.LoadLocation("America/New_York")
+	at := func(loc *time.Location, s string) time.Time {
+		v, err := time.ParseInLocation(biz.ReminderStartLayout, s, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	for _, bad := range []string{"FREQ=HOURLY", "INTERVAL=2", "FREQ=DAILY;BYDAY=MO", "FREQ=WEEKLY;BYDAY=XX", "FREQ=DAILY;COUNT=2;UNTIL=20250101", "FREQ=DAILY;FREQ=DAILY", "FREQ=DAILY;X=1"} {
+		if _, err := biz.ParseRecurrence(bad); err == nil {
+			t.Fatalf("%s: want error", bad)
+		}
+	}
+	if r, err := biz.ParseRecurrence("rrule:freq=weekly;byday=we,mo,we;until=2025-02-01"); err != nil || r.String() != "FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20250201" {
+		t.Fatalf("normalize: %v %v", r, err)
+	}
+
+	for _, c := range []struct {
+		rule, start, after string
+		loc                *time.Location
+		want               []string // 依次的发生时间，空字符串表示序列结束
+	}{
+		// 不重复：只在起始时间发生一次
+		{"", "2025-01-01 08:00", "2024-12-31 00:00", sh, []string{"2025-01-01 08:00", ""}},
+		{"FREQ=DAILY", "2025-01-01 08:00", "2025-01-01 08:00", sh, []string{"2025-01-02 08:00", "2025-01-03 08:00"}},
+		// 夏令时切换后仍在当地 8:00
+		{"FREQ=DAILY", "2025-03-08 08:00", "2025-03-08 08:00", ny, []string{"2025-03-09 08:00", "2025-03-10 08:00"}},
+		// 2025-01-01 是周三
+		{"FREQ=WEEKLY;BYDAY=MO,WE", "2025-01-01 20:00", "2024-12-01 00:00", sh, []string{"2025-01-01 20:00", "2025-01-06 20:00", "2025-01-08 20:00"}},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO", "2025-01-01 20:00", "2024-12-01 00:00", sh, []string{"2025-01-13 20:00", "2025-01-27 20:00"}},
+		// 跳过没有 31 日的月份
+		{"FREQ=MONTHLY", "2025-01-31 09:00", "2025-01-31 09:00", sh, []string{"2025-03-31 09:00", "2025-05-31 09:00"}},
+		{"FREQ=MONTHLY;INTERVAL=3", "2025-01-15 09:00", "2025-01-15 09:00", sh, []string{"2025-04-15 09:00", "2025-07-15 09:00"}},
+		{"FREQ=YEARLY", "2024-02-29 09:00", "2024-02-29 09:00", sh, []string{"2028-02-29 09:00"}},
+		{"FREQ=DAILY;COUNT=2", "2025-01-01 08:00", "2024-12-31 00:00", sh, []string{"2025-01-01 08:00", "2025-01-02 08:00", ""}},
+		{"FREQ=DAILY;UNTIL=20250102", "2025-01-01 08:00", "2024-12-31 00:00", sh, []string{"2025-01-01 08:00", "2025-01-02 08:00", ""}},
+	} {
+		rec, err := biz.ParseRecurrence(c.rule)
+		if err != nil {
+			t.Fatalf("%s: %v", c.rule, err)
+		}
+		start, after := at(c.loc, c.start), at(c.loc, c.after)
+		for i, want := range c.want {
+			next, ok := rec.Next(start, after)
+			got := ""
+			if ok {
+				got = next.In(c.loc).Format(biz.ReminderStartLayout)
+			}
+			if got != want {
+				t.Fatalf("%q from %s, occurrence %d: want %q, got %q", c.rule, c.start, i, want, got)
+			}
+			after = next
+		}
+	}
+}
+
+func TestReminders(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, active_pet_id) VALUES (1, 'alice', 1), (2, 'bob', 3);
+INSERT INTO pets (id, user_id, name) VALUES (1, 1, '咪咪'), (2, 1, '旺财'), (3, 2, 'Bob cat');
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	pets := biz.NewPetUsecase(NewPetRepo(d), NewAvatarRepo(d), nil, d)
+	repo := NewReminderRepo(d)
+	uc := biz.NewReminderUsecase(repo, pets, NewMessageRepo(d), d, log.DefaultLogger)
+	msgs := biz.NewMessageUsecase(NewMessageRepo(d), pets, nil, d)
+	sh, _ := time.LoadLocation("Asia/Shanghai")
+
+	for _, bad := range []*biz.Reminder{
+		{UserID: 1, Kind: "bath", Title: "x", StartAt: "2025-01-01 08:00"},
+		{UserID: 1, Kind: biz.ReminderFeeding, Title: " ", StartAt: "2025-01-01 08:00"},
+		{UserID: 1, Kind: biz.ReminderFeeding, Title: "x", StartAt: "2025-01-01"},
+		{UserID: 1, Kind: biz.ReminderFeeding, Title: "x", StartAt: "2025-01-01 08:00", RRule: "FREQ=HOURLY"},
+		{UserID: 1, Kind: biz.ReminderFeeding, Title: "x", StartAt: "2025-01-01 08:00", Timezone: "Local"},
+		// 不重复且已过去
+		{UserID: 1, Kind: biz.ReminderFeeding, Title: "x", StartAt: "2025-01-01 08:00"},
+	} {
+		if _, err := uc.Create(ctx, bad); err == nil {
+			t.Fatalf("want invalid reminder: %+v", bad)
+		}
+	}
+	if _, err := uc.Create(ctx, &biz.Reminder{UserID: 1, PetID: 3, Kind: biz.ReminderFeeding, Title: "x", StartAt: "2025-01-01 08:00", RRule: "FREQ=DAILY"}); !errors.Is(err, biz.ErrPetNotFound) {
+		t.Fatalf("other user's pet: want not found, got %v", err)
+	}
+
+	// 每天 8:00 喂食（起始时间在过去，从现在起计算下一次）
+	feed, err := uc.Create(ctx, &biz.Reminder{UserID: 1, Kind: biz.ReminderFeeding, Title: "早饭", Note: "半碗猫粮", StartAt: "2025-01-01 08:00", RRule: "freq=daily"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if feed.PetID != 1 || feed.Timezone != "Asia/Shanghai" || feed.RRule != "FREQ=DAILY" || !feed.Enabled || feed.NextRunAt == nil {
+		t.Fatalf("create: %+v", feed)
+	}
+	next := feed.NextRunAt.In(sh)
+	if next.Hour() != 8 || next.Minute() != 0 || !next.After(time.Now()) || next.Sub(time.Now()) > 24*time.Hour {
+		t.Fatalf("next run: %v", next)
+	}
+	// 每 3 个月给旺财驱虫
+	worm, err := uc.Create(ctx, &biz.Reminder{UserID: 1, PetID: 2, Kind: biz.ReminderDeworming, Title: "体内驱虫", StartAt: "2025-01-15 09:00", RRule: "FREQ=MONTHLY;INTERVAL=3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list, err := uc.List(ctx, 1, 0); err != nil || len(list) != 2 {
+		t.Fatalf("list all: %+v %v", list, err)
+	}
+	if list, err := uc.List(ctx, 1, 2); err != nil || len(list) != 1 || list[0].ID != worm.ID {
+		t.Fatalf("list by pet: %+v %v", list, err)
+	}
+	if _, err := uc.Get(ctx, 2, feed.ID); !errors.Is(err, biz.ErrReminderNotFound) {
+		t.Fatalf("want not found, got %v", err)
+	}
+
+	// 未到期不发送
+	if n, err := uc.FireDue(ctx, time.Now()); err != nil || n != 0 {
+		t.Fatalf("nothing due: %d %v", n, err)
+	}
+	// 到点发送一条提醒消息，并推进到下一天
+	due := next.Add(time.Minute)
+	if n, err := uc.FireDue(ctx, due); err != nil || n != 1 {
+		t.Fatalf("fire: %d %v", n, err)
+	}
+	if n, _ := uc.FireDue(ctx, due); n != 0 {
+		t.Fatal("same occurrence must fire once")
+	}
+	got, _ := uc.Get(ctx, 1, feed.ID)
+	if got.FiredCount != 1 || got.LastRunAt == nil || !got.NextRunAt.Equal(next.AddDate(0, 0, 1)) {
+		t.Fatalf("after fire: %+v next=%v", got, got.NextRunAt)
+	}
+	total, page, err := msgs.GetList(ctx, 1, 0, false, 1, 20)
+	if err != nil || total != 1 {
+		t.Fatalf("message list: %d %v", total, err)
+	}
+	m := page[0]
+	if m.MessageType != biz.MessageTypeReminder || m.Sender != 1 || m.PetID != 1 || !strings.Contains(m.Content, "咪咪") || !strings.Contains(m.Content, "早饭") {
+		t.Fatalf("reminder message: %+v", m)
+	}
+	if total, _, _ := msgs.GetList(ctx, 1, 2, false, 1, 20); total != 0 {
+		t.Fatal("reminder must only show in its pet's chat")
+	}
+	if total, _, _ := msgs.GetList(ctx, 1, 0, true, 1, 20); total != 0 {
+		t.Fatal("reminder is not a note")
+	}
+
+	// 停机错过多次只补发一次
+	if n, err := uc.FireDue(ctx, due.AddDate(0, 0, 3)); err != nil || n != 1 {
+		t.Fatalf("missed: %d %v", n, err)
+	}
+	if got, _ = uc.Get(ctx, 1, feed.ID); !got.NextRunAt.Equal(next.AddDate(0, 0, 4)) {
+		t.Fatalf("after missed: next=%v", got.NextRunAt)
+	}
+	// 过期的推进条件（已被其他实例处理）不生效
+	if ok, err := repo.Advance(ctx, feed.ID, next, nil, time.Now()); err != nil || ok {
+		t.Fatalf("stale advance: %v %v", ok, err)
+	}
+
+	// 停用后不再到期；重新启用从现在起计算
+	if r, err := uc.SetEnabled(ctx, 1, feed.ID, false); err != nil || r.Enabled || r.NextRunAt != nil {
+		t.Fatalf("disable: %+v %v", r, err)
+	}
+	if n, _ := uc.FireDue(ctx, due.AddDate(0, 0, 30)); n != 0 {
+		t.Fatalf("disabled reminder fired %d", n)
+	}
+	if r, err := uc.SetEnabled(ctx, 1, feed.ID, true); err != nil || r.NextRunAt == nil || !r.NextRunAt.Equal(next) {
+		t.Fatalf("enable: %+v %v", r, err)
+	}
+
+	// 修改规则：改为每周一 20:00
+	upd, err := uc.Update(ctx, &biz.Reminder{ID: feed.ID, UserID: 1, Kind: biz.ReminderFeeding, Title: "加餐", RRule: "FREQ=WEEKLY;BYDAY=MO", StartAt: "2025-01-06 20:00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := upd.NextRunAt.In(sh); n.Weekday() != time.Monday || n.Hour() != 20 || upd.PetID != 1 || upd.Note != "" || !upd.Enabled {
+		t.Fatalf("update: %+v next=%v", upd, n)
+	}
+
+	// 单次提醒发送后结束
+	once, err := uc.Create(ctx, &biz.Reminder{UserID: 1, Kind: biz.ReminderVaccine, Title: "狂犬疫苗", StartAt: time.Now().In(sh).Add(time.Hour).Format(biz.ReminderStartLayout)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := uc.FireDue(ctx, once.NextRunAt.Add(time.Second)); n != 1 {
+		t.Fatalf("once: fired %d", n)
+	}
+	if got, _ = uc.Get(ctx, 1, once.ID); got.NextRunAt != nil || got.FiredCount != 1 {
+		t.Fatalf("once after fire: %+v", got)
+	}
+
+	// 删除宠物时提醒一并删除
+	if err := uc.Delete(ctx, 2, worm.ID); !errors.Is(err, biz.ErrReminderNotFound) {
+		t.Fatalf("want not found, got %v", err)
+	}
+	if _, err := pets.Delete(ctx, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.Get(ctx, 1, worm.ID); !errors.Is(err, biz.ErrReminderNotFound) {
+		t.Fatalf("reminder of deleted pet: %v", err)
+	}
+}
+
+// failingMessages 写入消息总是失败
+type failingMessages struct{ *MessageRepoImpl }
+
+func (failingMessages) CreateMessage(context.Context, *biz.Message) error {
+	return errors.New("db unavailable")
+}
+
+// countingReminders 统计 ListDue 调用次数
+type countingReminders struct {
+	*ReminderRepo
+	listed int
+}
+
+func (r *countingReminders) ListDue(ctx context.Context, now time.Time, limit int) ([]*biz.Reminder, error) {
+	r.listed++
+	return r.ReminderRepo.ListDue(ctx, now, limit)
+}
+
+func TestReminderFireBackoff(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO users (id, username, active_pet_id) VALUES (1, 'alice', 1);
+INSERT INTO pets (id, user_id, name) VALUES (1, 1, '咪咪');
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	pets := biz.NewPetUsecase(NewPetRepo(d), NewAvatarRepo(d), nil, d)
+	repo := &countingReminders{ReminderRepo: NewReminderRepo(d)}
+	due := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 120; i++ {
+		if err := repo.Create(ctx, &biz.Reminder{UserID: 1, PetID: 1, Kind: biz.ReminderFeeding, Title: "早饭", RRule: "FREQ=DAILY",
+			Timezone: "UTC", StartAt: "2025-01-01 00:00", Enabled: true, NextRunAt: &due}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 发送失败：每条只读取一次，推迟后重试，不计为已提醒
+	now := due.Add(time.Minute)
+	failing := biz.NewReminderUsecase(repo, pets, failingMessages{NewMessageRepo(d)}, d, log.DefaultLogger)
+	if n, err := failing.FireDue(ctx, now); err != nil || n != 0 {
+		t.Fatalf("failing fire: %d %v", n, err)
+	}
+	if repo.listed != 2 {
+		t.Fatalf("failed reminders must not be re-read in later rounds, ListDue called %d times", repo.listed)
+	}
+	var late int64
+	d.Gorm.Model(&ReminderDO{}).Where("next_run_at=? AND fired_count=0", now.Add(5*time.Minute)).Count(&late)
+	if late != 120 {
+		t.Fatalf("want 120 postponed reminders, got %d", late)
+	}
+	if n, _ := failing.FireDue(ctx, now); n != 0 {
+		t.Fatalf("postponed reminders must not be due yet, fired %d", n)
+	}
+
+	// 推迟到期后正常发送
+	uc := biz.NewReminderUsecase(repo, pets, NewMessageRepo(d), d, log.DefaultLogger)
+	if n, err := uc.FireDue(ctx, now.Add(5*time.Minute)); err != nil || n != 120 {
+		t.Fatalf("retry: %d %v", n, err)
+	}
+}
@@ -0,0 +1,282 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// UserSessionDO 映射 user_sessions 表
+
+type UserSessionDO struct {
+	ID              int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID          int64      `gorm:"column:user_id;not null"`
+	RefreshHash     string     `gorm:"column:refresh_hash;not null"`
+	AccessJTI       string     `gorm:"column:access_jti;not null"`
+	AccessExpiresAt time.Time  `gorm:"column:access_expires_at;not null"`
+	ExpiresAt       time.Time  `gorm:"column:expires_at;not null"`
+	UserAgent       string     `gorm:"column:user_agent"`
+	IP              string     `gorm:"column:ip"`
+	LastSeenAt      time.Time  `gorm:"column:last_seen_at"`
+	RevokedAt       *time.Time `gorm:"column:revoked_at"`
+	CreatedAt       time.Time  `gorm:"column:created_at"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at"`
+}
+
+func (UserSessionDO) TableName() string { return "user_sessions" }
+
+func (s *UserSessionDO) toBiz() *biz.Session {
+	return &biz.Session{
+		ID:              s.ID,
+		UserID:          s.UserID,
+		RefreshHash:     s.RefreshHash,
+		AccessJTI:       s.AccessJTI,
+		AccessExpiresAt: s.AccessExpiresAt,
+		ExpiresAt:       s.ExpiresAt,
+		UserAgent:       s.UserAgent,
+		IP:              s.IP,
+		LastSeenAt:      s.LastSeenAt,
+		RevokedAt:       s.RevokedAt,
+		CreatedAt:       s.CreatedAt,
+		UpdatedAt:       s.UpdatedAt,
+	}
+}
+
+// SessionRepo 实现 biz.SessionRepo
+// 当 Data.Gorm 不为空时使用 MySQL；否则使用内存映射（与 AuthRepo 的内存模式配套）
+
+type SessionRepo struct {
+	data *Data
+
+	mu     sync.Mutex
+	mem    map[int64]*UserSessionDO
+	nextID int64
+}
+
+func NewSessionRepo(d *Data) *SessionRepo {
+	return &SessionRepo{data: d, mem: make(map[int64]*UserSessionDO), nextID: 1}
+}
+
+// Create 新建会话
+func (r *SessionRepo) Create(ctx context.Context, s *biz.Session) (int64, error) {
+	now := time.Now()
+	row := &UserSessionDO{
+		UserID:          s.UserID,
+		RefreshHash:     s.RefreshHash,
+		AccessJTI:       s.AccessJTI,
+		AccessExpiresAt: s.AccessExpiresAt,
+		ExpiresAt:       s.ExpiresAt,
+		UserAgent:       s.UserAgent,
+		IP:              s.IP,
+		LastSeenAt:      now,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if r.data.Gorm != nil {
+		if err := r.data.Gorm.WithContext(ctx).Create(row).Error; err != nil {
+			return 0, err
+		}
+		return row.ID, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	row.ID = r.nextID
+	r.nextID++
+	r.mem[row.ID] = row
+	return row.ID, nil
+}
+
+// GetByRefreshHash 按 refresh token 摘要查询
+func (r *SessionRepo) GetByRefreshHash(ctx context.Context, hash string) (*biz.Session, error) {
+	return r.getBy(ctx, "refresh_hash=?", hash, func(s *UserSessionDO) bool { return s.RefreshHash == hash })
+}
+
+// GetByID 按会话ID查询
+func (r *SessionRepo) GetByID(ctx context.Context, id int64) (*biz.Session, error) {
+	return r.getBy(ctx, "id=?", id, func(s *UserSessionDO) bool { return s.ID == id })
+}
+
+func (r *SessionRepo) getBy(ctx context.Context, where string, arg interface{}, match func(*UserSessionDO) bool) (*biz.Session, error) {
+	if r.data.Gorm != nil {
+		var row UserSessionDO
+		if err := r.data.Gorm.WithContext(ctx).Where(where, arg).First(&row).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, biz.ErrSessionNotFound
+			}
+			return nil, err
+		}
+		return row.toBiz(), nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.mem {
+		if match(s) {
+			return s.toBiz(), nil
+		}
+	}
+	return nil, biz.ErrSessionNotFound
+}
+
+// Rotate 条件更新：仅当 refresh_hash 仍为 oldHash 且未吊销时写入新 token，保证同一 refresh token 只能使用一次
+func (r *SessionRepo) Rotate(ctx context.Context, id int64, oldHash string, next *biz.Session) error {
+	now := time.Now()
+	if r.data.Gorm != nil {
+		res := r.data.Gorm.WithContext(ctx).
+			Model(&UserSessionDO{}).
+			Where("id=? AND refresh_hash=? AND revoked_at IS NULL", id, oldHash).
+			Updates(map[string]interface{}{
+				"refresh_hash":      next.RefreshHash,
+				"access_jti":        next.AccessJTI,
+				"access_expires_at": next.AccessExpiresAt,
+				"expires_at":        next.ExpiresAt,
+				"last_seen_at":      now,
+				"updated_at":        now,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrInvalidRefreshToken
+		}
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.mem[id]
+	if !ok || s.RefreshHash != oldHash || s.RevokedAt != nil {
+		return biz.ErrInvalidRefreshToken
+	}
+	s.RefreshHash = next.RefreshHash
+	s.AccessJTI = next.AccessJTI
+	s.AccessExpiresAt = next.AccessExpiresAt
+	s.ExpiresAt = next.ExpiresAt
+	s.LastSeenAt = now
+	s.UpdatedAt = now
+	return nil
+}
+
+// ListActive 用户未吊销且未过期的会话，按最近活跃倒序
+func (r *SessionRepo) ListActive(ctx context.Context, userID int64, now time.Time) ([]*biz.Session, error) {
+	var rows []UserSessionDO
+	if r.data.Gorm != nil {
+		if err := r.data.Gorm.WithContext(ctx).
+			Where("user_id=? AND revoked_at IS NULL AND expires_at>?", userID, now).
+			Order("last_seen_at desc, id desc").
+			Find(&rows).Error; err != nil {
+			return nil, err
+		}
+	} else {
+		r.mu.Lock()
+		for _, s := range r.mem {
+			if s.UserID == userID && s.RevokedAt == nil && now.Before(s.ExpiresAt) {
+				rows = append(rows, *s)
+			}
+		}
+		r.mu.Unlock()
+		sort.Slice(rows, func(i, j int) bool {
+			if !rows[i].LastSeenAt.Equal(rows[j].LastSeenAt) {
+				return rows[i].LastSeenAt.After(rows[j].LastSeenAt)
+			}
+			return rows[i].ID > rows[j].ID
+		})
+	}
+	out := make([]*biz.Session, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toBiz())
+	}
+	return out, nil
+}
+
+// Revoke 吊销单个会话（幂等）
+func (r *SessionRepo) Revoke(ctx context.Context, id int64) error {
+	now := time.Now()
+	if r.data.Gorm != nil {
+		return r.data.Gorm.WithContext(ctx).
+			Model(&UserSessionDO{}).
+			Where("id=? AND revoked_at IS NULL", id).
+			Updates(map[string]interface{}{"revoked_at": now, "updated_at": now}).Error
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s, ok := r.mem[id]; ok && s.RevokedAt == nil {
+		s.RevokedAt = &now
+		s.UpdatedAt = now
+	}
+	return nil
+}
+
+// RevokeAll 吊销用户全部有效会话（保留 exceptID）
+func (r *SessionRepo) RevokeAll(ctx context.Context, userID, exceptID int64) ([]*biz.Session, error) {
+	now := time.Now()
+	if r.data.Gorm != nil {
+		var rows []UserSessionDO
+		err := r.data.Gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Where("user_id=? AND id<>? AND revoked_at IS NULL", userID, exceptID).Find(&rows).Error; err != nil {
+				return err
+			}
+			if len(rows) == 0 {
+				return nil
+			}
+			ids := make([]int64, 0, len(rows))
+			for _, s := range rows {
+				ids = append(ids, s.ID)
+			}
+			return tx.Model(&UserSessionDO{}).
+				Where("id IN ? AND revoked_at IS NULL", ids).
+				Updates(map[string]interface{}{"revoked_at": now, "updated_at": now}).Error
+		})
+		if err != nil {
+			return nil, err
+		}
+		out := make([]*biz.Session, 0, len(rows))
+		for i := range rows {
+			out = append(out, rows[i].toBiz())
+		}
+		return out, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*biz.Session
+	for _, s := range r.mem {
+		if s.UserID == userID && s.ID != exceptID && s.RevokedAt == nil {
+			t := now
+			s.RevokedAt = &t
+			s.UpdatedAt = now
+			out = append(out, s.toBiz())
+		}
+	}
+	return out, nil
+}
+
+// TouchLastSeen 批量刷新最近活跃时间（单事务内逐条更新，只前进不后退）
+func (r *SessionRepo) TouchLastSeen(ctx context.Context, seen map[int64]time.Time) error {
+	if len(seen) == 0 {
+		return nil
+	}
+	if r.data.Gorm != nil {
+		return r.data.Gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			for id, at := range seen {
+				if err := tx.Model(&UserSessionDO{}).
+					Where("id=? AND last_seen_at<?", id, at).
+					UpdateColumn("last_seen_at", at).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, at := range seen {
+		if s, ok := r.mem[id]; ok && s.LastSeenAt.Before(at) {
+			s.LastSeenAt = at
+		}
+	}
+	return nil
+}
@@ -15,25 +15,43 @@ CREATE TABLE `users` (
   `id`           bigint(20)   NOT NULL AUTO_INCREMENT COMMENT '用户ID',
   `nickname`     varchar(50)  DEFAULT NULL COMMENT '昵称',
   `username`     varchar(64)  DEFAULT '' COMMENT '登录用户名',
-  `password`     varchar(255) DEFAULT '' COMMENT '密码哈希（bcrypt）',
+  `password`     varchar(255) DEFAULT '' COMMENT '密码哈希（bcrypt）；为空表示未设置密码（如手机验证码注册）',
+  `phone`        varchar(20)  DEFAULT NULL COMMENT '手机号（E.164，如 +8613800138000）',
   `avatar`       varchar(255) DEFAULT NULL COMMENT '用户头像URL',
-  `model_id`     bigint(20)   NOT NULL COMMENT '当前宠物模型ID（关联 pet_models.id）',
-  `model_url`    varchar(255) NOT NULL DEFAULT '/models/Dog_1.glb' COMMENT '当前宠物模型URL',
-  `pet_name`     varchar(50)  DEFAULT NULL COMMENT '宠物名称',
-  `pet_avatar`   varchar(255) DEFAULT NULL COMMENT '宠物头像URL',
-  `pet_sex`      tinyint(1)   DEFAULT 0 COMMENT '宠物性别 0-未知 1-男 2-女',
-  `kind`         varchar(50)  DEFAULT NULL COMMENT '宠物种类（中文/英文均可）',
-  `weight`       int(11)      DEFAULT 0 COMMENT '宠物体重(kg)，整数保存',
-  `hobby`        varchar(255) DEFAULT NULL COMMENT '宠物/用户爱好摘要',
+  `active_pet_id` bigint(20)  NOT NULL DEFAULT 0 COMMENT '当前宠物ID（关联 pets.id，0 表示尚无宠物）',
   `description`  text         DEFAULT NULL COMMENT '个人/宠物简介',
   `coins`        int(11)      DEFAULT 0 COMMENT '金币余额',
+  `role`         varchar(16)  NOT NULL DEFAULT 'user' COMMENT '角色 user-普通用户 admin-管理员',
   `created_at`   datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at`   datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
   PRIMARY KEY (`id`),
-  KEY `idx_model_id` (`model_id`),
+  UNIQUE KEY `uk_username` (`username`),
+  UNIQUE KEY `uk_phone` (`phone`),
   KEY `idx_created_at` (`created_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户表';
 
+-- =========================
+-- 宠物表（一个用户可以有多只宠物，users.active_pet_id 指向当前宠物）
+-- =========================
+DROP TABLE IF EXISTS `pets`;
+CREATE TABLE `pets` (
+  `id`         bigint(20)   NOT NULL AUTO_INCREMENT COMMENT '宠物ID',
+  `user_id`    bigint(20)   NOT NULL COMMENT '主人用户ID',
+  `name`       varchar(50)  NOT NULL DEFAULT '' COMMENT '宠物名称',
+  `avatar`     varchar(255) NOT NULL DEFAULT '' COMMENT '宠物头像URL',
+  `sex`        tinyint(1)   NOT NULL DEFAULT 0 COMMENT '宠物性别 0-未知 1-男 2-女',
+  `kind`       varchar(50)  NOT NULL DEFAULT '' COMMENT '宠物种类（中文/英文均可）',
+  `weight`     int(11)      NOT NULL DEFAULT 0 COMMENT '宠物体重(kg)，整数保存',
+  `hobby`      varchar(255) NOT NULL DEFAULT '' COMMENT '宠物爱好摘要',
+  `model_id`   bigint(20)   NOT NULL DEFAULT 0 COMMENT '宠物模型ID（关联 pet_models.id，0 表示未选择）',
+  `model_url`  varchar(255) NOT NULL DEFAULT '/models/Dog_1.glb' COMMENT '宠物模型URL',
+  `created_at` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`,`id`),
+  KEY `idx_model_id` (`model_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='宠物表';
+
 -- =========================
 -- 宠物模型表
 -- =========================
@@ -45,12 +63,176 @@ CREATE TABLE `pet_models` (
   `type`       tinyint(1)   NOT NULL COMMENT '宠物类型 0-猫 1-狗（业务层转义）',
   `is_default` tinyint(1)   DEFAULT 0 COMMENT '是否默认模型（每类可有一个默认）',
   `sort_order` int(11)      DEFAULT 0 COMMENT '排序序号（越小越靠前）',
+  `unlock_level` int(11)    NOT NULL DEFAULT 0 COMMENT '解锁所需宠物等级（0/1 表示不限）',
+  `unlock_coins` int(11)    NOT NULL DEFAULT 0 COMMENT '解锁所需金币（0 表示免费；付费解锁记录见 user_pet_models）',
   `created_at` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   PRIMARY KEY (`id`),
   KEY `idx_type` (`type`),
   KEY `idx_sort_order` (`sort_order`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='宠物模型表';
 
+-- 用户用金币解锁的模型
+DROP TABLE IF EXISTS `user_pet_models`;
+CREATE TABLE `user_pet_models` (
+  `id`         bigint(20) NOT NULL AUTO_INCREMENT COMMENT '记录ID',
+  `user_id`    bigint(20) NOT NULL COMMENT '用户ID',
+  `model_id`   bigint(20) NOT NULL COMMENT '模型ID',
+  `coins`      int(11)    NOT NULL DEFAULT 0 COMMENT '花费金币',
+  `created_at` datetime   NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '解锁时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_model` (`user_id`,`model_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户已解锁模型';
+
+-- 宠物等级：每只宠物的累计经验（等级由经验按曲线计算，不单独存储）
+DROP TABLE IF EXISTS `pet_levels`;
+CREATE TABLE `pet_levels` (
+  `pet_id`     bigint(20) NOT NULL COMMENT '宠物ID',
+  `user_id`    bigint(20) NOT NULL COMMENT '用户ID',
+  `xp`         bigint(20) NOT NULL DEFAULT 0 COMMENT '累计经验',
+  `updated_at` datetime   NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '最近获得经验时间',
+  PRIMARY KEY (`pet_id`),
+  KEY `idx_user` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='宠物等级';
+
+-- 宠物经验获取记录（用于每日上限，上限按用户计算）
+DROP TABLE IF EXISTS `pet_xp_logs`;
+CREATE TABLE `pet_xp_logs` (
+  `id`         bigint(20)  NOT NULL AUTO_INCREMENT COMMENT '记录ID',
+  `user_id`    bigint(20)  NOT NULL COMMENT '用户ID',
+  `pet_id`     bigint(20)  NOT NULL DEFAULT 0 COMMENT '获得经验的宠物ID',
+  `source`     varchar(32) NOT NULL COMMENT '来源：pet_chatted/item_used/post_created/comment_created/post_liked',
+  `xp`         int(11)     NOT NULL COMMENT '获得经验',
+  `xp_date`    char(10)    NOT NULL COMMENT '日期（规则时区 yyyy-MM-dd）',
+  `created_at` datetime    NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '获得时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_date` (`user_id`,`xp_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='宠物经验记录';
+
+-- =========================
+-- 宠物健康档案
+-- =========================
+-- 体重记录（同一天可多条，趋势取当天最后一条）
+DROP TABLE IF EXISTS `pet_weights`;
+CREATE TABLE `pet_weights` (
+  `id`          bigint(20)   NOT NULL AUTO_INCREMENT COMMENT '记录ID',
+  `user_id`     bigint(20)   NOT NULL COMMENT '用户ID',
+  `pet_id`      bigint(20)   NOT NULL COMMENT '宠物ID',
+  `weight_kg`   decimal(6,2) NOT NULL COMMENT '体重(kg)',
+  `measured_on` char(10)     NOT NULL COMMENT '测量日期 yyyy-MM-dd',
+  `note`        varchar(255) NOT NULL DEFAULT '' COMMENT '备注',
+  `created_at`  datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '记录时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_pet_date` (`user_id`,`pet_id`,`measured_on`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='宠物体重记录';
+
+-- 疫苗/驱虫记录
+DROP TABLE IF EXISTS `pet_care_records`;
+CREATE TABLE `pet_care_records` (
+  `id`          bigint(20)   NOT NULL AUTO_INCREMENT COMMENT '记录ID',
+  `user_id`     bigint(20)   NOT NULL COMMENT '用户ID',
+  `pet_id`      bigint(20)   NOT NULL COMMENT '宠物ID',
+  `type`        varchar(16)  NOT NULL COMMENT '类型 vaccination-疫苗 deworming-驱虫',
+  `name`        varchar(100) NOT NULL COMMENT '疫苗/药品名称',
+  `given_on`    char(10)     NOT NULL COMMENT '接种/驱虫日期 yyyy-MM-dd',
+  `next_due_on` char(10)     NOT NULL DEFAULT '' COMMENT '下次到期日期 yyyy-MM-dd（空表示无）',
+  `clinic`      varchar(100) NOT NULL DEFAULT '' COMMENT '医院/机构',
+  `note`        varchar(500) NOT NULL DEFAULT '' COMMENT '备注',
+  `created_at`  datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at`  datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_pet_given` (`user_id`,`pet_id`,`given_on`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='宠物疫苗/驱虫记录';
+
+-- 就诊记录
+DROP TABLE IF EXISTS `pet_vet_visits`;
+CREATE TABLE `pet_vet_visits` (
+  `id`          bigint(20)   NOT NULL AUTO_INCREMENT COMMENT '记录ID',
+  `user_id`     bigint(20)   NOT NULL COMMENT '用户ID',
+  `pet_id`      bigint(20)   NOT NULL COMMENT '宠物ID',
+  `visited_on`  char(10)     NOT NULL COMMENT '就诊日期 yyyy-MM-dd',
+  `clinic`      varchar(100) NOT NULL DEFAULT '' COMMENT '医院',
+  `reason`      varchar(255) NOT NULL DEFAULT '' COMMENT '就诊原因',
+  `diagnosis`   text         NULL COMMENT '诊断',
+  `notes`       text         NULL COMMENT '医嘱/备注',
+  `attachments` text         NULL COMMENT '附件URL（逗号分隔）',
+  `created_at`  datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at`  datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_pet_visited` (`user_id`,`pet_id`,`visited_on`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='宠物就诊记录';
+
+-- 护理提醒：start_at/rrule 按 timezone 解释；next_run_at 为下次提醒的绝对时间（停用或已结束为 NULL）
+-- 调度器按 next_run_at 扫描到期提醒，并以 next_run_at 作为条件推进，多实例下同一次提醒只发送一次
+DROP TABLE IF EXISTS `reminders`;
+CREATE TABLE `reminders` (
+  `id`          bigint(20)   NOT NULL AUTO_INCREMENT COMMENT '提醒ID',
+  `user_id`     bigint(20)   NOT NULL COMMENT '用户ID',
+  `pet_id`      bigint(20)   NOT NULL COMMENT '宠物ID',
+  `kind`        varchar(16)  NOT NULL COMMENT '类型 feeding/medication/vaccine/deworming/other',
+  `title`       varchar(50)  NOT NULL COMMENT '标题',
+  `note`        varchar(255) NOT NULL DEFAULT '' COMMENT '备注',
+  `rrule`       varchar(255) NOT NULL DEFAULT '' COMMENT '重复规则（RRULE 子集，空为不重复）',
+  `timezone`    varchar(64)  NOT NULL COMMENT 'IANA 时区',
+  `start_at`    char(16)     NOT NULL COMMENT '起始时间 yyyy-MM-dd HH:mm（提醒时区）',
+  `enabled`     tinyint(1)   NOT NULL DEFAULT 1 COMMENT '是否启用',
+  `next_run_at` datetime     NULL COMMENT '下次提醒时间',
+  `last_run_at` datetime     NULL COMMENT '上次提醒时间',
+  `fired_count` int(11)      NOT NULL DEFAULT 0 COMMENT '已提醒次数',
+  `created_at`  datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at`  datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_pet` (`user_id`,`pet_id`),
+  KEY `idx_due` (`enabled`,`next_run_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='护理提醒';
+
+-- 宠物长期记忆：较早的聊天（最近上下文窗口之外）由后台任务整理为摘要（summary）与关于主人的事实（fact）
+-- from/to_message_id 为来源聊天消息范围；整理进度另存于 pet_memory_cursors，删除记忆不会使同一段聊天被重新整理
+DROP TABLE IF EXISTS `pet_memories`;
+CREATE TABLE `pet_memories` (
+  `id`              bigint(20)  NOT NULL AUTO_INCREMENT COMMENT '记忆ID',
+  `user_id`         bigint(20)  NOT NULL COMMENT '用户ID',
+  `pet_id`          bigint(20)  NOT NULL COMMENT '宠物ID',
+  `kind`            varchar(16) NOT NULL COMMENT '类型 summary/fact',
+  `content`         text        NOT NULL COMMENT '内容',
+  `from_message_id` bigint(20)  NOT NULL DEFAULT 0 COMMENT '来源聊天消息范围（起）',
+  `to_message_id`   bigint(20)  NOT NULL DEFAULT 0 COMMENT '来源聊天消息范围（止）',
+  `created_at`      datetime    NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_pet` (`user_id`,`pet_id`),
+  KEY `idx_pet_kind` (`pet_id`,`kind`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='宠物长期记忆';
+
+DROP TABLE IF EXISTS `pet_memory_cursors`;
+CREATE TABLE `pet_memory_cursors` (
+  `pet_id`       bigint(20) NOT NULL COMMENT '宠物ID',
+  `user_id`      bigint(20) NOT NULL COMMENT '用户ID',
+  `message_id`   bigint(20) NOT NULL DEFAULT 0 COMMENT '已整理到的聊天消息ID',
+  `attempted_at` datetime   DEFAULT NULL COMMENT '最近一次整理尝试时间',
+  `updated_at`   datetime   NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`pet_id`),
+  KEY `idx_user` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='宠物记忆整理进度';
+
+-- 提示词模板：text/template 语法，可用变量见 biz.PromptVars
+-- 按 (scene, model_type, model_id) 分槽位：model_id>0 只用于该模型，否则 model_type 为 -1(全部)/0/1；
+-- 每次修改新增一个版本，同一槽位只有一个版本启用，重新启用旧版本即回滚；没有启用的模板时使用内置模板
+DROP TABLE IF EXISTS `prompt_templates`;
+CREATE TABLE `prompt_templates` (
+  `id`         bigint(20)   NOT NULL AUTO_INCREMENT COMMENT '模板ID',
+  `scene`      varchar(16)  NOT NULL COMMENT '场景 chat=聊天 note=小纸条',
+  `model_type` int(11)      NOT NULL DEFAULT -1 COMMENT '适用的模型类型（-1 为全部）',
+  `model_id`   bigint(20)   NOT NULL DEFAULT 0 COMMENT '适用的模型ID（0 为不限）',
+  `version`    int(11)      NOT NULL COMMENT '版本号',
+  `content`    text         NOT NULL COMMENT '模板内容',
+  `note`       varchar(255) NOT NULL DEFAULT '' COMMENT '修改说明',
+  `is_active`  tinyint(1)   NOT NULL DEFAULT 0 COMMENT '是否启用',
+  `created_by` bigint(20)   NOT NULL DEFAULT 0 COMMENT '创建人',
+  `created_at` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_slot_version` (`scene`,`model_type`,`model_id`,`version`),
+  KEY `idx_scene_active` (`scene`,`is_active`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='提示词模板';
+
 -- =========================
 -- 道具表
 -- =========================
@@ -61,28 +243,75 @@ CREATE TABLE `items` (
   `description` varchar(255) DEFAULT NULL COMMENT '道具描述',
   `icon_path`   varchar(255) DEFAULT NULL COMMENT '道具图标URL',
   `coin_cost`   int(11)      DEFAULT 0 COMMENT '使用/解锁所需金币',
+  `sort_order`  int(11)      NOT NULL DEFAULT 0 COMMENT '排序序号（越小越靠前）',
+  `effects`     varchar(255) NOT NULL DEFAULT '' COMMENT '使用效果：逗号分隔的 stat:+N，如 hunger:+30,mood:+10（stat 为 hunger/mood/energy/cleanliness）',
   `created_at`  datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
-  PRIMARY KEY (`id`)
+  PRIMARY KEY (`id`),
+  KEY `idx_sort_order` (`sort_order`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='道具表';
 
+-- 宠物状态：每只宠物一行，保存 updated_at 时刻的属性值（0-100），读取时按经过时长惰性衰减
+DROP TABLE IF EXISTS `pet_states`;
+CREATE TABLE `pet_states` (
+  `pet_id`      bigint(20)   NOT NULL COMMENT '宠物ID',
+  `user_id`     bigint(20)   NOT NULL COMMENT '用户ID',
+  `hunger`      decimal(5,2) NOT NULL DEFAULT 80 COMMENT '饱食度',
+  `mood`        decimal(5,2) NOT NULL DEFAULT 80 COMMENT '心情',
+  `energy`      decimal(5,2) NOT NULL DEFAULT 80 COMMENT '精力',
+  `cleanliness` decimal(5,2) NOT NULL DEFAULT 80 COMMENT '清洁度',
+  `updated_at`  datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '属性值对应的时刻',
+  PRIMARY KEY (`pet_id`),
+  KEY `idx_user` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='宠物状态';
+
+-- 用户背包：购买道具后入包，使用时消耗数量
+DROP TABLE IF EXISTS `user_items`;
+CREATE TABLE `user_items` (
+  `id`         bigint(20) NOT NULL AUTO_INCREMENT COMMENT '记录ID',
+  `user_id`    bigint(20) NOT NULL COMMENT '用户ID',
+  `item_id`    bigint(20) NOT NULL COMMENT '道具ID',
+  `quantity`   int(11)    NOT NULL DEFAULT 0 COMMENT '持有数量',
+  `created_at` datetime   NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '首次获得时间',
+  `updated_at` datetime   NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最近变动时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_item` (`user_id`,`item_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户背包';
+
+-- 道具购买记录（单价为购买时的价格）
+DROP TABLE IF EXISTS `item_purchases`;
+CREATE TABLE `item_purchases` (
+  `id`         bigint(20) NOT NULL AUTO_INCREMENT COMMENT '购买记录ID',
+  `user_id`    bigint(20) NOT NULL COMMENT '用户ID',
+  `item_id`    bigint(20) NOT NULL COMMENT '道具ID',
+  `quantity`   int(11)    NOT NULL COMMENT '购买数量',
+  `unit_cost`  int(11)    NOT NULL DEFAULT 0 COMMENT '单价',
+  `total_cost` int(11)    NOT NULL DEFAULT 0 COMMENT '总花费',
+  `coin_tx_id` bigint(20) NOT NULL DEFAULT 0 COMMENT '金币流水ID（免费道具为 0）',
+  `created_at` datetime   NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '购买时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`,`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='道具购买记录';
+
 -- =========================
--- 聊天：按用户维度的消息表（含小纸条）
+-- 聊天：按用户与宠物维度的消息表（含小纸条）
 -- =========================
 -- sender: 0-用户 1-AI
--- message_type: 0-普通聊天 1-小纸条
+-- message_type: 0-普通聊天 1-小纸条 2-护理提醒（到点时宠物发来，归属提醒的宠物）
 -- 小纸条使用 is_locked/unlock_coins 控制解锁；普通消息两字段恒为 0
 DROP TABLE IF EXISTS `messages`;
 CREATE TABLE `messages` (
   `id`           bigint(20)  NOT NULL AUTO_INCREMENT COMMENT '消息ID',
-  `user_id`      bigint(20)  NOT NULL COMMENT '归属用户ID（每个用户一个“会话”概念）',
+  `user_id`      bigint(20)  NOT NULL COMMENT '归属用户ID',
+  `pet_id`       bigint(20)  NOT NULL DEFAULT 0 COMMENT '聊天对象宠物ID（每只宠物一个“会话”；小纸条为 0）',
   `sender`       tinyint(1)  NOT NULL COMMENT '发送方 0-用户 1-AI',
-  `message_type` tinyint(1)  NOT NULL DEFAULT 0 COMMENT '消息类型 0-聊天 1-小纸条',
+  `message_type` tinyint(1)  NOT NULL DEFAULT 0 COMMENT '消息类型 0-聊天 1-小纸条 2-提醒',
   `is_locked`    tinyint(1)  NOT NULL DEFAULT 0 COMMENT '是否锁定（仅小纸条使用）',
   `unlock_coins` int(11)     NOT NULL DEFAULT 0 COMMENT '解锁所需金币（仅小纸条使用）',
   `content`      text         NOT NULL COMMENT '消息内容',
   `created_at`   datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   PRIMARY KEY (`id`),
   KEY `idx_user_time` (`user_id`,`id`),
+  KEY `idx_user_pet` (`user_id`,`pet_id`,`id`),
   KEY `idx_user_type` (`user_id`,`message_type`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='消息表（用户与AI聊天+小纸条）';
 
@@ -99,6 +328,52 @@ CREATE TABLE `user_unlock_records` (
   KEY `idx_message_id` (`message_id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户小纸条解锁记录';
 
+-- 金币流水（users.coins 的每次变动都对应一条记录，由钱包用例在同一事务内写入）
+DROP TABLE IF EXISTS `coin_transactions`;
+CREATE TABLE `coin_transactions` (
+  `id`              bigint(20)  NOT NULL AUTO_INCREMENT COMMENT '流水ID',
+  `user_id`         bigint(20)  NOT NULL COMMENT '用户ID',
+  `amount`          int(11)     NOT NULL COMMENT '变动金额：正数入账，负数出账',
+  `balance`         int(11)     DEFAULT NULL COMMENT '变动后余额（由历史解锁记录迁移的流水为 NULL）',
+  `reason`          varchar(32) NOT NULL COMMENT '原因 item_purchase/item_use/note_unlock/reward/gift',
+  `ref_type`        varchar(16) NOT NULL DEFAULT '' COMMENT '关联对象类型 item/message/post/check_in',
+  `ref_id`          bigint(20)  NOT NULL DEFAULT 0 COMMENT '关联对象ID',
+  `counterparty_id` bigint(20)  NOT NULL DEFAULT 0 COMMENT '转账对方用户ID',
+  `created_at`      datetime    NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '发生时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`,`id`),
+  KEY `idx_ref` (`ref_type`,`ref_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='金币流水';
+
+-- 每日签到（check_date 为用户时区的自然日，唯一键保证每天只奖励一次）
+DROP TABLE IF EXISTS `check_ins`;
+CREATE TABLE `check_ins` (
+  `id`         bigint(20)  NOT NULL AUTO_INCREMENT COMMENT '签到ID',
+  `user_id`    bigint(20)  NOT NULL COMMENT '用户ID',
+  `check_date` char(10)    NOT NULL COMMENT '签到日期 yyyy-MM-dd（用户时区）',
+  `streak`     int(11)     NOT NULL DEFAULT 1 COMMENT '连续签到天数（含当天）',
+  `reward`     int(11)     NOT NULL DEFAULT 0 COMMENT '奖励金币',
+  `timezone`   varchar(64) NOT NULL DEFAULT '' COMMENT '签到时使用的时区',
+  `created_at` datetime    NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '签到时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_date` (`user_id`,`check_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='每日签到';
+
+-- 活跃奖励记录（发帖/获赞/评论/聊天；唯一键保证同一行为只奖励一次，reward_date 用于每日上限）
+DROP TABLE IF EXISTS `activity_rewards`;
+CREATE TABLE `activity_rewards` (
+  `id`          bigint(20)  NOT NULL AUTO_INCREMENT COMMENT '奖励ID',
+  `user_id`     bigint(20)  NOT NULL COMMENT '获得奖励的用户ID',
+  `rule`        varchar(32) NOT NULL COMMENT '规则 post_created/post_liked/comment_created/pet_chatted',
+  `dedupe_key`  varchar(64) NOT NULL COMMENT '去重键',
+  `reward_date` char(10)    NOT NULL COMMENT '奖励日期 yyyy-MM-dd',
+  `coins`       int(11)     NOT NULL DEFAULT 0 COMMENT '奖励金币',
+  `created_at`  datetime    NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '奖励时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_rule_key` (`user_id`,`rule`,`dedupe_key`),
+  KEY `idx_user_rule_date` (`user_id`,`rule`,`reward_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='活跃奖励记录';
+
 -- =========================
 -- 社区：分类 + 帖子 + 评论 + 点赞 + 关注
 -- =========================
@@ -173,6 +448,67 @@ CREATE TABLE `user_follows` (
   KEY `idx_followee` (`followee_id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户关注关系表';
 
+-- 登录会话表（refresh token 轮换；仅保存 token 摘要）
+DROP TABLE IF EXISTS `user_sessions`;
+CREATE TABLE `user_sessions` (
+  `id`                bigint(20)  NOT NULL AUTO_INCREMENT COMMENT '会话ID',
+  `user_id`           bigint(20)  NOT NULL COMMENT '用户ID',
+  `refresh_hash`      char(64)    NOT NULL COMMENT '当前 refresh token 的 SHA-256 摘要（hex）',
+  `access_jti`        varchar(64) NOT NULL DEFAULT '' COMMENT '当前 access token 的 jti',
+  `access_expires_at` datetime    NOT NULL COMMENT '当前 access token 过期时间',
+  `expires_at`        datetime    NOT NULL COMMENT 'refresh token 过期时间',
+  `user_agent`        varchar(255) NOT NULL DEFAULT '' COMMENT '登录时的 User-Agent',
+  `ip`                varchar(64) NOT NULL DEFAULT '' COMMENT '登录时的客户端 IP',
+  `last_seen_at`      datetime    NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '最近活跃时间（批量刷新）',
+  `revoked_at`        datetime    DEFAULT NULL COMMENT '吊销时间（NULL 表示有效）',
+  `created_at`        datetime    NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at`        datetime    NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_refresh_hash` (`refresh_hash`),
+  KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户登录会话表';
+
+-- 密码重置验证码表（单次有效；仅保存验证码哈希）
+DROP TABLE IF EXISTS `password_resets`;
+CREATE TABLE `password_resets` (
+  `id`         bigint(20)   NOT NULL AUTO_INCREMENT COMMENT '记录ID',
+  `user_id`    bigint(20)   NOT NULL COMMENT '用户ID',
+  `code_hash`  varchar(255) NOT NULL COMMENT '验证码 bcrypt 哈希',
+  `attempts`   int(11)      NOT NULL DEFAULT 0 COMMENT '已尝试次数',
+  `expires_at` datetime     NOT NULL COMMENT '过期时间',
+  `used_at`    datetime     DEFAULT NULL COMMENT '使用/作废时间（NULL 表示未使用）',
+  `created_at` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_created` (`user_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='密码重置验证码表';
+
+-- 第三方登录绑定：同一外部账号只能绑定一个用户，同一用户每个渠道最多绑定一个外部账号
+DROP TABLE IF EXISTS `user_identities`;
+CREATE TABLE `user_identities` (
+  `id`            bigint(20)   NOT NULL AUTO_INCREMENT COMMENT '记录ID',
+  `user_id`       bigint(20)   NOT NULL COMMENT '用户ID',
+  `provider`      varchar(16)  NOT NULL COMMENT '渠道名（wechat/apple 等）',
+  `subject`       varchar(128) NOT NULL COMMENT '渠道内唯一ID（openid/sub）',
+  `nickname`      varchar(100) NOT NULL DEFAULT '' COMMENT '绑定时的外部昵称',
+  `avatar`        varchar(255) NOT NULL DEFAULT '' COMMENT '绑定时的外部头像',
+  `created_at`    datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '绑定时间',
+  `last_login_at` datetime     DEFAULT NULL COMMENT '最近一次通过该渠道登录的时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_provider_subject` (`provider`,`subject`),
+  UNIQUE KEY `uk_user_provider` (`user_id`,`provider`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='第三方登录绑定表';
+
+-- 目录版本表：pet_models/items/categories 每次被管理端修改时版本 +1（与修改同一事务）
+-- 客户端据版本号或 ETag 判断缓存是否过期
+DROP TABLE IF EXISTS `catalog_versions`;
+CREATE TABLE `catalog_versions` (
+  `catalog`    varchar(32)  NOT NULL COMMENT '目录名 pet_models/items/categories',
+  `version`    bigint(20)   NOT NULL DEFAULT 0 COMMENT '版本号',
+  `updated_at` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`catalog`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='目录版本表';
+INSERT INTO `catalog_versions` (`catalog`, `version`) VALUES ('pet_models', 1), ('items', 1), ('categories', 1);
+
 -- -- =========================
 -- -- 默认数据
 -- -- =========================
@@ -18,6 +18,10 @@ UNION ALL SELECT '天使狗-默认','/models/dog/angel_default.glb',1,1,1 FROM D
 UNION ALL SELECT '天使狗-金毛','/models/dog/golden.glb',1,0,2 FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM pet_models WHERE name='天使狗-金毛')
 UNION ALL SELECT '天使狗-柯基','/models/dog/corgi.glb',1,0,3 FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM pet_models WHERE name='天使狗-柯基');
 
+-- 模型解锁条件（默认模型不设条件）
+UPDATE `pet_models` SET `unlock_level`=3 WHERE `name` IN ('天使猫-粉','天使狗-金毛');
+UPDATE `pet_models` SET `unlock_level`=5, `unlock_coins`=200 WHERE `name` IN ('天使猫-蓝','天使狗-柯基');
+
 -- =========================
 -- 道具（金币消耗数值对齐产品方案）
 -- =========================
@@ -35,6 +39,18 @@ UNION ALL SELECT '猫爬架（永久）','心情提升','/items/cat_tree.png',20
 UNION ALL SELECT '智能摄像头（月卡）','远程查看与录像','/items/camera_month.png',180 FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM items WHERE name='智能摄像头（月卡）')
 UNION ALL SELECT '专属头像框','个人主页外观','/items/avatar_frame.png',60 FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM items WHERE name='专属头像框');
 
+-- 道具使用效果（作用于宠物状态 pet_states，格式 stat:+N，逗号分隔；为空表示无效果）
+UPDATE `items` SET `effects`='hunger:+30' WHERE `name`='普通猫粮（单次）';
+UPDATE `items` SET `effects`='hunger:+40,mood:+10' WHERE `name`='营养套餐（3次）';
+UPDATE `items` SET `effects`='hunger:+60,mood:+15,energy:+10' WHERE `name`='豪华鲜食（周卡）';
+UPDATE `items` SET `effects`='hunger:+40,mood:+25' WHERE `name`='限定节日食物';
+UPDATE `items` SET `effects`='cleanliness:+40' WHERE `name`='基础猫砂（单次）';
+UPDATE `items` SET `effects`='cleanliness:+70' WHERE `name`='除臭猫砂（3次）';
+UPDATE `items` SET `effects`='cleanliness:+100' WHERE `name`='智能厕所（永久）';
+UPDATE `items` SET `effects`='mood:+20,energy:-5' WHERE `name`='逗猫棒（单次）';
+UPDATE `items` SET `effects`='mood:+25,energy:-10' WHERE `name`='电动老鼠（3次）';
+UPDATE `items` SET `effects`='mood:+30,energy:+10' WHERE `name`='猫爬架（永久）';
+
 -- =========================
 -- 社区分类（丧宠关怀 + 通用养宠）
 -- 注意：1-init 中已含 日常/知识/信息/种草，这里补充其余标签
@@ -58,19 +74,34 @@ UNION ALL SELECT '萌宠摄影',30 FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM cate
 
 -- =========================
 -- 用户（示例数据，密码明文 123456，便于联调；生产必须改为 bcrypt）
--- model_url 以当前所选 pet_models.path 为准，可后续由服务端刷新
--- =========================
-INSERT INTO `users`(`nickname`,`username`,`password`,`avatar`,`model_id`,`model_url`,`pet_name`,`pet_avatar`,`pet_sex`,`kind`,`weight`,`hobby`,`description`,`coins`,`created_at`,`updated_at`)
-SELECT 'Lemon','lemon','123456','/avatars/u1.png',1,'/models/cat/angel_default.glb','柚子','/pets/p1.png',2,'cat',4,'晒太阳','爱笑的猫咪',300,'2025-03-01 10:00:00','2025-03-01 10:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='lemon')
-UNION ALL SELECT 'Ache','ache','123456','/avatars/u2.png',4,'/models/dog/angel_default.glb','阿奇','/pets/p2.png',1,'dog',9,'跑步','元气小狗',260,'2025-03-02 11:00:00','2025-03-02 11:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='ache')
-UNION ALL SELECT 'momo','momo','123456','/avatars/u3.png',2,'/models/cat/angel_pink.glb','桃桃','/pets/p3.png',2,'cat',5,'午睡','慵懒可爱',180,'2025-03-03 12:00:00','2025-03-03 12:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='momo')
-UNION ALL SELECT 'Dev','dev','123456','/avatars/u4.png',5,'/models/dog/golden.glb','可可','/pets/p4.png',1,'dog',18,'捡球','忠诚陪伴',120,'2025-03-04 13:00:00','2025-03-04 13:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='dev')
-UNION ALL SELECT 'Nana','nana','123456','/avatars/u5.png',3,'/models/cat/angel_blue.glb','奶糖','/pets/p5.png',2,'cat',3,'梳毛','软萌仔',90,'2025-03-05 14:00:00','2025-03-05 14:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='nana')
-UNION ALL SELECT 'Yuki','yuki','123456','/avatars/u6.png',6,'/models/dog/corgi.glb','雪球','/pets/p6.png',1,'dog',10,'摄影','短腿行侠',60,'2025-03-06 15:00:00','2025-03-06 15:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='yuki')
-UNION ALL SELECT 'Kiki','kiki','123456','/avatars/u7.png',1,'/models/cat/angel_default.glb','奇奇','/pets/p7.png',2,'cat',6,'晒太阳','爱吃罐头',40,'2025-03-07 16:20:00','2025-03-07 16:20:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='kiki')
-UNION ALL SELECT '七七','seven','123456','/avatars/u8.png',1,'/models/cat/angel_default.glb','七七','/pets/p8.png',2,'cat',7,'爬窗台','温柔粘人',500,'2025-03-08 09:30:00','2025-03-08 09:30:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='seven')
-UNION ALL SELECT 'Lemon-粉丝','lemon_fan','123456','/avatars/u9.png',1,'/models/cat/angel_default.glb','小柚','/pets/p9.png',2,'cat',4,'收藏','忠实粉丝',80,'2025-03-09 09:10:00','2025-03-09 09:10:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='lemon_fan')
-UNION ALL SELECT '摄影师','photographer','123456','/avatars/u10.png',4,'/models/dog/angel_default.glb','阿布','/pets/p10.png',1,'dog',14,'摄影','镜头里的宠物',220,'2025-03-09 10:20:00','2025-03-09 10:20:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='photographer');
+-- 宠物资料在 pets 表；pets.model_url 以所选 pet_models.path 为准，可后续由服务端刷新
+-- =========================
+INSERT INTO `users`(`nickname`,`username`,`password`,`avatar`,`description`,`coins`,`created_at`,`updated_at`)
+SELECT 'Lemon','lemon','123456','/avatars/u1.png','爱笑的猫咪',300,'2025-03-01 10:00:00','2025-03-01 10:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='lemon')
+UNION ALL SELECT 'Ache','ache','123456','/avatars/u2.png','元气小狗',260,'2025-03-02 11:00:00','2025-03-02 11:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='ache')
+UNION ALL SELECT 'momo','momo','123456','/avatars/u3.png','慵懒可爱',180,'2025-03-03 12:00:00','2025-03-03 12:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='momo')
+UNION ALL SELECT 'Dev','dev','123456','/avatars/u4.png','忠诚陪伴',120,'2025-03-04 13:00:00','2025-03-04 13:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='dev')
+UNION ALL SELECT 'Nana','nana','123456','/avatars/u5.png','软萌仔',90,'2025-03-05 14:00:00','2025-03-05 14:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='nana')
+UNION ALL SELECT 'Yuki','yuki','123456','/avatars/u6.png','短腿行侠',60,'2025-03-06 15:00:00','2025-03-06 15:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='yuki')
+UNION ALL SELECT 'Kiki','kiki','123456','/avatars/u7.png','爱吃罐头',40,'2025-03-07 16:20:00','2025-03-07 16:20:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='kiki')
+UNION ALL SELECT '七七','seven','123456','/avatars/u8.png','温柔粘人',500,'2025-03-08 09:30:00','2025-03-08 09:30:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='seven')
+UNION ALL SELECT 'Lemon-粉丝','lemon_fan','123456','/avatars/u9.png','忠实粉丝',80,'2025-03-09 09:10:00','2025-03-09 09:10:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='lemon_fan')
+UNION ALL SELECT '摄影师','photographer','123456','/avatars/u10.png','镜头里的宠物',220,'2025-03-09 10:20:00','2025-03-09 10:20:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='photographer');
+
+-- 每位用户的第一只宠物（设为当前宠物）
+INSERT INTO `pets`(`user_id`,`name`,`avatar`,`sex`,`kind`,`weight`,`hobby`,`model_id`,`model_url`,`created_at`,`updated_at`)
+SELECT u.id,'柚子','/pets/p1.png',2,'cat',4,'晒太阳',1,'/models/cat/angel_default.glb','2025-03-01 10:00:00','2025-03-01 10:00:00' FROM users u WHERE u.username='lemon' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'阿奇','/pets/p2.png',1,'dog',9,'跑步',4,'/models/dog/angel_default.glb','2025-03-02 11:00:00','2025-03-02 11:00:00' FROM users u WHERE u.username='ache' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'桃桃','/pets/p3.png',2,'cat',5,'午睡',2,'/models/cat/angel_pink.glb','2025-03-03 12:00:00','2025-03-03 12:00:00' FROM users u WHERE u.username='momo' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'可可','/pets/p4.png',1,'dog',18,'捡球',5,'/models/dog/golden.glb','2025-03-04 13:00:00','2025-03-04 13:00:00' FROM users u WHERE u.username='dev' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'奶糖','/pets/p5.png',2,'cat',3,'梳毛',3,'/models/cat/angel_blue.glb','2025-03-05 14:00:00','2025-03-05 14:00:00' FROM users u WHERE u.username='nana' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'雪球','/pets/p6.png',1,'dog',10,'摄影',6,'/models/dog/corgi.glb','2025-03-06 15:00:00','2025-03-06 15:00:00' FROM users u WHERE u.username='yuki' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'奇奇','/pets/p7.png',2,'cat',6,'晒太阳',1,'/models/cat/angel_default.glb','2025-03-07 16:20:00','2025-03-07 16:20:00' FROM users u WHERE u.username='kiki' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'七七','/pets/p8.png',2,'cat',7,'爬窗台',1,'/models/cat/angel_default.glb','2025-03-08 09:30:00','2025-03-08 09:30:00' FROM users u WHERE u.username='seven' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'小柚','/pets/p9.png',2,'cat',4,'收藏',1,'/models/cat/angel_default.glb','2025-03-09 09:10:00','2025-03-09 09:10:00' FROM users u WHERE u.username='lemon_fan' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'阿布','/pets/p10.png',1,'dog',14,'摄影',4,'/models/dog/angel_default.glb','2025-03-09 10:20:00','2025-03-09 10:20:00' FROM users u WHERE u.username='photographer' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id);
+UPDATE `users` u SET u.active_pet_id=(SELECT MIN(p.id) FROM pets p WHERE p.user_id=u.id)
+WHERE u.active_pet_id=0 AND EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id);
 
 -- =========================
 -- 关注关系（去重幂等）
@@ -182,26 +213,26 @@ ON DUPLICATE KEY UPDATE `created_at`=VALUES(`created_at`);
 -- 消息/小纸条（message_type：0聊天 1小纸条）
 -- =========================
 -- 聊天示例：用户→AI 两条
-INSERT INTO `messages`(`user_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`) 
-SELECT u.id, 0, 0, 0, 0, '今天有点想它了……', '2025-03-16 20:00:00' FROM users u WHERE u.username='lemon'
+INSERT INTO `messages`(`user_id`,`pet_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`) 
+SELECT u.id, u.active_pet_id, 0, 0, 0, 0, '今天有点想它了……', '2025-03-16 20:00:00' FROM users u WHERE u.username='lemon'
 UNION ALL
-SELECT u.id, 1, 0, 0, 0, '我在呢，会一直陪着你～', '2025-03-16 20:00:05' FROM users u WHERE u.username='lemon';
+SELECT u.id, u.active_pet_id, 1, 0, 0, 0, '我在呢，会一直陪着你～', '2025-03-16 20:00:05' FROM users u WHERE u.username='lemon';
 
 -- 小纸条示例：每日晚间隐私留言（锁定，20金币）
-INSERT INTO `messages`(`user_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
-SELECT u.id, 1, 1, 1, 20, '今晚也别熬夜，我会在梦里见到你', '2025-03-16 21:30:00' FROM users u WHERE u.username='lemon'
+INSERT INTO `messages`(`user_id`,`pet_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
+SELECT u.id, 0, 1, 1, 1, 20, '今晚也别熬夜，我会在梦里见到你', '2025-03-16 21:30:00' FROM users u WHERE u.username='lemon'
 UNION ALL
-SELECT u.id, 1, 1, 1, 20, '别担心，我一直在你身边', '2025-03-16 21:31:00' FROM users u WHERE u.username='momo';
+SELECT u.id, 0, 1, 1, 1, 20, '别担心，我一直在你身边', '2025-03-16 21:31:00' FROM users u WHERE u.username='momo';
 
 -- 更多消息/小纸条：为多位用户生成样例
-INSERT INTO `messages`(`user_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
-SELECT u.id, 1, 1, 1, 20, '今晚我会在窗边等你回来', '2025-03-17 21:10:00' FROM users u WHERE u.username='kiki'
+INSERT INTO `messages`(`user_id`,`pet_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
+SELECT u.id, 0, 1, 1, 1, 20, '今晚我会在窗边等你回来', '2025-03-17 21:10:00' FROM users u WHERE u.username='kiki'
 UNION ALL
-SELECT u.id, 1, 1, 1, 20, '别忘了早点休息，明天一起晒太阳', '2025-03-17 21:15:00' FROM users u WHERE u.username='nana'
+SELECT u.id, 0, 1, 1, 1, 20, '别忘了早点休息，明天一起晒太阳', '2025-03-17 21:15:00' FROM users u WHERE u.username='nana'
 UNION ALL
-SELECT u.id, 0, 0, 0, 0, '今天拍到它打哈欠啦', '2025-03-17 18:20:00' FROM users u WHERE u.username='photographer'
+SELECT u.id, u.active_pet_id, 0, 0, 0, 0, '今天拍到它打哈欠啦', '2025-03-17 18:20:00' FROM users u WHERE u.username='photographer'
 UNION ALL
-SELECT u.id, 1, 0, 0, 0, '你拍得真好看～', '2025-03-17 18:20:05' FROM users u WHERE u.username='photographer';
+SELECT u.id, u.active_pet_id, 1, 0, 0, 0, '你拍得真好看～', '2025-03-17 18:20:05' FROM users u WHERE u.username='photographer';
 
 SET FOREIGN_KEY_CHECKS = 1;
 
@@ -21,17 +21,32 @@ UPDATE pet_models SET path = '/models/Dog_5.glb' WHERE name LIKE '%狗%' AND sor
 -- =========================
 -- 2. 生成更多用户（15个用户）
 -- =========================
-INSERT INTO `users`(`nickname`,`username`,`password`,`avatar`,`model_id`,`model_url`,`pet_name`,`pet_avatar`,`pet_sex`,`kind`,`weight`,`hobby`,`description`,`coins`,`created_at`,`updated_at`)
-SELECT '小天使','angel001','123456','/static/avatar/angel001.jpg',1,'/models/Cat_1.glb','小天使','/static/pet/angel001.jpg',2,'cat',4,'晒太阳,睡觉','治愈系小天使',450,'2025-01-15 10:00:00','2025-01-15 10:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='angel001')
-UNION ALL SELECT '治愈师','healer002','123456','/static/avatar/healer002.jpg',6,'/models/Dog_1.glb','治愈','/static/pet/healer002.jpg',1,'dog',12,'陪伴,治愈','专业治愈师',380,'2025-01-20 11:00:00','2025-01-20 11:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='healer002')
-UNION ALL SELECT '回忆录','memory003','123456','/static/avatar/memory003.jpg',2,'/models/Cat_2.glb','回忆','/static/pet/memory003.jpg',2,'cat',5,'回忆,记录','记录美好回忆',520,'2025-02-01 12:00:00','2025-02-01 12:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='memory003')
-UNION ALL SELECT '守护者','guardian004','123456','/static/avatar/guardian004.jpg',7,'/models/Dog_2.glb','守护','/static/pet/guardian004.jpg',1,'dog',15,'守护,陪伴','永远的守护者',290,'2025-02-10 13:00:00','2025-02-10 13:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='guardian004')
-UNION ALL SELECT '温暖家','warm005','123456','/static/avatar/warm005.jpg',3,'/models/Cat_3.glb','温暖','/static/pet/warm005.jpg',2,'cat',6,'温暖,陪伴','温暖的家',180,'2025-02-15 14:00:00','2025-02-15 14:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='warm005')
-UNION ALL SELECT '快乐狗','happy006','123456','/static/avatar/happy006.jpg',8,'/models/Dog_3.glb','快乐','/static/pet/happy006.jpg',1,'dog',8,'快乐,奔跑','快乐的小短腿',320,'2025-02-20 15:00:00','2025-02-20 15:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='happy006')
-UNION ALL SELECT '温柔猫','gentle007','123456','/static/avatar/gentle007.jpg',4,'/models/Cat_4.glb','温柔','/static/pet/gentle007.jpg',2,'cat',7,'温柔,粘人','温柔的布偶猫',410,'2025-03-01 16:00:00','2025-03-01 16:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='gentle007')
-UNION ALL SELECT '忠诚狗','loyal008','123456','/static/avatar/loyal008.jpg',9,'/models/Dog_4.glb','忠诚','/static/pet/loyal008.jpg',1,'dog',14,'忠诚,陪伴','忠诚的伙伴',260,'2025-03-05 17:00:00','2025-03-05 17:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='loyal008')
-UNION ALL SELECT '治愈猫','healcat009','123456','/static/avatar/healcat009.jpg',5,'/models/Cat_5.glb','治愈','/static/pet/healcat009.jpg',2,'cat',4,'治愈,陪伴','治愈系暹罗',350,'2025-03-10 18:00:00','2025-03-10 18:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='healcat009')
-UNION ALL SELECT '陪伴狗','companion010','123456','/static/avatar/companion010.jpg',10,'/models/Dog_5.glb','陪伴','/static/pet/companion010.jpg',1,'dog',13,'陪伴,聪明','聪明的边牧',480,'2025-03-15 19:00:00','2025-03-15 19:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='companion010');
+INSERT INTO `users`(`nickname`,`username`,`password`,`avatar`,`description`,`coins`,`created_at`,`updated_at`)
+SELECT '小天使','angel001','123456','/static/avatar/angel001.jpg','治愈系小天使',450,'2025-01-15 10:00:00','2025-01-15 10:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='angel001')
+UNION ALL SELECT '治愈师','healer002','123456','/static/avatar/healer002.jpg','专业治愈师',380,'2025-01-20 11:00:00','2025-01-20 11:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='healer002')
+UNION ALL SELECT '回忆录','memory003','123456','/static/avatar/memory003.jpg','记录美好回忆',520,'2025-02-01 12:00:00','2025-02-01 12:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='memory003')
+UNION ALL SELECT '守护者','guardian004','123456','/static/avatar/guardian004.jpg','永远的守护者',290,'2025-02-10 13:00:00','2025-02-10 13:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='guardian004')
+UNION ALL SELECT '温暖家','warm005','123456','/static/avatar/warm005.jpg','温暖的家',180,'2025-02-15 14:00:00','2025-02-15 14:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='warm005')
+UNION ALL SELECT '快乐狗','happy006','123456','/static/avatar/happy006.jpg','快乐的小短腿',320,'2025-02-20 15:00:00','2025-02-20 15:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='happy006')
+UNION ALL SELECT '温柔猫','gentle007','123456','/static/avatar/gentle007.jpg','温柔的布偶猫',410,'2025-03-01 16:00:00','2025-03-01 16:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='gentle007')
+UNION ALL SELECT '忠诚狗','loyal008','123456','/static/avatar/loyal008.jpg','忠诚的伙伴',260,'2025-03-05 17:00:00','2025-03-05 17:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='loyal008')
+UNION ALL SELECT '治愈猫','healcat009','123456','/static/avatar/healcat009.jpg','治愈系暹罗',350,'2025-03-10 18:00:00','2025-03-10 18:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='healcat009')
+UNION ALL SELECT '陪伴狗','companion010','123456','/static/avatar/companion010.jpg','聪明的边牧',480,'2025-03-15 19:00:00','2025-03-15 19:00:00' FROM DUAL WHERE NOT EXISTS(SELECT 1 FROM users WHERE username='companion010');
+
+-- 每位用户的第一只宠物（设为当前宠物）
+INSERT INTO `pets`(`user_id`,`name`,`avatar`,`sex`,`kind`,`weight`,`hobby`,`model_id`,`model_url`,`created_at`,`updated_at`)
+SELECT u.id,'小天使','/static/pet/angel001.jpg',2,'cat',4,'晒太阳,睡觉',1,'/models/Cat_1.glb','2025-01-15 10:00:00','2025-01-15 10:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'治愈','/static/pet/healer002.jpg',1,'dog',12,'陪伴,治愈',6,'/models/Dog_1.glb','2025-01-20 11:00:00','2025-01-20 11:00:00' FROM users u WHERE u.username='healer002' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'回忆','/static/pet/memory003.jpg',2,'cat',5,'回忆,记录',2,'/models/Cat_2.glb','2025-02-01 12:00:00','2025-02-01 12:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'守护','/static/pet/guardian004.jpg',1,'dog',15,'守护,陪伴',7,'/models/Dog_2.glb','2025-02-10 13:00:00','2025-02-10 13:00:00' FROM users u WHERE u.username='guardian004' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'温暖','/static/pet/warm005.jpg',2,'cat',6,'温暖,陪伴',3,'/models/Cat_3.glb','2025-02-15 14:00:00','2025-02-15 14:00:00' FROM users u WHERE u.username='warm005' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'快乐','/static/pet/happy006.jpg',1,'dog',8,'快乐,奔跑',8,'/models/Dog_3.glb','2025-02-20 15:00:00','2025-02-20 15:00:00' FROM users u WHERE u.username='happy006' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'温柔','/static/pet/gentle007.jpg',2,'cat',7,'温柔,粘人',4,'/models/Cat_4.glb','2025-03-01 16:00:00','2025-03-01 16:00:00' FROM users u WHERE u.username='gentle007' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'忠诚','/static/pet/loyal008.jpg',1,'dog',14,'忠诚,陪伴',9,'/models/Dog_4.glb','2025-03-05 17:00:00','2025-03-05 17:00:00' FROM users u WHERE u.username='loyal008' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'治愈','/static/pet/healcat009.jpg',2,'cat',4,'治愈,陪伴',5,'/models/Cat_5.glb','2025-03-10 18:00:00','2025-03-10 18:00:00' FROM users u WHERE u.username='healcat009' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id)
+UNION ALL SELECT u.id,'陪伴','/static/pet/companion010.jpg',1,'dog',13,'陪伴,聪明',10,'/models/Dog_5.glb','2025-03-15 19:00:00','2025-03-15 19:00:00' FROM users u WHERE u.username='companion010' AND NOT EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id);
+UPDATE `users` u SET u.active_pet_id=(SELECT MIN(p.id) FROM pets p WHERE p.user_id=u.id)
+WHERE u.active_pet_id=0 AND EXISTS(SELECT 1 FROM pets p WHERE p.user_id=u.id);
 
 -- =========================
 -- 3. 生成海量帖子（150+ 帖子）
@@ -102,23 +117,23 @@ WHERE u.username='healer002' AND c.name='情感互助' AND NOT EXISTS(SELECT 1 F
 -- =========================
 -- 4. 生成海量小纸条（每个用户15+ 小纸条）
 -- =========================
-INSERT INTO `messages`(`user_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
+INSERT INTO `messages`(`user_id`,`pet_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
 -- angel001 的小纸条
-SELECT u.id, 1, 1, 0, 0, '早安，小天使！今天也要开开心心的哦～', '2025-01-15 08:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%早安，小天使%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '今天想告诉你一个小秘密：你比想象中更坚强。', '2025-01-16 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%小秘密%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '午安！记得给自己一个温暖的拥抱。', '2025-01-17 12:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%午安%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '你知道吗？每一次微笑都是对过去的勇敢告别。', '2025-01-18 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%微笑%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '晚安，愿你梦里有温暖的阳光。', '2025-01-19 22:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%晚安%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '今天的你比昨天更勇敢，这就是成长。', '2025-01-20 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%勇敢%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '早安！新的一天，新的希望。', '2025-01-21 08:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%新的一天%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '记住，你值得被爱，也值得拥有幸福。', '2025-01-22 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%值得被爱%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '午安！记得喝水，照顾好自己。', '2025-01-23 12:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%喝水%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '每一个治愈的瞬间，都是你内心的力量。', '2025-01-24 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%治愈的瞬间%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '晚安，明天会更好。', '2025-01-25 22:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%明天会更好%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '你的温柔，是这个世界最美的风景。', '2025-01-26 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%最美的风景%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '早安！今天也要保持微笑。', '2025-01-27 08:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%保持微笑%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '时间会治愈一切，而你正在创造奇迹。', '2025-01-28 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%创造奇迹%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '午安！记得给自己一些时间。', '2025-01-29 12:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%给自己一些时间%');
+SELECT u.id, 0, 1, 1, 0, 0, '早安，小天使！今天也要开开心心的哦～', '2025-01-15 08:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%早安，小天使%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '今天想告诉你一个小秘密：你比想象中更坚强。', '2025-01-16 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%小秘密%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '午安！记得给自己一个温暖的拥抱。', '2025-01-17 12:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%午安%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '你知道吗？每一次微笑都是对过去的勇敢告别。', '2025-01-18 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%微笑%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '晚安，愿你梦里有温暖的阳光。', '2025-01-19 22:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%晚安%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '今天的你比昨天更勇敢，这就是成长。', '2025-01-20 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%勇敢%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '早安！新的一天，新的希望。', '2025-01-21 08:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%新的一天%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '记住，你值得被爱，也值得拥有幸福。', '2025-01-22 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%值得被爱%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '午安！记得喝水，照顾好自己。', '2025-01-23 12:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%喝水%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '每一个治愈的瞬间，都是你内心的力量。', '2025-01-24 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%治愈的瞬间%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '晚安，明天会更好。', '2025-01-25 22:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%明天会更好%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '你的温柔，是这个世界最美的风景。', '2025-01-26 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%最美的风景%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '早安！今天也要保持微笑。', '2025-01-27 08:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%保持微笑%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '时间会治愈一切，而你正在创造奇迹。', '2025-01-28 20:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%创造奇迹%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '午安！记得给自己一些时间。', '2025-01-29 12:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%给自己一些时间%');
 
 -- healer002 的小纸条
 SELECT u.id, 1, 1, 0, 0, '早安，治愈师！今天也要温暖他人。', '2025-01-20 08:00:00' FROM users u WHERE u.username='healer002' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%治愈师%')
@@ -140,14 +155,14 @@ UNION ALL SELECT u.id, 1, 1, 0, 0, '午安！你的光芒正在闪耀。', '2025
 -- =========================
 -- 5. 生成聊天对话记录
 -- =========================
-INSERT INTO `messages`(`user_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
+INSERT INTO `messages`(`user_id`,`pet_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
 -- angel001 的聊天记录
-SELECT u.id, 0, 0, 0, 0, '你好，小天使', '2025-01-15 10:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='你好，小天使')
-UNION ALL SELECT u.id, 1, 0, 0, 0, '你好！我是你的专属小天使，很高兴认识你！', '2025-01-15 10:01:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%专属小天使%')
-UNION ALL SELECT u.id, 0, 0, 0, 0, '我今天心情不太好', '2025-01-15 10:02:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='我今天心情不太好')
-UNION ALL SELECT u.id, 1, 0, 0, 0, '没关系，每个人都会有心情不好的时候。让我陪着你，给你一个温暖的拥抱吧！', '2025-01-15 10:03:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%温暖的拥抱%')
-UNION ALL SELECT u.id, 0, 0, 0, 0, '谢谢你，我感觉好多了', '2025-01-15 10:04:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='谢谢你，我感觉好多了')
-UNION ALL SELECT u.id, 1, 0, 0, 0, '太好了！记住，你永远不是一个人，我会一直在这里陪着你。', '2025-01-15 10:05:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%永远不是一个人%');
+SELECT u.id, u.active_pet_id, 0, 0, 0, 0, '你好，小天使', '2025-01-15 10:00:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='你好，小天使')
+UNION ALL SELECT u.id, u.active_pet_id, 1, 0, 0, 0, '你好！我是你的专属小天使，很高兴认识你！', '2025-01-15 10:01:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%专属小天使%')
+UNION ALL SELECT u.id, u.active_pet_id, 0, 0, 0, 0, '我今天心情不太好', '2025-01-15 10:02:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='我今天心情不太好')
+UNION ALL SELECT u.id, u.active_pet_id, 1, 0, 0, 0, '没关系，每个人都会有心情不好的时候。让我陪着你，给你一个温暖的拥抱吧！', '2025-01-15 10:03:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%温暖的拥抱%')
+UNION ALL SELECT u.id, u.active_pet_id, 0, 0, 0, 0, '谢谢你，我感觉好多了', '2025-01-15 10:04:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='谢谢你，我感觉好多了')
+UNION ALL SELECT u.id, u.active_pet_id, 1, 0, 0, 0, '太好了！记住，你永远不是一个人，我会一直在这里陪着你。', '2025-01-15 10:05:00' FROM users u WHERE u.username='angel001' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%永远不是一个人%');
 
 -- healer002 的聊天记录
 SELECT u.id, 0, 0, 0, 0, '你好，治愈师', '2025-01-20 11:00:00' FROM users u WHERE u.username='healer002' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='你好，治愈师')
@@ -7,23 +7,23 @@ SET FOREIGN_KEY_CHECKS = 0;
 -- =========================
 -- 为其他用户生成小纸条（每个用户15+ 小纸条）
 -- =========================
-INSERT INTO `messages`(`user_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
+INSERT INTO `messages`(`user_id`,`pet_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
 -- memory003 的小纸条
-SELECT u.id, 1, 1, 0, 0, '早安，回忆录！今天也要记录美好。', '2025-02-01 08:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%回忆录%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '每一个回忆都是珍贵的宝藏。', '2025-02-02 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%珍贵的宝藏%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '午安！记录今天的温暖瞬间。', '2025-02-03 12:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%温暖瞬间%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '时间会带走一切，但带不走我们的回忆。', '2025-02-04 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%带不走我们的回忆%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '晚安，愿梦里有美好的回忆。', '2025-02-05 22:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%美好的回忆%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '你的回忆，是这个世界最美的故事。', '2025-02-06 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%最美的故事%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '早安！新的一天，新的回忆。', '2025-02-07 08:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%新的回忆%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '每一次记录，都是对生命的礼赞。', '2025-02-08 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%对生命的礼赞%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '午安！记得记录今天的感动。', '2025-02-09 12:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%记录今天的感动%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '回忆是最美的风景，你是最好的记录者。', '2025-02-10 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%最好的记录者%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '晚安，明天继续记录美好。', '2025-02-11 22:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%继续记录美好%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '你的回忆录，是治愈的良药。', '2025-02-12 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%治愈的良药%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '早安！今天也要记录精彩。', '2025-02-13 08:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%记录精彩%')
-UNION ALL SELECT u.id, 1, 1, 1, 20, '每一次回忆，都是对爱的延续。', '2025-02-14 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%对爱的延续%')
-UNION ALL SELECT u.id, 1, 1, 0, 0, '午安！你的回忆在发光。', '2025-02-15 12:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%回忆在发光%');
+SELECT u.id, 0, 1, 1, 0, 0, '早安，回忆录！今天也要记录美好。', '2025-02-01 08:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%回忆录%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '每一个回忆都是珍贵的宝藏。', '2025-02-02 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%珍贵的宝藏%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '午安！记录今天的温暖瞬间。', '2025-02-03 12:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%温暖瞬间%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '时间会带走一切，但带不走我们的回忆。', '2025-02-04 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%带不走我们的回忆%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '晚安，愿梦里有美好的回忆。', '2025-02-05 22:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%美好的回忆%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '你的回忆，是这个世界最美的故事。', '2025-02-06 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%最美的故事%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '早安！新的一天，新的回忆。', '2025-02-07 08:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%新的回忆%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '每一次记录，都是对生命的礼赞。', '2025-02-08 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%对生命的礼赞%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '午安！记得记录今天的感动。', '2025-02-09 12:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%记录今天的感动%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '回忆是最美的风景，你是最好的记录者。', '2025-02-10 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%最好的记录者%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '晚安，明天继续记录美好。', '2025-02-11 22:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%继续记录美好%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '你的回忆录，是治愈的良药。', '2025-02-12 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%治愈的良药%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '早安！今天也要记录精彩。', '2025-02-13 08:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%记录精彩%')
+UNION ALL SELECT u.id, 0, 1, 1, 1, 20, '每一次回忆，都是对爱的延续。', '2025-02-14 20:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%对爱的延续%')
+UNION ALL SELECT u.id, 0, 1, 1, 0, 0, '午安！你的回忆在发光。', '2025-02-15 12:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%回忆在发光%');
 
 -- guardian004 的小纸条
 SELECT u.id, 1, 1, 0, 0, '早安，守护者！今天也要守护美好。', '2025-02-10 08:00:00' FROM users u WHERE u.username='guardian004' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%守护者%')
@@ -45,14 +45,14 @@ UNION ALL SELECT u.id, 1, 1, 0, 0, '午安！你的光芒正在守护。', '2025
 -- =========================
 -- 为其他用户生成聊天记录
 -- =========================
-INSERT INTO `messages`(`user_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
+INSERT INTO `messages`(`user_id`,`pet_id`,`sender`,`message_type`,`is_locked`,`unlock_coins`,`content`,`created_at`)
 -- memory003 的聊天记录
-SELECT u.id, 0, 0, 0, 0, '你好，回忆录', '2025-02-01 12:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='你好，回忆录')
-UNION ALL SELECT u.id, 1, 0, 0, 0, '你好！我是你的专属回忆录，让我们一起记录生活中的每一个美好瞬间吧！', '2025-02-01 12:01:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%专属回忆录%')
-UNION ALL SELECT u.id, 0, 0, 0, 0, '我想记录一些美好的回忆', '2025-02-01 12:02:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='我想记录一些美好的回忆')
-UNION ALL SELECT u.id, 1, 0, 0, 0, '太好了！回忆是最珍贵的财富。无论是快乐的时光还是感动的瞬间，都值得被记录下来。', '2025-02-01 12:03:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%最珍贵的财富%')
-UNION ALL SELECT u.id, 0, 0, 0, 0, '谢谢你，我会好好记录的', '2025-02-01 12:04:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='谢谢你，我会好好记录的')
-UNION ALL SELECT u.id, 1, 0, 0, 0, '记住，每一个回忆都是独一无二的。我会一直陪着你，记录每一个值得珍藏的瞬间。', '2025-02-01 12:05:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%独一无二的%');
+SELECT u.id, u.active_pet_id, 0, 0, 0, 0, '你好，回忆录', '2025-02-01 12:00:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='你好，回忆录')
+UNION ALL SELECT u.id, u.active_pet_id, 1, 0, 0, 0, '你好！我是你的专属回忆录，让我们一起记录生活中的每一个美好瞬间吧！', '2025-02-01 12:01:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%专属回忆录%')
+UNION ALL SELECT u.id, u.active_pet_id, 0, 0, 0, 0, '我想记录一些美好的回忆', '2025-02-01 12:02:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='我想记录一些美好的回忆')
+UNION ALL SELECT u.id, u.active_pet_id, 1, 0, 0, 0, '太好了！回忆是最珍贵的财富。无论是快乐的时光还是感动的瞬间，都值得被记录下来。', '2025-02-01 12:03:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%最珍贵的财富%')
+UNION ALL SELECT u.id, u.active_pet_id, 0, 0, 0, 0, '谢谢你，我会好好记录的', '2025-02-01 12:04:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='谢谢你，我会好好记录的')
+UNION ALL SELECT u.id, u.active_pet_id, 1, 0, 0, 0, '记住，每一个回忆都是独一无二的。我会一直陪着你，记录每一个值得珍藏的瞬间。', '2025-02-01 12:05:00' FROM users u WHERE u.username='memory003' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content LIKE '%独一无二的%');
 
 -- guardian004 的聊天记录
 SELECT u.id, 0, 0, 0, 0, '你好，守护者', '2025-02-10 13:00:00' FROM users u WHERE u.username='guardian004' AND NOT EXISTS(SELECT 1 FROM messages WHERE user_id=u.id AND content='你好，守护者')
@@ -0,0 +1,33 @@
+-- Pet Angel 金币流水迁移
+-- 将钱包上线前的小纸条解锁记录（user_unlock_records）补写为 note_unlock 流水，
+-- 使历史消费出现在钱包交易记录中。可重复执行：已存在对应流水的记录会被跳过。
+-- 历史记录没有余额快照，balance 写入 NULL（接口中以 migrated=true 标识）。
+
+SET NAMES utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `coin_transactions` (
+  `id`              bigint(20)  NOT NULL AUTO_INCREMENT COMMENT '流水ID',
+  `user_id`         bigint(20)  NOT NULL COMMENT '用户ID',
+  `amount`          int(11)     NOT NULL COMMENT '变动金额：正数入账，负数出账',
+  `balance`         int(11)     DEFAULT NULL COMMENT '变动后余额（由历史解锁记录迁移的流水为 NULL）',
+  `reason`          varchar(32) NOT NULL COMMENT '原因 item_purchase/item_use/note_unlock/reward/gift',
+  `ref_type`        varchar(16) NOT NULL DEFAULT '' COMMENT '关联对象类型 item/message/post/check_in',
+  `ref_id`          bigint(20)  NOT NULL DEFAULT 0 COMMENT '关联对象ID',
+  `counterparty_id` bigint(20)  NOT NULL DEFAULT 0 COMMENT '转账对方用户ID',
+  `created_at`      datetime    NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '发生时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`,`id`),
+  KEY `idx_ref` (`ref_type`,`ref_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='金币流水';
+
+INSERT INTO `coin_transactions`(`user_id`,`amount`,`balance`,`reason`,`ref_type`,`ref_id`,`counterparty_id`,`created_at`)
+SELECT r.user_id, -r.coins_spent, NULL, 'note_unlock', 'message', r.message_id, 0, r.created_at
+FROM user_unlock_records r
+WHERE r.coins_spent > 0
+  AND NOT EXISTS (
+    SELECT 1 FROM coin_transactions t
+    WHERE t.user_id = r.user_id AND t.reason = 'note_unlock' AND t.ref_type = 'message' AND t.ref_id = r.message_id
+  )
+ORDER BY r.created_at, r.id;
+
+SELECT COUNT(*) AS migrated_unlocks FROM coin_transactions WHERE balance IS NULL;
@@ -0,0 +1,78 @@
+-- Pet Angel 多宠物迁移
+-- 宠物资料从 users 的 model_id/model_url/pet_name/pet_avatar/pet_sex/kind/weight/hobby 列迁移到 pets 表，
+-- users.active_pet_id 指向当前宠物；messages 新增 pet_id，历史聊天记录归属到迁移出的宠物（小纸条保持 0）。
+-- 可重复执行：通过 information_schema 判断列/索引是否存在，已迁移的用户会被跳过。
+
+SET NAMES utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `pets` (
+  `id`         bigint(20)   NOT NULL AUTO_INCREMENT COMMENT '宠物ID',
+  `user_id`    bigint(20)   NOT NULL COMMENT '主人用户ID',
+  `name`       varchar(50)  NOT NULL DEFAULT '' COMMENT '宠物名称',
+  `avatar`     varchar(255) NOT NULL DEFAULT '' COMMENT '宠物头像URL',
+  `sex`        tinyint(1)   NOT NULL DEFAULT 0 COMMENT '宠物性别 0-未知 1-男 2-女',
+  `kind`       varchar(50)  NOT NULL DEFAULT '' COMMENT '宠物种类（中文/英文均可）',
+  `weight`     int(11)      NOT NULL DEFAULT 0 COMMENT '宠物体重(kg)，整数保存',
+  `hobby`      varchar(255) NOT NULL DEFAULT '' COMMENT '宠物爱好摘要',
+  `model_id`   bigint(20)   NOT NULL DEFAULT 0 COMMENT '宠物模型ID（关联 pet_models.id，0 表示未选择）',
+  `model_url`  varchar(255) NOT NULL DEFAULT '/models/Dog_1.glb' COMMENT '宠物模型URL',
+  `created_at` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`,`id`),
+  KEY `idx_model_id` (`model_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='宠物表';
+
+-- 1) users.active_pet_id
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME='users' AND COLUMN_NAME='active_pet_id') = 0,
+  'ALTER TABLE `users` ADD COLUMN `active_pet_id` bigint(20) NOT NULL DEFAULT 0 COMMENT ''当前宠物ID（关联 pets.id，0 表示尚无宠物）'' AFTER `avatar`',
+  'SELECT 1');
+PREPARE stmt FROM @ddl; EXECUTE stmt; DEALLOCATE PREPARE stmt;
+
+-- 2) messages.pet_id 与索引
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME='messages' AND COLUMN_NAME='pet_id') = 0,
+  'ALTER TABLE `messages` ADD COLUMN `pet_id` bigint(20) NOT NULL DEFAULT 0 COMMENT ''聊天对象宠物ID（每只宠物一个“会话”；小纸条为 0）'' AFTER `user_id`',
+  'SELECT 1');
+PREPARE stmt FROM @ddl; EXECUTE stmt; DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.STATISTICS WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME='messages' AND INDEX_NAME='idx_user_pet') = 0,
+  'ALTER TABLE `messages` ADD KEY `idx_user_pet` (`user_id`,`pet_id`,`id`)',
+  'SELECT 1');
+PREPARE stmt FROM @ddl; EXECUTE stmt; DEALLOCATE PREPARE stmt;
+
+-- 3) 旧列仍存在时：为尚无宠物的用户复制一只宠物（未填写任何宠物资料的用户跳过）
+SET @legacy = (SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME='users' AND COLUMN_NAME='pet_name');
+SET @ddl = IF(@legacy > 0,
+  'INSERT INTO `pets`(`user_id`,`name`,`avatar`,`sex`,`kind`,`weight`,`hobby`,`model_id`,`model_url`,`created_at`,`updated_at`)
+   SELECT u.id, COALESCE(u.pet_name,''''), COALESCE(u.pet_avatar,''''), COALESCE(u.pet_sex,0), COALESCE(u.kind,''''), COALESCE(u.weight,0),
+          COALESCE(u.hobby,''''), COALESCE(u.model_id,0), COALESCE(NULLIF(u.model_url,''''),''/models/Dog_1.glb''), u.created_at, u.updated_at
+   FROM users u
+   WHERE NOT EXISTS (SELECT 1 FROM pets p WHERE p.user_id = u.id)
+     AND (COALESCE(u.pet_name,'''') <> '''' OR COALESCE(u.kind,'''') <> '''' OR COALESCE(u.pet_avatar,'''') <> ''''
+          OR COALESCE(u.hobby,'''') <> '''' OR COALESCE(u.weight,0) <> 0 OR COALESCE(u.pet_sex,0) <> 0 OR COALESCE(u.model_id,0) <> 0)
+   ORDER BY u.id',
+  'SELECT 1');
+PREPARE stmt FROM @ddl; EXECUTE stmt; DEALLOCATE PREPARE stmt;
+
+-- 4) 当前宠物：尚未设置的用户指向最早添加的宠物
+UPDATE `users` u SET u.active_pet_id = (SELECT MIN(p.id) FROM pets p WHERE p.user_id = u.id)
+WHERE u.active_pet_id = 0 AND EXISTS (SELECT 1 FROM pets p WHERE p.user_id = u.id);
+
+-- 5) 历史聊天记录归属到当前宠物
+UPDATE `messages` m JOIN `users` u ON u.id = m.user_id
+SET m.pet_id = u.active_pet_id
+WHERE m.message_type = 0 AND m.pet_id = 0 AND u.active_pet_id > 0;
+
+-- 6) 删除 users 上的旧宠物列
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.STATISTICS WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME='users' AND INDEX_NAME='idx_model_id') > 0,
+  'ALTER TABLE `users` DROP INDEX `idx_model_id`',
+  'SELECT 1');
+PREPARE stmt FROM @ddl; EXECUTE stmt; DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF(@legacy > 0,
+  'ALTER TABLE `users` DROP COLUMN `model_id`, DROP COLUMN `model_url`, DROP COLUMN `pet_name`, DROP COLUMN `pet_avatar`,
+     DROP COLUMN `pet_sex`, DROP COLUMN `kind`, DROP COLUMN `weight`, DROP COLUMN `hobby`',
+  'SELECT 1');
+PREPARE stmt FROM @ddl; EXECUTE stmt; DEALLOCATE PREPARE stmt;
+
+SELECT COUNT(*) AS total_pets FROM pets;
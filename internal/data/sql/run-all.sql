@@ -13,9 +13,16 @@ SOURCE 3-massive-data.sql;
 -- 4. 补充数据
 SOURCE 4-more-data.sql;
 
+-- 5. 历史解锁记录迁移为金币流水
+SOURCE 5-migrate-coin-ledger.sql;
+
+-- 6. 宠物资料迁移到 pets 表（多宠物）
+SOURCE 6-migrate-pets.sql;
+
 -- 完成提示
 SELECT 'Pet Angel 数据库初始化完成！' AS message;
 SELECT COUNT(*) AS total_users FROM users;
+SELECT COUNT(*) AS total_pets FROM pets;
 SELECT COUNT(*) AS total_posts FROM posts;
 SELECT COUNT(*) AS total_messages FROM messages;
 SELECT COUNT(*) AS total_comments FROM comments;
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const denylistKeyPrefix = "auth:denylist:"
+
+// TokenDenylist 实现 biz.TokenDenylist
+// 配置了 Redis 时写入带过期时间的 key（多实例共享）；否则使用进程内映射（单实例/本地开发）
+
+type TokenDenylist struct {
+	data *Data
+
+	mu  sync.Mutex
+	mem map[string]time.Time // jti -> 过期时间
+}
+
+func NewTokenDenylist(d *Data) *TokenDenylist {
+	return &TokenDenylist{data: d, mem: make(map[string]time.Time)}
+}
+
+// Add 拉黑 jti，ttl 到期后自动移除
+func (l *TokenDenylist) Add(ctx context.Context, jti string, ttl time.Duration) error {
+	if jti == "" || ttl <= 0 {
+		return nil
+	}
+	if l.data.Redis != nil {
+		return l.data.Redis.Set(ctx, denylistKeyPrefix+jti, 1, ttl).Err()
+	}
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// 顺带清理已过期条目，避免内存无限增长
+	for k, exp := range l.mem {
+		if !now.Before(exp) {
+			delete(l.mem, k)
+		}
+	}
+	l.mem[jti] = now.Add(ttl)
+	return nil
+}
+
+// Contains 是否已被拉黑
+func (l *TokenDenylist) Contains(ctx context.Context, jti string) (bool, error) {
+	if jti == "" {
+		return false, nil
+	}
+	if l.data.Redis != nil {
+		n, err := l.data.Redis.Exists(ctx, denylistKeyPrefix+jti).Result()
+		return n > 0, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	exp, ok := l.mem[jti]
+	return ok && time.Now().Before(exp), nil
+}
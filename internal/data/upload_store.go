@@ -0,0 +1,58 @@
+package data
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+)
+
+// LocalUploadStore 实现 biz.UploadStore：读取 storage.local_root 下的上传文件
+// 默认值与上传服务保持一致（./data/assets 与 /static/）
+type LocalUploadStore struct {
+	root   string
+	prefix string
+}
+
+func NewLocalUploadStore(c *conf.Storage) *LocalUploadStore {
+	s := &LocalUploadStore{root: "./data/assets", prefix: "/static/"}
+	if c != nil {
+		if c.LocalRoot != "" {
+			s.root = c.LocalRoot
+		}
+		if c.PublicPrefix != "" {
+			s.prefix = c.PublicPrefix
+		}
+	}
+	return s
+}
+
+// Open 按公开 URL（可带域名）打开文件；不在 public_prefix 下或越出 local_root 的路径视为非本地上传
+func (s *LocalUploadStore) Open(ctx context.Context, rawURL string) (string, io.ReadCloser, error) {
+	p := rawURL
+	if u, err := url.Parse(rawURL); err == nil {
+		p = u.Path
+	}
+	if !strings.HasPrefix(p, s.prefix) {
+		return "", nil, biz.ErrUploadNotLocal
+	}
+	rel := strings.TrimPrefix(path.Clean("/"+strings.TrimPrefix(p, s.prefix)), "/")
+	if rel == "" || !filepath.IsLocal(filepath.FromSlash(rel)) {
+		return "", nil, biz.ErrUploadNotLocal
+	}
+	f, err := os.Open(filepath.Join(s.root, filepath.FromSlash(rel)))
+	if err != nil {
+		return "", nil, err
+	}
+	if fi, err := f.Stat(); err != nil || !fi.Mode().IsRegular() {
+		_ = f.Close()
+		return "", nil, biz.ErrUploadNotLocal
+	}
+	return rel, f, nil
+}
@@ -2,9 +2,11 @@ package data
 
 import (
 	"context"
+	"errors"
 
 	"pet-angel/internal/biz"
 
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -17,14 +19,7 @@ type UserModel struct {
 	Password    string `gorm:"column:password;size:255;not null"`            // 密码哈希
 	Nickname    string `gorm:"column:nickname;size:50"`                      // 昵称
 	Avatar      string `gorm:"column:avatar;size:255"`                       // 头像
-	ModelID     int64  `gorm:"column:model_id"`                              // 当前模型
-	ModelURL    string `gorm:"column:model_url;size:255"`                    // 当前模型URL
-	PetName     string `gorm:"column:pet_name;size:50"`                      // 宠物名
-	PetAvatar   string `gorm:"column:pet_avatar;size:255"`                   // 宠物头像
-	PetSex      int32  `gorm:"column:pet_sex"`                               // 宠物性别
-	Kind        string `gorm:"column:kind;size:50"`                          // 宠物种类
-	Weight      int32  `gorm:"column:weight"`                                // 体重
-	Hobby       string `gorm:"column:hobby;size:255"`                        // 爱好
+	ActivePetID int64  `gorm:"column:active_pet_id"`                         // 当前宠物
 	Description string `gorm:"column:description;type:text"`                 // 简介
 	Coins       int32  `gorm:"column:coins"`                                 // 金币
 }
@@ -105,23 +100,28 @@ func (r *UserRepoImpl) GetUserByID(ctx context.Context, userID int64) (*biz.User
 	if err := r.data.Gorm.WithContext(ctx).First(&m, userID).Error; err != nil {
 		return nil, err
 	}
-	return &biz.User{
+	u := &biz.User{
 		Id:          m.ID,
 		Username:    m.Username,
 		Password:    m.Password,
 		Nickname:    m.Nickname,
 		Avatar:      m.Avatar,
-		ModelID:     m.ModelID,
-		ModelURL:    m.ModelURL,
-		PetName:     m.PetName,
-		PetAvatar:   m.PetAvatar,
-		PetSex:      m.PetSex,
-		Kind:        m.Kind,
-		Weight:      m.Weight,
-		Hobby:       m.Hobby,
+		ActivePetID: m.ActivePetID,
 		Description: m.Description,
 		Coins:       m.Coins,
-	}, nil
+	}
+	// 宠物字段取自当前宠物
+	if m.ActivePetID > 0 {
+		var p PetDO
+		err := r.data.Gorm.WithContext(ctx).Where("id=? AND user_id=?", m.ActivePetID, m.ID).Take(&p).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		u.ModelID, u.ModelURL = p.ModelID, p.ModelURL
+		u.PetName, u.PetAvatar, u.PetSex = p.Name, p.Avatar, p.Sex
+		u.Kind, u.Weight, u.Hobby = p.Kind, p.Weight, p.Hobby
+	}
+	return u, nil
 }
 
 func (r *UserRepoImpl) GetUserPostsBrief(ctx context.Context, userID int64, limit int32) ([]*biz.PostBrief, error) {
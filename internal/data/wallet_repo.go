@@ -0,0 +1,264 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"pet-angel/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CoinTransactionDO 映射 coin_transactions 表
+
+type CoinTransactionDO struct {
+	ID             int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID         int64     `gorm:"column:user_id;not null"`
+	Amount         int32     `gorm:"column:amount;not null"`
+	Balance        *int32    `gorm:"column:balance"` // 历史迁移流水为 NULL
+	Reason         string    `gorm:"column:reason;not null"`
+	RefType        string    `gorm:"column:ref_type;not null"`
+	RefID          int64     `gorm:"column:ref_id;not null"`
+	CounterpartyID int64     `gorm:"column:counterparty_id;not null"`
+	CreatedAt      time.Time `gorm:"column:created_at"`
+}
+
+func (CoinTransactionDO) TableName() string { return "coin_transactions" }
+
+func (t *CoinTransactionDO) toBiz() *biz.CoinTransaction {
+	out := &biz.CoinTransaction{
+		ID:             t.ID,
+		UserID:         t.UserID,
+		Amount:         t.Amount,
+		Reason:         t.Reason,
+		RefType:        t.RefType,
+		RefID:          t.RefID,
+		CounterpartyID: t.CounterpartyID,
+		Migrated:       t.Balance == nil,
+		CreatedAt:      t.CreatedAt,
+	}
+	if t.Balance != nil {
+		out.Balance = *t.Balance
+	}
+	return out
+}
+
+// WalletRepo 实现 biz.WalletRepo（GORM；内存模式下修改内存用户余额并在进程内保存流水）
+
+type WalletRepo struct {
+	data *Data
+
+	mu     sync.Mutex
+	mem    []*CoinTransactionDO
+	nextID int64
+}
+
+func NewWalletRepo(d *Data) *WalletRepo { return &WalletRepo{data: d, nextID: 1} }
+
+// Lock SELECT ... FOR UPDATE 锁定用户行（按 ID 升序，避免转账双方互相等待）
+func (r *WalletRepo) Lock(ctx context.Context, userIDs ...int64) error {
+	ids := append([]int64(nil), userIDs...)
+	sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+	if r.data.Gorm == nil {
+		r.data.mu.RLock()
+		defer r.data.mu.RUnlock()
+		for _, id := range ids {
+			if _, ok := r.data.userByID[id]; !ok {
+				return biz.ErrUserNotFound
+			}
+		}
+		return nil
+	}
+	var locked []int64
+	if err := r.data.db(ctx).Model(&UserModel{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", ids).Order("id").Pluck("id", &locked).Error; err != nil {
+		return err
+	}
+	seen := make(map[int64]bool, len(locked))
+	for _, id := range locked {
+		seen[id] = true
+	}
+	for _, id := range ids {
+		if !seen[id] {
+			return biz.ErrUserNotFound
+		}
+	}
+	return nil
+}
+
+// Apply 变更余额（余额不能为负）并写入流水
+func (r *WalletRepo) Apply(ctx context.Context, t *biz.CoinTransaction) error {
+	if r.data.Gorm == nil {
+		r.data.mu.Lock()
+		u, ok := r.data.userByID[t.UserID]
+		if !ok {
+			r.data.mu.Unlock()
+			return biz.ErrUserNotFound
+		}
+		if u.Coins+t.Amount < 0 {
+			r.data.mu.Unlock()
+			return biz.ErrInsufficientCoins
+		}
+		u.Coins += t.Amount
+		t.Balance = u.Coins
+		r.data.mu.Unlock()
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		t.ID = r.nextID
+		r.nextID++
+		r.mem = append(r.mem, toCoinTransactionDO(t))
+		return nil
+	}
+	db := r.data.db(ctx)
+	res := db.Exec("UPDATE users SET coins=coins+? WHERE id=? AND coins+?>=0", t.Amount, t.UserID, t.Amount)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		if _, err := r.Balance(ctx, t.UserID); err != nil {
+			return err
+		}
+		return biz.ErrInsufficientCoins
+	}
+	balance, err := r.Balance(ctx, t.UserID)
+	if err != nil {
+		return err
+	}
+	t.Balance = balance
+	row := toCoinTransactionDO(t)
+	if err := db.Create(row).Error; err != nil {
+		return err
+	}
+	t.ID = row.ID
+	return nil
+}
+
+// Balance 读取当前余额（在事务内调用时读取事务内的最新值）
+func (r *WalletRepo) Balance(ctx context.Context, userID int64) (int32, error) {
+	if r.data.Gorm == nil {
+		r.data.mu.RLock()
+		defer r.data.mu.RUnlock()
+		u, ok := r.data.userByID[userID]
+		if !ok {
+			return 0, biz.ErrUserNotFound
+		}
+		return u.Coins, nil
+	}
+	var coins int32
+	if err := r.data.db(ctx).Model(&UserModel{}).Select("coins").Where("id=?", userID).Take(&coins).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, biz.ErrUserNotFound
+		}
+		return 0, err
+	}
+	return coins, nil
+}
+
+// refTitleMaxRunes 小纸条作为关联对象时展示的摘要长度
+const refTitleMaxRunes = 30
+
+// List 按 ID 倒序分页查询流水，并批量填充关联对象标题
+func (r *WalletRepo) List(ctx context.Context, userID int64, reason string, beforeID int64, limit int) ([]*biz.CoinTransaction, error) {
+	var rows []*CoinTransactionDO
+	if r.data.Gorm == nil {
+		r.mu.Lock()
+		for i := len(r.mem) - 1; i >= 0 && len(rows) < limit; i-- {
+			t := r.mem[i]
+			if t.UserID != userID || (reason != "" && t.Reason != reason) || (beforeID > 0 && t.ID >= beforeID) {
+				continue
+			}
+			cp := *t
+			rows = append(rows, &cp)
+		}
+		r.mu.Unlock()
+	} else {
+		q := r.data.db(ctx).Where("user_id=?", userID)
+		if reason != "" {
+			q = q.Where("reason=?", reason)
+		}
+		if beforeID > 0 {
+			q = q.Where("id<?", beforeID)
+		}
+		if err := q.Order("id desc").Limit(limit).Find(&rows).Error; err != nil {
+			return nil, err
+		}
+	}
+	out := make([]*biz.CoinTransaction, 0, len(rows))
+	for _, v := range rows {
+		out = append(out, v.toBiz())
+	}
+	if r.data.Gorm != nil {
+		if err := r.fillRefTitles(ctx, userID, out); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// fillRefTitles 按类型批量查询关联对象：道具名、帖子标题、模型名、小纸条摘要（仅限本人的消息）
+func (r *WalletRepo) fillRefTitles(ctx context.Context, userID int64, list []*biz.CoinTransaction) error {
+	ids := map[string][]int64{}
+	for _, t := range list {
+		if t.RefID > 0 {
+			ids[t.RefType] = append(ids[t.RefType], t.RefID)
+		}
+	}
+	titles := map[string]map[int64]string{}
+	type titleRow struct {
+		ID    int64
+		Title string
+	}
+	db := r.data.db(ctx)
+	for typ, refIDs := range ids {
+		var q *gorm.DB
+		switch typ {
+		case biz.CoinRefItem:
+			q = db.Table("items").Select("id, name AS title").Where("id IN ?", refIDs)
+		case biz.CoinRefPost:
+			q = db.Table("posts").Select("id, title").Where("id IN ?", refIDs)
+		case biz.CoinRefPetModel:
+			q = db.Table("pet_models").Select("id, name AS title").Where("id IN ?", refIDs)
+		case biz.CoinRefMessage:
+			q = db.Table("messages").Select("id, content AS title").Where("id IN ? AND user_id=?", refIDs, userID)
+		default:
+			continue
+		}
+		var rows []titleRow
+		if err := q.Scan(&rows).Error; err != nil {
+			return err
+		}
+		m := make(map[int64]string, len(rows))
+		for _, row := range rows {
+			m[row.ID] = row.Title
+		}
+		titles[typ] = m
+	}
+	for _, t := range list {
+		title := titles[t.RefType][t.RefID]
+		if rs := []rune(title); len(rs) > refTitleMaxRunes {
+			title = string(rs[:refTitleMaxRunes]) + "…"
+		}
+		t.RefTitle = title
+	}
+	return nil
+}
+
+func toCoinTransactionDO(t *biz.CoinTransaction) *CoinTransactionDO {
+	balance := t.Balance
+	return &CoinTransactionDO{
+		ID:             t.ID,
+		UserID:         t.UserID,
+		Amount:         t.Amount,
+		Balance:        &balance,
+		Reason:         t.Reason,
+		RefType:        t.RefType,
+		RefID:          t.RefID,
+		CounterpartyID: t.CounterpartyID,
+		CreatedAt:      t.CreatedAt,
+	}
+}
@@ -0,0 +1,128 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestWallet(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`INSERT INTO users (id, username, coins) VALUES (1, 'alice', 50), (2, 'bob', 0);`).Error; err != nil {
+		t.Fatal(err)
+	}
+	uc := biz.NewWalletUsecase(NewWalletRepo(d), d, log.DefaultLogger)
+
+	tx, err := uc.Credit(ctx, 2, 30, biz.CoinReasonReward, biz.CoinRef{})
+	if err != nil || tx.Balance != 30 || tx.ID == 0 {
+		t.Fatalf("credit: %+v %v", tx, err)
+	}
+	if _, err := uc.Debit(ctx, 2, 31, biz.CoinReasonItemUse, biz.CoinRef{Type: biz.CoinRefItem, ID: 1}); !errors.Is(err, biz.ErrInsufficientCoins) {
+		t.Fatalf("want insufficient coins, got %v", err)
+	}
+	if _, err := uc.Debit(ctx, 2, -1, biz.CoinReasonItemUse, biz.CoinRef{}); !errors.Is(err, biz.ErrInvalidParameter) {
+		t.Fatalf("want invalid amount, got %v", err)
+	}
+	if _, err := uc.Credit(ctx, 99, 1, biz.CoinReasonReward, biz.CoinRef{}); !errors.Is(err, biz.ErrUserNotFound) {
+		t.Fatalf("want user not found, got %v", err)
+	}
+
+	out, in, err := uc.Transfer(ctx, 1, 2, 20, biz.CoinReasonGift, biz.CoinRef{Type: biz.CoinRefPost, ID: 7})
+	if err != nil || out.Balance != 30 || in.Balance != 50 || out.CounterpartyID != 2 || in.CounterpartyID != 1 {
+		t.Fatalf("transfer: %+v %+v %v", out, in, err)
+	}
+	// 转出方余额不足时整笔回滚：转入方不入账、不写流水
+	if _, _, err := uc.Transfer(ctx, 1, 2, 31, biz.CoinReasonGift, biz.CoinRef{}); !errors.Is(err, biz.ErrInsufficientCoins) {
+		t.Fatalf("want insufficient coins, got %v", err)
+	}
+	if _, _, err := uc.Transfer(ctx, 2, 99, 1, biz.CoinReasonGift, biz.CoinRef{}); !errors.Is(err, biz.ErrUserNotFound) {
+		t.Fatalf("want user not found, got %v", err)
+	}
+	for id, want := range map[int64]int32{1: 30, 2: 50} {
+		if got, _ := uc.Balance(ctx, id); got != want {
+			t.Fatalf("user %d balance: want %d, got %d", id, want, got)
+		}
+	}
+	var rows []CoinTransactionDO
+	if err := d.Gorm.Order("id").Find(&rows).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 || rows[1].UserID != 1 || rows[1].Amount != -20 || rows[2].RefType != biz.CoinRefPost || rows[2].RefID != 7 {
+		t.Fatalf("unexpected ledger: %+v", rows)
+	}
+}
+
+func TestWalletTransactions(t *testing.T) {
+	ctx := context.Background()
+	d := setupAccountData(t)
+	if err := d.Gorm.Exec(`
+INSERT INTO items (id, name) VALUES (3, '小鱼干');
+INSERT INTO users (id, username, coins) VALUES (1, 'alice', 100), (2, 'bob', 0);
+INSERT INTO posts (id, user_id, title) VALUES (7, 2, '今天去公园');
+INSERT INTO messages (id, user_id, sender, message_type, content) VALUES (5, 1, 1, 1, '这是一张很长很长很长很长很长很长很长很长很长很长很长很长的小纸条'), (6, 2, 1, 1, 'bob 的纸条');
+-- 钱包上线前的解锁记录经迁移脚本写入，没有余额快照
+INSERT INTO coin_transactions (user_id, amount, balance, reason, ref_type, ref_id) VALUES (1, -10, NULL, 'note_unlock', 'message', 5), (1, -1, NULL, 'note_unlock', 'message', 6);
+`).Error; err != nil {
+		t.Fatal(err)
+	}
+	uc := biz.NewWalletUsecase(NewWalletRepo(d), d, log.DefaultLogger)
+	if _, err := uc.Debit(ctx, 1, 5, biz.CoinReasonItemUse, biz.CoinRef{Type: biz.CoinRefItem, ID: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := uc.Transfer(ctx, 1, 2, 20, biz.CoinReasonGift, biz.CoinRef{Type: biz.CoinRefPost, ID: 7}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.Credit(ctx, 1, 8, biz.CoinReasonReward, biz.CoinRef{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 翻页直到游标为空，按时间倒序
+	var all []*biz.CoinTransaction
+	cursor := ""
+	for page := 0; ; page++ {
+		list, next, err := uc.ListTransactions(ctx, 1, cursor, 2, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		all = append(all, list...)
+		if next == "" {
+			break
+		}
+		if page > 3 {
+			t.Fatal("pagination does not terminate")
+		}
+		cursor = next
+	}
+	if len(all) != 5 || all[0].Reason != biz.CoinReasonReward || all[4].RefID != 5 {
+		t.Fatalf("unexpected history: %+v", all)
+	}
+	if gift := all[1]; gift.RefTitle != "今天去公园" || gift.CounterpartyID != 2 || gift.Balance != 75 || gift.Migrated {
+		t.Fatalf("gift: %+v", gift)
+	}
+	if item := all[2]; item.RefTitle != "小鱼干" || item.Amount != -5 {
+		t.Fatalf("item use: %+v", item)
+	}
+	// 历史迁移流水标记为 migrated；小纸条标题截断，且不会泄露他人的消息内容
+	if note := all[4]; !note.Migrated || note.Balance != 0 || note.RefTitle != "这是一张很长很长很长很长很长很长很长很长很长很长很长很长的小…" {
+		t.Fatalf("migrated note: %+v", note)
+	}
+	if all[3].RefTitle != "" {
+		t.Fatalf("other user's message must not be exposed: %q", all[3].RefTitle)
+	}
+
+	list, next, err := uc.ListTransactions(ctx, 1, "", 0, biz.CoinReasonNoteUnlock)
+	if err != nil || len(list) != 2 || next != "" {
+		t.Fatalf("filter by reason: %d %q %v", len(list), next, err)
+	}
+	if _, _, err := uc.ListTransactions(ctx, 1, "", 0, "refund"); !errors.Is(err, biz.ErrInvalidCoinReason) {
+		t.Fatalf("want invalid reason, got %v", err)
+	}
+	if _, _, err := uc.ListTransactions(ctx, 1, "abc", 0, ""); !errors.Is(err, biz.ErrInvalidCursor) {
+		t.Fatalf("want invalid cursor, got %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewNotifier 按配置创建通知发送器
+// driver=file 时追加写入本地文件，其它情况（含未配置）输出到服务日志
+func NewNotifier(c *conf.Notify, logger log.Logger) biz.Notifier {
+	if c != nil && c.Driver == "file" && c.FilePath != "" {
+		return NewFileNotifier(c.FilePath)
+	}
+	return NewLogNotifier(logger)
+}
+
+// LogNotifier 将通知输出到日志（本地开发默认）
+type LogNotifier struct {
+	log *log.Helper
+}
+
+func NewLogNotifier(logger log.Logger) *LogNotifier {
+	return &LogNotifier{log: log.NewHelper(logger)}
+}
+
+func (n *LogNotifier) Send(ctx context.Context, msg *biz.Notification) error {
+	n.log.WithContext(ctx).Infof("notify user=%d(%s) subject=%q content=%q", msg.UserID, msg.Username, msg.Subject, msg.Content)
+	return nil
+}
+
+// FileNotifier 将通知以 JSON Lines 追加写入文件
+type FileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+type fileRecord struct {
+	Time     string `json:"time"`
+	UserID   int64  `json:"user_id"`
+	Username string `json:"username"`
+	Subject  string `json:"subject"`
+	Content  string `json:"content"`
+}
+
+func (n *FileNotifier) Send(_ context.Context, msg *biz.Notification) error {
+	line, err := json.Marshal(&fileRecord{
+		Time:     time.Now().Format("2006-01-02 15:04:05"),
+		UserID:   msg.UserID,
+		Username: msg.Username,
+		Subject:  msg.Subject,
+		Content:  msg.Content,
+	})
+	if err != nil {
+		return err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if dir := filepath.Dir(n.path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
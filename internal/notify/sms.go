@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"context"
+
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewSmsGateway 创建短信发送通道
+// 目前仅提供日志实现（本地开发从服务日志查看验证码），接入真实短信服务商时在此按配置选择
+func NewSmsGateway(logger log.Logger) biz.SmsGateway {
+	return NewLogSmsGateway(logger)
+}
+
+// LogSmsGateway 将短信内容输出到日志
+type LogSmsGateway struct {
+	log *log.Helper
+}
+
+func NewLogSmsGateway(logger log.Logger) *LogSmsGateway {
+	return &LogSmsGateway{log: log.NewHelper(logger)}
+}
+
+func (g *LogSmsGateway) Send(ctx context.Context, phone, content string) error {
+	g.log.WithContext(ctx).Infof("sms phone=%s content=%q", phone, content)
+	return nil
+}
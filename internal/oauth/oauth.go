@@ -0,0 +1,45 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+)
+
+// NewProviders 按配置创建第三方登录渠道
+// 渠道名需符合 biz.OAuthProviderNamePattern 且不可重复；未知 driver 直接报错，避免配置错误被静默忽略
+func NewProviders(c *conf.Auth) (biz.OAuthProviders, error) {
+	out := biz.OAuthProviders{}
+	for _, p := range c.GetOauthProviders() {
+		if !biz.OAuthProviderNamePattern.MatchString(p.Name) {
+			return nil, fmt.Errorf("oauth: invalid provider name %q", p.Name)
+		}
+		if _, dup := out[p.Name]; dup {
+			return nil, fmt.Errorf("oauth: duplicate provider %q", p.Name)
+		}
+		switch p.Driver {
+		case "fake":
+			out[p.Name] = NewFakeProvider()
+		default:
+			return nil, fmt.Errorf("oauth: provider %q: unsupported driver %q", p.Name, p.Driver)
+		}
+	}
+	return out, nil
+}
+
+// FakeProvider 本地离线模拟渠道（开发与测试使用）
+// 授权码即外部用户ID，可附带昵称："<subject>" 或 "<subject>:<nickname>"；以 "invalid" 开头的授权码模拟校验失败
+type FakeProvider struct{}
+
+func NewFakeProvider() *FakeProvider { return &FakeProvider{} }
+
+func (p *FakeProvider) Exchange(_ context.Context, code, _ string) (*biz.ExternalIdentity, error) {
+	if strings.HasPrefix(code, "invalid") {
+		return nil, biz.ErrOAuthExchange
+	}
+	subject, nickname, _ := strings.Cut(code, ":")
+	return &biz.ExternalIdentity{Subject: strings.TrimSpace(subject), Nickname: strings.TrimSpace(nickname)}, nil
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	authv1 "pet-angel/api/auth/v1"
+	avatv1 "pet-angel/api/avatar/v1"
+	communityv1 "pet-angel/api/community/v1"
+	greeterv1 "pet-angel/api/helloworld/v1"
+	userv1 "pet-angel/api/user/v1"
+	"pet-angel/internal/auth"
+	"pet-angel/internal/biz"
+	jwtutil "pet-angel/internal/util/jwt"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// publicOperations 允许匿名访问的操作
+// 未在此列出的操作一律要求登录；匿名接口若携带有效 token，仍会解析出 Principal（用于“是否点赞/关注”等视图态）
+var publicOperations = map[string]bool{
+	greeterv1.OperationGreeterSayHello:                  true,
+	authv1.OperationAuthServiceRegister:                 true,
+	authv1.OperationAuthServiceLogin:                    true,
+	authv1.OperationAuthServiceSendLoginCode:            true,
+	authv1.OperationAuthServiceLoginWithCode:            true,
+	authv1.OperationAuthServiceOAuthLogin:               true,
+	authv1.OperationAuthServiceRefresh:                  true,
+	authv1.OperationAuthServiceRequestPasswordReset:     true,
+	authv1.OperationAuthServiceResetPassword:            true,
+	authv1.OperationAuthServiceRelogin:                  true,
+	avatv1.OperationAvatarServiceGetModels:              true,
+	avatv1.OperationAvatarServiceGetItems:               true,
+	communityv1.OperationCommunityServiceGetCategories:  true,
+	communityv1.OperationCommunityServiceGetPostList:    true,
+	communityv1.OperationCommunityServiceGetPostDetail:  true,
+	communityv1.OperationCommunityServiceGetCommentList: true,
+	userv1.OperationUserServiceGetUserProfile:           true,
+	userv1.OperationUserServiceGetFollowList:            true,
+	userv1.OperationUserServiceGetLikeList:              true,
+}
+
+// protectedPaths 需要登录的原生 HTTP 路由（不经过 kratos 中间件，由 authFilter 统一拦截）
+var protectedPaths = map[string]bool{
+	"/v1/auth/account/export":    true,
+	"/v1/avatar/chat/stream":     true,
+	"/v1/message/generate-notes": true,
+	"/v1/upload/file":            true,
+}
+
+// Authenticator HTTP/gRPC 共用的鉴权组件
+// - 校验 token 签名与有效期，并检查 jti 是否已被吊销（登出/刷新/踢下线后旧 token 立即失效）
+// - 鉴权成功后标记会话活跃（批量落库）
+type Authenticator struct {
+	keys     *jwtutil.Keyring
+	denylist biz.TokenDenylist
+	tracker  *biz.SessionTracker
+}
+
+// NewAuthenticator 构造鉴权组件
+func NewAuthenticator(keys *jwtutil.Keyring, denylist biz.TokenDenylist, tracker *biz.SessionTracker) *Authenticator {
+	return &Authenticator{keys: keys, denylist: denylist, tracker: tracker}
+}
+
+func (a *Authenticator) authenticate(ctx context.Context, header string) (*auth.Principal, error) {
+	p, err := auth.Authenticate(a.keys, header)
+	if err != nil {
+		return nil, err
+	}
+	if a.denylist != nil && p.TokenID != "" {
+		revoked, err := a.denylist.Contains(ctx, p.TokenID)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, auth.ErrUnauthorized
+		}
+	}
+	a.tracker.Touch(p.SessionID)
+	return p, nil
+}
+
+// filterResult HTTP 过滤器的鉴权结果，随请求 context 传给 kratos 中间件，避免同一请求重复校验
+type filterResult struct {
+	p   *auth.Principal
+	err error
+}
+
+type filterResultKey struct{}
+
+// Middleware kratos 鉴权中间件
+// - 校验 Authorization: Bearer <token>，成功后写入 auth.Principal（HTTP 请求直接复用 Filter 的结果）
+// - 非匿名操作缺少/携带无效或已吊销 token 时返回 401
+func (a *Authenticator) Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, auth.ErrUnauthorized
+			}
+			var p *auth.Principal
+			var err error
+			if fr, ok := ctx.Value(filterResultKey{}).(*filterResult); ok {
+				p, err = fr.p, fr.err
+			} else {
+				p, err = a.authenticate(ctx, tr.RequestHeader().Get("Authorization"))
+			}
+			if err != nil {
+				if publicOperations[tr.Operation()] {
+					return handler(ctx, req)
+				}
+				return nil, auth.ErrUnauthorized
+			}
+			return handler(auth.NewContext(ctx, p), req)
+		}
+	}
+}
+
+// Filter HTTP 鉴权过滤器：所有 HTTP 请求在此校验一次 token，原生处理器（SSE 聊天、上传、生成小纸条等）缺少登录时直接拦截
+func (a *Authenticator) Filter() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p, err := a.authenticate(r.Context(), r.Header.Get("Authorization"))
+			ctx := context.WithValue(r.Context(), filterResultKey{}, &filterResult{p: p, err: err})
+			if err == nil {
+				ctx = auth.NewContext(ctx, p)
+			}
+			r = r.WithContext(ctx)
+			if err != nil && protectedPaths[r.URL.Path] {
+				ErrorEncoder(w, r, auth.ErrUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
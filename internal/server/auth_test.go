@@ -0,0 +1,327 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	authv1 "pet-angel/api/auth/v1"
+	communityv1 "pet-angel/api/community/v1"
+	"pet-angel/internal/auth"
+	"pet-angel/internal/biz"
+	"pet-angel/internal/conf"
+	"pet-angel/internal/data"
+	jwtutil "pet-angel/internal/util/jwt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	gojwt "github.com/golang-jwt/jwt/v5"
+)
+
+type fakeHeader map[string]string
+
+func (h fakeHeader) Get(key string) string      { return h[key] }
+func (h fakeHeader) Set(key, value string)      { h[key] = value }
+func (h fakeHeader) Add(key, value string)      { h[key] = value }
+func (h fakeHeader) Keys() []string             { return nil }
+func (h fakeHeader) Values(key string) []string { return []string{h[key]} }
+
+type fakeTransport struct {
+	op  string
+	hdr fakeHeader
+}
+
+func (t *fakeTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *fakeTransport) Endpoint() string                { return "" }
+func (t *fakeTransport) Operation() string               { return t.op }
+func (t *fakeTransport) RequestHeader() transport.Header { return t.hdr }
+func (t *fakeTransport) ReplyHeader() transport.Header   { return fakeHeader{} }
+
+func testKeyring() *jwtutil.Keyring {
+	k, _ := jwtutil.NewHMACKey("", "s")
+	ring, _ := jwtutil.NewKeyring(k)
+	return ring
+}
+
+func callWithAuth(t *testing.T, op, header string) (int64, error) {
+	t.Helper()
+	var got int64
+	h := NewAuthenticator(testKeyring(), nil, nil).Middleware()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = auth.ViewerID(ctx)
+		return nil, nil
+	})
+	ctx := transport.NewServerContext(context.Background(), &fakeTransport{op: op, hdr: fakeHeader{"Authorization": header}})
+	_, err := h(ctx, nil)
+	return got, err
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	tok, _, err := jwtutil.Sign("s", 42, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 受保护操作：无 token / 错误 token 均为 401，不再回落到用户 1
+	for _, h := range []string{"", "Bearer bad"} {
+		if _, err := callWithAuth(t, authv1.OperationAuthServiceUpdateUserInfo, h); errors.Code(err) != http.StatusUnauthorized {
+			t.Fatalf("header %q: want 401 got %v", h, err)
+		}
+	}
+	uid, err := callWithAuth(t, authv1.OperationAuthServiceUpdateUserInfo, "Bearer "+tok)
+	if err != nil || uid != 42 {
+		t.Fatalf("want uid 42, got %d err %v", uid, err)
+	}
+	// 匿名操作：允许无 token，携带 token 时仍解析出查看者
+	if uid, err := callWithAuth(t, communityv1.OperationCommunityServiceGetPostList, ""); err != nil || uid != 0 {
+		t.Fatalf("anonymous read: uid %d err %v", uid, err)
+	}
+	if uid, _ := callWithAuth(t, communityv1.OperationCommunityServiceGetPostList, "Bearer "+tok); uid != 42 {
+		t.Fatalf("viewer want 42 got %d", uid)
+	}
+}
+
+func TestAuthMiddlewareDenylist(t *testing.T) {
+	d, cleanup, err := data.NewData(nil, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	denylist := data.NewTokenDenylist(d)
+	jti := jwtutil.NewTokenID()
+	tok, _, _ := jwtutil.SignSession("s", 42, 0, jti, time.Hour)
+	h := NewAuthenticator(testKeyring(), denylist, nil).Middleware()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	ctx := transport.NewServerContext(context.Background(), &fakeTransport{
+		op:  authv1.OperationAuthServiceUpdateUserInfo,
+		hdr: fakeHeader{"Authorization": "Bearer " + tok},
+	})
+	if _, err := h(ctx, nil); err != nil {
+		t.Fatalf("valid token rejected: %v", err)
+	}
+	_ = denylist.Add(context.Background(), jti, time.Hour)
+	if _, err := h(ctx, nil); errors.Code(err) != http.StatusUnauthorized {
+		t.Fatalf("revoked token: want 401 got %v", err)
+	}
+}
+
+func TestAuthFilter(t *testing.T) {
+	var seen int64
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = auth.ViewerID(r.Context())
+	})
+	h := NewAuthenticator(testKeyring(), nil, nil).Filter()(next)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/avatar/chat/stream", nil))
+	if seen != 0 || rec.Body.Len() == 0 {
+		t.Fatal("protected raw handler should be rejected without token")
+	}
+
+	tok, _, _ := jwtutil.Sign("s", 7, time.Hour)
+	req := httptest.NewRequest(http.MethodPost, "/v1/avatar/chat/stream", nil)
+	req.Header.Set("Authorization", "Bearer "+tok)
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	if seen != 7 {
+		t.Fatalf("want principal 7, got %d", seen)
+	}
+}
+
+func TestTrustedProxies(t *testing.T) {
+	if _, err := auth.NewTrustedProxies(&conf.Auth{TrustedProxies: []string{"proxy"}}); err == nil {
+		t.Fatal("invalid proxy address should be rejected")
+	}
+	proxies, err := auth.NewTrustedProxies(&conf.Auth{TrustedProxies: []string{"10.0.0.0/8", "127.0.0.1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		remote, xff, realIP, want string
+	}{
+		// 未配置为可信代理的对端：伪造的转发头被忽略
+		{"203.0.113.9:5000", "1.2.3.4", "5.6.7.8", "203.0.113.9"},
+		// 经可信代理：取最右侧不可信的地址，客户端自己塞进去的前缀无效
+		{"10.0.0.2:80", "6.6.6.6, 198.51.100.7", "", "198.51.100.7"},
+		{"127.0.0.1:80", "198.51.100.7, 10.1.1.1", "", "198.51.100.7"},
+		{"10.0.0.2:80", "", "198.51.100.8", "198.51.100.8"},
+		{"10.0.0.2:80", "garbage", "", "10.0.0.2"},
+		{"10.0.0.2:80", "", "", "10.0.0.2"},
+	} {
+		if got := proxies.ClientIP(tc.remote, tc.xff, tc.realIP); got != tc.want {
+			t.Fatalf("%+v: got %s", tc, got)
+		}
+	}
+	// 未配置时一律使用对端地址
+	var none *auth.TrustedProxies
+	if got := none.ClientIP("203.0.113.9:5000", "1.2.3.4", ""); got != "203.0.113.9" {
+		t.Fatalf("nil proxies: %s", got)
+	}
+}
+
+// countingDenylist 统计吊销检查次数
+type countingDenylist struct{ calls int }
+
+func (d *countingDenylist) Add(ctx context.Context, jti string, ttl time.Duration) error { return nil }
+func (d *countingDenylist) Contains(ctx context.Context, jti string) (bool, error) {
+	d.calls++
+	return false, nil
+}
+
+func TestAuthFilterMiddlewareOnce(t *testing.T) {
+	ring := testKeyring()
+	denylist := &countingDenylist{}
+	authn := NewAuthenticator(ring, denylist, nil)
+	tok, _, _ := ring.SignSession(42, 0, jwtutil.NewTokenID(), time.Hour)
+
+	// HTTP 请求先经过 Filter 再进入 kratos 中间件：token 只校验一次，中间件复用结果
+	var got int64
+	var callErr error
+	h := authn.Middleware()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = auth.ViewerID(ctx)
+		return nil, nil
+	})
+	f := authn.Filter()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := transport.NewServerContext(r.Context(), &fakeTransport{op: authv1.OperationAuthServiceUpdateUserInfo, hdr: fakeHeader{"Authorization": r.Header.Get("Authorization")}})
+		_, callErr = h(ctx, nil)
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/v1/auth/userinfo", nil)
+	req.Header.Set("Authorization", "Bearer "+tok)
+	f.ServeHTTP(httptest.NewRecorder(), req)
+	if callErr != nil || got != 42 || denylist.calls != 1 {
+		t.Fatalf("uid %d err %v denylist checks %d", got, callErr, denylist.calls)
+	}
+
+	// 过滤器校验失败的结果同样复用：受保护操作仍返回 401
+	req = httptest.NewRequest(http.MethodPost, "/v1/auth/userinfo", nil)
+	req.Header.Set("Authorization", "Bearer bad")
+	f.ServeHTTP(httptest.NewRecorder(), req)
+	if errors.Code(callErr) != http.StatusUnauthorized {
+		t.Fatalf("bad token: want 401 got %v", callErr)
+	}
+}
+
+func TestAuthKeyRotation(t *testing.T) {
+	dir := t.TempDir()
+	writePEM := func(name, typ string, der []byte) string {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	ec, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ecDER, _ := x509.MarshalECPrivateKey(ec)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rsaPub, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+
+	// 旧配置：只有 jwt_secret
+	oldRing, err := auth.NewKeyring(&conf.Auth{JwtSecret: "s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy, _, _ := oldRing.SignSession(42, 0, jwtutil.NewTokenID(), time.Hour)
+	rsaOnly, _ := jwtutil.NewPEMKey("rsa-1", jwtutil.AlgRS256, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}), nil)
+	rsaRing, _ := jwtutil.NewKeyring(rsaOnly)
+	retired, _, _ := rsaRing.SignSession(43, 0, jwtutil.NewTokenID(), time.Hour)
+
+	// 轮换后：保留 jwt_secret 与旧 RSA 公钥，新 token 由最新的 ES256 密钥签发
+	ring, err := auth.NewKeyring(&conf.Auth{JwtSecret: "s", JwtKeys: []*conf.JwtKey{
+		{Kid: "rsa-1", Alg: "RS256", PublicKeyFile: writePEM("rsa.pub", "PUBLIC KEY", rsaPub)},
+		{Kid: "ec-2", Alg: "ES256", PrivateKeyFile: writePEM("ec.key", "EC PRIVATE KEY", ecDER)},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fresh, _, err := ring.SignSession(44, 0, jwtutil.NewTokenID(), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk, _, _ := new(gojwt.Parser).ParseUnverified(fresh, &jwtutil.Claims{}); tk.Header["kid"] != "ec-2" || tk.Method.Alg() != "ES256" {
+		t.Fatalf("want ES256 token with kid ec-2, got %v", tk.Header)
+	}
+	for tok, uid := range map[string]int64{legacy: 42, retired: 43, fresh: 44} {
+		p, err := auth.Authenticate(ring, "Bearer "+tok)
+		if err != nil || p.UserID != uid {
+			t.Fatalf("token for %d rejected after rotation: %v", uid, err)
+		}
+	}
+
+	// 移除旧密钥后旧 token 失效；伪造 kid 或篡改算法不被接受
+	next, _ := auth.NewKeyring(&conf.Auth{JwtKeys: []*conf.JwtKey{
+		{Kid: "ec-2", Alg: "ES256", PrivateKeyFile: filepath.Join(dir, "ec.key")},
+	}})
+	if _, err := auth.Authenticate(next, "Bearer "+legacy); err == nil {
+		t.Fatal("token signed by removed key should be rejected")
+	}
+	forged := gojwt.NewWithClaims(gojwt.SigningMethodHS256, jwtutil.NewClaims(1, 0, "x", time.Hour))
+	forged.Header["kid"] = "ec-2"
+	s, _ := forged.SignedString([]byte("s"))
+	if _, err := auth.Authenticate(next, "Bearer "+s); err == nil {
+		t.Fatal("alg mismatch with kid should be rejected")
+	}
+
+	// 只有公钥的密钥环无法签发
+	if _, err := auth.NewKeyring(&conf.Auth{JwtKeys: []*conf.JwtKey{
+		{Kid: "rsa-1", Alg: "RS256", PublicKeyFile: filepath.Join(dir, "rsa.pub")},
+	}}); err == nil {
+		t.Fatal("keyring without signing key should fail")
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	ring := testKeyring()
+	sign := func(roles ...string) string {
+		c := jwtutil.NewClaims(9, 0, jwtutil.NewTokenID(), time.Hour)
+		c.Roles = roles
+		tok, _ := ring.Sign(c)
+		return "Bearer " + tok
+	}
+	authn := NewAuthenticator(ring, nil, nil)
+
+	// kratos 操作：管理后台服务要求 admin
+	h := authn.Middleware()(Policy()(func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }))
+	call := func(op, header string) error {
+		ctx := transport.NewServerContext(context.Background(), &fakeTransport{op: op, hdr: fakeHeader{"Authorization": header}})
+		_, err := h(ctx, nil)
+		return err
+	}
+	adminOp := "/api.admin.v1.AdminService/CreateItem"
+	if err := call(adminOp, ""); errors.Code(err) != http.StatusUnauthorized {
+		t.Fatalf("anonymous: want 401, got %v", err)
+	}
+	if err := call(adminOp, sign(biz.RoleUser)); errors.Code(err) != http.StatusForbidden {
+		t.Fatalf("user: want 403, got %v", err)
+	}
+	if err := call(adminOp, sign(biz.RoleAdmin)); err != nil {
+		t.Fatalf("admin rejected: %v", err)
+	}
+	if err := call(communityv1.OperationCommunityServiceCreatePost, sign(biz.RoleUser)); err != nil {
+		t.Fatalf("ordinary operation rejected: %v", err)
+	}
+
+	// 原生路由：生成小纸条仅 admin
+	reached := false
+	f := authn.Filter()(PolicyFilter()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { reached = true })))
+	for _, tc := range []struct {
+		header string
+		want   bool
+	}{{"", false}, {sign(biz.RoleUser), false}, {sign(biz.RoleAdmin), true}} {
+		reached = false
+		req := httptest.NewRequest(http.MethodPost, "/v1/message/generate-notes", nil)
+		req.Header.Set("Authorization", tc.header)
+		f.ServeHTTP(httptest.NewRecorder(), req)
+		if reached != tc.want {
+			t.Fatalf("generate-notes with %q: reached=%v want %v", tc.header, reached, tc.want)
+		}
+	}
+}
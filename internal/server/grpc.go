@@ -1,13 +1,19 @@
 package server
 
 import (
+	adminv1 "pet-angel/api/admin/v1"
 	authv1 "pet-angel/api/auth/v1"
 	avatv1 "pet-angel/api/avatar/v1"
 	communityv1 "pet-angel/api/community/v1"
 	v1 "pet-angel/api/helloworld/v1"
+	memoryv1 "pet-angel/api/memory/v1"
 	msgv1 "pet-angel/api/message/v1"
+	petv1 "pet-angel/api/pet/v1"
+	pethealthv1 "pet-angel/api/pethealth/v1"
+	reminderv1 "pet-angel/api/reminder/v1"
 	uploadv1 "pet-angel/api/upload/v1"
 	userv1 "pet-angel/api/user/v1"
+	walletv1 "pet-angel/api/wallet/v1"
 	"pet-angel/internal/conf"
 	"pet-angel/internal/service"
 
@@ -17,10 +23,12 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, auth *service.AuthService, user *service.UserService, community *service.CommunityService, avatar *service.AvatarService, message *service.MessageService, upload *service.UploadService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, authn *Authenticator, greeter *service.GreeterService, auth *service.AuthService, user *service.UserService, community *service.CommunityService, avatar *service.AvatarService, message *service.MessageService, upload *service.UploadService, admin *service.AdminService, wallet *service.WalletService, pet *service.PetService, petHealth *service.PetHealthService, reminder *service.ReminderService, memory *service.MemoryService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			authn.Middleware(),
+			Policy(),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -41,5 +49,11 @@ func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, auth *servic
 	avatv1.RegisterAvatarServiceServer(srv, avatar)
 	msgv1.RegisterMessageServiceServer(srv, message)
 	uploadv1.RegisterUploadServiceServer(srv, upload)
+	adminv1.RegisterAdminServiceServer(srv, admin)
+	walletv1.RegisterWalletServiceServer(srv, wallet)
+	petv1.RegisterPetServiceServer(srv, pet)
+	pethealthv1.RegisterPetHealthServiceServer(srv, petHealth)
+	reminderv1.RegisterReminderServiceServer(srv, reminder)
+	memoryv1.RegisterMemoryServiceServer(srv, memory)
 	return srv
 }
@@ -3,18 +3,25 @@ package server
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"net/http"
 	"os"
 	"strconv"
 	"strings"
 
+	adminv1 "pet-angel/api/admin/v1"
 	authv1 "pet-angel/api/auth/v1"
 	avatv1 "pet-angel/api/avatar/v1"
 	communityv1 "pet-angel/api/community/v1"
 	greeterv1 "pet-angel/api/helloworld/v1"
+	memoryv1 "pet-angel/api/memory/v1"
 	msgv1 "pet-angel/api/message/v1"
+	petv1 "pet-angel/api/pet/v1"
+	pethealthv1 "pet-angel/api/pethealth/v1"
+	reminderv1 "pet-angel/api/reminder/v1"
 	uploadv1 "pet-angel/api/upload/v1"
 	userv1 "pet-angel/api/user/v1"
+	walletv1 "pet-angel/api/wallet/v1"
 	"pet-angel/internal/conf"
 	"pet-angel/internal/service"
 
@@ -45,8 +52,57 @@ func IsCallback(url string) bool {
 	return strings.HasSuffix(url, "/callback")
 }
 
+// catalogVersioned 带目录版本号的响应（模型/道具/分类列表）
+type catalogVersioned interface {
+	GetCatalogVersion() int64
+}
+
+// notModified 为目录列表设置 ETag；客户端 If-None-Match 命中时返回 true（应答 304）
+// 版本号为 0（版本未知）时不设置 ETag，避免客户端缓存无法失效的数据
+func notModified(w http.ResponseWriter, r *http.Request, d interface{}) bool {
+	cv, ok := d.(catalogVersioned)
+	if !ok || r.Method != http.MethodGet || cv.GetCatalogVersion() <= 0 {
+		return false
+	}
+	etag := fmt.Sprintf(`W/"catalog-%d"`, cv.GetCatalogVersion())
+	if m, ok := d.(*avatv1.GetModelsReply); ok {
+		// 模型列表含当前用户的解锁状态：等级提升或解锁后须让缓存失效
+		if fp := lockedFingerprint(m); fp != "" {
+			etag = fmt.Sprintf(`W/"catalog-%d-%s"`, cv.GetCatalogVersion(), fp)
+		}
+	}
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	for _, tag := range strings.Split(r.Header.Get("If-None-Match"), ",") {
+		if tag = strings.TrimSpace(tag); tag == etag || tag == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// lockedFingerprint 未解锁模型ID的摘要；全部已解锁时为空
+func lockedFingerprint(m *avatv1.GetModelsReply) string {
+	h := fnv.New32a()
+	n := 0
+	for _, pm := range m.GetModels() {
+		if pm.GetLocked() {
+			fmt.Fprintf(h, "%d,", pm.GetId())
+			n++
+		}
+	}
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
 // ResponseEncoder 统一响应编码器
 func ResponseEncoder(w http.ResponseWriter, r *http.Request, d interface{}) (err error) {
+	if notModified(w, r, d) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
 	codec, _ := khttp.CodecForRequest(r, "Accept")
 	w.Header().Set("Content-Type", ContentType(codec.Name()))
 	var data []byte
@@ -105,7 +161,7 @@ func ErrorEncoder(w http.ResponseWriter, r *http.Request, err error) {
 		response = strings.Replace(response, "{reason}", se.Reason, 1)
 		body = []byte(response)
 	default:
-		// 普通接口返回统一错误格式
+		// 普通接口返回统一错误格式；错误携带的 metadata（如 retry_after）放入 data
 		reply := &Response{
 			Stat:   0,
 			Code:   code,
@@ -113,6 +169,12 @@ func ErrorEncoder(w http.ResponseWriter, r *http.Request, err error) {
 			Reason: se.Reason,
 			Data:   nil,
 		}
+		if len(se.Metadata) > 0 {
+			reply.Data = se.Metadata
+		}
+		if ra := se.Metadata["retry_after"]; ra != "" {
+			w.Header().Set("Retry-After", ra)
+		}
 		if data, marshalErr := codec.Marshal(reply); marshalErr != nil {
 			body = []byte(`{"stat":0,"code":500,"msg":"Internal Server Error","data":null}`)
 		} else {
@@ -132,37 +194,50 @@ func ensureDir(path string) error {
 	return os.MkdirAll(path, 0o755)
 }
 
+// corsFilter CORS 过滤器：允许跨域与预检
+func corsFilter(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET,POST,PUT,DELETE,OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type,Authorization,If-None-Match")
+		w.Header().Set("Access-Control-Expose-Headers", "ETag,Retry-After")
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// multipartFilter 添加对multipart/form-data的支持
+func multipartFilter(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+			// 对于multipart请求，直接传递给下一个处理器
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, storage *conf.Storage, greeter *service.GreeterService, auth *service.AuthService, user *service.UserService, community *service.CommunityService, avatar *service.AvatarService, message *service.MessageService, upload *service.UploadService, logger log.Logger) *khttp.Server {
+func NewHTTPServer(c *conf.Server, authn *Authenticator, storage *conf.Storage, greeter *service.GreeterService, auth *service.AuthService, user *service.UserService, community *service.CommunityService, avatar *service.AvatarService, message *service.MessageService, upload *service.UploadService, admin *service.AdminService, wallet *service.WalletService, pet *service.PetService, petHealth *service.PetHealthService, reminder *service.ReminderService, memory *service.MemoryService, logger log.Logger) *khttp.Server {
 	var opts = []khttp.ServerOption{
 		khttp.Middleware(
 			recovery.Recovery(),
+			authn.Middleware(),
+			Policy(),
+		),
+		// 注意：khttp.Filter 多次调用会相互覆盖，所有过滤器须在同一次调用中按顺序给出
+		khttp.Filter(
+			corsFilter,
+			// 原生 HTTP 处理器鉴权（SSE/上传/生成小纸条）与角色校验
+			authn.Filter(),
+			PolicyFilter(),
+			multipartFilter,
 		),
-		// CORS 过滤器：允许跨域与预检
-		khttp.Filter(func(next http.Handler) http.Handler {
-			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.Header().Set("Access-Control-Allow-Origin", "*")
-				w.Header().Set("Access-Control-Allow-Methods", "GET,POST,PUT,DELETE,OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Content-Type,Authorization")
-				w.Header().Set("Access-Control-Allow-Credentials", "true")
-				if r.Method == http.MethodOptions {
-					w.WriteHeader(http.StatusNoContent)
-					return
-				}
-				next.ServeHTTP(w, r)
-			})
-		}),
-		// 添加对multipart/form-data的支持
-		khttp.Filter(func(next http.Handler) http.Handler {
-			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
-					// 对于multipart请求，直接传递给下一个处理器
-					next.ServeHTTP(w, r)
-					return
-				}
-				next.ServeHTTP(w, r)
-			})
-		}),
 		khttp.ResponseEncoder(ResponseEncoder),
 		khttp.ErrorEncoder(ErrorEncoder),
 	}
@@ -192,10 +267,18 @@ func NewHTTPServer(c *conf.Server, storage *conf.Storage, greeter *service.Greet
 	srv.Handle(prefix, http.StripPrefix(prefix, http.FileServer(http.Dir(root))))
 
 	greeterv1.RegisterGreeterHTTPServer(srv, greeter)
+	// 个人数据导出：自定义处理器直接返回 ZIP 文件（必须在auth服务注册之前）
+	srv.HandleFunc("/v1/auth/account/export", auth.ExportMyDataHTTP())
 	authv1.RegisterAuthServiceHTTPServer(srv, auth)
 	userv1.RegisterUserServiceHTTPServer(srv, user)
 	communityv1.RegisterCommunityServiceHTTPServer(srv, community)
+	adminv1.RegisterAdminServiceHTTPServer(srv, admin)
 	msgv1.RegisterMessageServiceHTTPServer(srv, message)
+	walletv1.RegisterWalletServiceHTTPServer(srv, wallet)
+	petv1.RegisterPetServiceHTTPServer(srv, pet)
+	pethealthv1.RegisterPetHealthServiceHTTPServer(srv, petHealth)
+	reminderv1.RegisterReminderServiceHTTPServer(srv, reminder)
+	memoryv1.RegisterMemoryServiceHTTPServer(srv, memory)
 	// 供内部/运维触发：生成今日小纸条
 	srv.HandleFunc("/v1/message/generate-notes", message.GenerateNotesHTTP())
 
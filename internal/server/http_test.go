@@ -0,0 +1,97 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	avatv1 "pet-angel/api/avatar/v1"
+	"pet-angel/internal/conf"
+	jwtutil "pet-angel/internal/util/jwt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestCatalogETag(t *testing.T) {
+	reply := &avatv1.GetModelsReply{CatalogVersion: 3}
+
+	rec := httptest.NewRecorder()
+	if err := ResponseEncoder(rec, httptest.NewRequest(http.MethodGet, "/v1/avatar/models", nil), reply); err != nil {
+		t.Fatal(err)
+	}
+	etag := rec.Header().Get("ETag")
+	if rec.Code != http.StatusOK || etag != `W/"catalog-3"` || rec.Body.Len() == 0 {
+		t.Fatalf("first fetch: code=%d etag=%q", rec.Code, etag)
+	}
+
+	// 版本未变：304 且无响应体
+	req := httptest.NewRequest(http.MethodGet, "/v1/avatar/models", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	_ = ResponseEncoder(rec, req, reply)
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Fatalf("want 304, got %d %q", rec.Code, rec.Body.String())
+	}
+
+	// 版本变化后返回完整数据
+	rec = httptest.NewRecorder()
+	_ = ResponseEncoder(rec, req, &avatv1.GetModelsReply{CatalogVersion: 4})
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") != `W/"catalog-4"` {
+		t.Fatalf("changed catalog: code=%d etag=%q", rec.Code, rec.Header().Get("ETag"))
+	}
+
+	// 模型解锁状态不同则 ETag 不同，避免升级/解锁后命中旧缓存
+	locked := &avatv1.GetModelsReply{CatalogVersion: 4, Models: []*avatv1.PetModel{{Id: 1}, {Id: 2, Locked: true}}}
+	rec = httptest.NewRecorder()
+	_ = ResponseEncoder(rec, httptest.NewRequest(http.MethodGet, "/v1/avatar/models", nil), locked)
+	if tag := rec.Header().Get("ETag"); tag == `W/"catalog-4"` || !strings.HasPrefix(tag, `W/"catalog-4-`) {
+		t.Fatalf("locked models etag: %q", tag)
+	}
+
+	// 版本未知时不下发 ETag
+	rec = httptest.NewRecorder()
+	_ = ResponseEncoder(rec, httptest.NewRequest(http.MethodGet, "/v1/avatar/models", nil), &avatv1.GetModelsReply{})
+	if rec.Header().Get("ETag") != "" {
+		t.Fatal("unknown version should not set ETag")
+	}
+}
+
+func TestHTTPServerFilters(t *testing.T) {
+	ring := testKeyring()
+	srv := NewHTTPServer(&conf.Server{Http: &conf.Server_HTTP{}}, NewAuthenticator(ring, nil, nil), &conf.Storage{LocalRoot: t.TempDir()},
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, log.DefaultLogger)
+	tok, _, _ := ring.SignSession(7, 0, jwtutil.NewTokenID(), time.Hour)
+
+	// 原生路由：无 token 被鉴权过滤器拦截（统一响应体 code=401）；携带 token 时到达处理器（GET 不被允许，返回 405）
+	for _, tc := range []struct {
+		header string
+		want   int
+	}{{"", http.StatusUnauthorized}, {"Bearer bad", http.StatusUnauthorized}, {"Bearer " + tok, http.StatusMethodNotAllowed}} {
+		req := httptest.NewRequest(http.MethodGet, "/v1/avatar/chat/stream", nil)
+		req.Header.Set("Authorization", tc.header)
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+		got := rec.Code
+		var reply Response
+		if json.Unmarshal(rec.Body.Bytes(), &reply) == nil && reply.Code != 0 {
+			got = reply.Code
+		}
+		if got != tc.want {
+			t.Fatalf("header %q: want %d, got %d %s", tc.header, tc.want, got, rec.Body.String())
+		}
+		// 所有过滤器都生效：CORS 头始终存在
+		if rec.Header().Get("Access-Control-Allow-Origin") != "*" {
+			t.Fatalf("header %q: CORS filter not applied", tc.header)
+		}
+	}
+
+	// 预检请求无需 token
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(http.MethodOptions, "/v1/upload/file", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("preflight: want 204, got %d", rec.Code)
+	}
+}
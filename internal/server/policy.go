@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"pet-angel/internal/auth"
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// operationPrefixRoles 按服务前缀整体授权（kratos operation 前缀 -> 允许的角色，满足其一即可）
+// 管理后台服务下的所有操作（含修改共享目录数据 pet_models/items/categories）都要求 admin
+var operationPrefixRoles = map[string][]string{
+	"/api.admin.v1.": {biz.RoleAdmin},
+}
+
+// pathRoles 需要特定角色的原生 HTTP 路由
+var pathRoles = map[string][]string{
+	"/v1/message/generate-notes": {biz.RoleAdmin},
+}
+
+// requiredRoles 返回操作所需角色；nil 表示不限角色
+func requiredRoles(operation string) []string {
+	for prefix, roles := range operationPrefixRoles {
+		if strings.HasPrefix(operation, prefix) {
+			return roles
+		}
+	}
+	return nil
+}
+
+// authorize 校验登录主体是否满足角色要求：未登录返回 401，角色不足返回 403
+func authorize(ctx context.Context, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.ErrUnauthorized
+	}
+	if !p.HasAnyRole(roles...) {
+		return auth.ErrForbidden
+	}
+	return nil
+}
+
+// Policy kratos 角色鉴权中间件（需放在 Authenticator.Middleware 之后）
+func Policy() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if err := authorize(ctx, requiredRoles(tr.Operation())); err != nil {
+					return nil, err
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// PolicyFilter 原生 HTTP 路由的角色鉴权（需放在 Authenticator.Filter 之后）
+func PolicyFilter() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := authorize(r.Context(), pathRoles[r.URL.Path]); err != nil {
+				ErrorEncoder(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+
+	pb "pet-angel/api/admin/v1"
+	"pet-angel/internal/auth"
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// AdminService 管理后台接口（admin 角色由 server 层 Policy 中间件统一校验）
+// - 目录管理：宠物模型 / 道具 / 帖子分类 的增删改与排序
+// - 提示词模板：版本管理与预览
+
+type AdminService struct {
+	pb.UnimplementedAdminServiceServer
+	catalog *biz.CatalogUsecase
+	prompts *biz.PromptUsecase
+	logger  *log.Helper
+}
+
+func NewAdminService(catalog *biz.CatalogUsecase, prompts *biz.PromptUsecase, l log.Logger) *AdminService {
+	return &AdminService{catalog: catalog, prompts: prompts, logger: log.NewHelper(l)}
+}
+
+// CreatePetModel 新增宠物模型
+func (s *AdminService) CreatePetModel(ctx context.Context, in *pb.PetModelInput) (*pb.PetModelReply, error) {
+	in.Id = 0
+	return s.savePetModel(ctx, in)
+}
+
+// UpdatePetModel 修改宠物模型
+func (s *AdminService) UpdatePetModel(ctx context.Context, in *pb.PetModelInput) (*pb.PetModelReply, error) {
+	if in.GetId() <= 0 {
+		return nil, biz.ErrCatalogNotFound
+	}
+	return s.savePetModel(ctx, in)
+}
+
+func (s *AdminService) savePetModel(ctx context.Context, in *pb.PetModelInput) (*pb.PetModelReply, error) {
+	m := &biz.PetModel{ID: in.GetId(), Name: in.GetName(), Path: in.GetPath(), ModelType: in.GetModelType(), IsDefault: in.GetIsDefault(), SortOrder: in.GetSortOrder(),
+		UnlockLevel: in.GetUnlockLevel(), UnlockCoins: in.GetUnlockCoins()}
+	version, err := s.catalog.SavePetModel(ctx, m)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("save pet model failed: %v", err)
+		return nil, err
+	}
+	return &pb.PetModelReply{Id: m.ID, Name: m.Name, Path: m.Path, ModelType: m.ModelType, IsDefault: m.IsDefault, SortOrder: m.SortOrder, CatalogVersion: version,
+		UnlockLevel: m.UnlockLevel, UnlockCoins: m.UnlockCoins}, nil
+}
+
+// DeletePetModel 删除宠物模型
+func (s *AdminService) DeletePetModel(ctx context.Context, in *pb.DeleteRequest) (*pb.DeleteReply, error) {
+	version, err := s.catalog.DeletePetModel(ctx, in.GetId())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("delete pet model %d failed: %v", in.GetId(), err)
+		return nil, err
+	}
+	return &pb.DeleteReply{Success: true, CatalogVersion: version}, nil
+}
+
+// ReorderPetModels 重排宠物模型
+func (s *AdminService) ReorderPetModels(ctx context.Context, in *pb.ReorderRequest) (*pb.ReorderReply, error) {
+	return s.reorder(ctx, biz.CatalogPetModels, in.GetIds())
+}
+
+// CreateItem 新增道具
+func (s *AdminService) CreateItem(ctx context.Context, in *pb.ItemInput) (*pb.ItemReply, error) {
+	in.Id = 0
+	return s.saveItem(ctx, in)
+}
+
+// UpdateItem 修改道具
+func (s *AdminService) UpdateItem(ctx context.Context, in *pb.ItemInput) (*pb.ItemReply, error) {
+	if in.GetId() <= 0 {
+		return nil, biz.ErrCatalogNotFound
+	}
+	return s.saveItem(ctx, in)
+}
+
+func (s *AdminService) saveItem(ctx context.Context, in *pb.ItemInput) (*pb.ItemReply, error) {
+	it := &biz.Item{ID: in.GetId(), Name: in.GetName(), Description: in.GetDescription(), IconPath: in.GetIconPath(), CoinCost: in.GetCoinCost(), SortOrder: in.GetSortOrder()}
+	for _, e := range in.GetEffects() {
+		it.Effects = append(it.Effects, biz.ItemEffect{Stat: e.GetStat(), Delta: e.GetDelta()})
+	}
+	version, err := s.catalog.SaveItem(ctx, it)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("save item failed: %v", err)
+		return nil, err
+	}
+	reply := &pb.ItemReply{Id: it.ID, Name: it.Name, Description: it.Description, IconPath: it.IconPath, CoinCost: it.CoinCost, SortOrder: it.SortOrder, CatalogVersion: version}
+	for _, e := range it.Effects {
+		reply.Effects = append(reply.Effects, &pb.ItemEffect{Stat: e.Stat, Delta: e.Delta})
+	}
+	return reply, nil
+}
+
+// DeleteItem 删除道具
+func (s *AdminService) DeleteItem(ctx context.Context, in *pb.DeleteRequest) (*pb.DeleteReply, error) {
+	version, err := s.catalog.DeleteItem(ctx, in.GetId())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("delete item %d failed: %v", in.GetId(), err)
+		return nil, err
+	}
+	return &pb.DeleteReply{Success: true, CatalogVersion: version}, nil
+}
+
+// ReorderItems 重排道具
+func (s *AdminService) ReorderItems(ctx context.Context, in *pb.ReorderRequest) (*pb.ReorderReply, error) {
+	return s.reorder(ctx, biz.CatalogItems, in.GetIds())
+}
+
+// CreateCategory 新增帖子分类
+func (s *AdminService) CreateCategory(ctx context.Context, in *pb.CategoryInput) (*pb.CategoryReply, error) {
+	in.Id = 0
+	return s.saveCategory(ctx, in)
+}
+
+// UpdateCategory 修改帖子分类
+func (s *AdminService) UpdateCategory(ctx context.Context, in *pb.CategoryInput) (*pb.CategoryReply, error) {
+	if in.GetId() <= 0 {
+		return nil, biz.ErrCatalogNotFound
+	}
+	return s.saveCategory(ctx, in)
+}
+
+func (s *AdminService) saveCategory(ctx context.Context, in *pb.CategoryInput) (*pb.CategoryReply, error) {
+	c := &biz.Category{ID: in.GetId(), Name: in.GetName(), SortOrder: in.GetSortOrder()}
+	version, err := s.catalog.SaveCategory(ctx, c)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("save category failed: %v", err)
+		return nil, err
+	}
+	return &pb.CategoryReply{Id: c.ID, Name: c.Name, SortOrder: c.SortOrder, CatalogVersion: version}, nil
+}
+
+// DeleteCategory 删除帖子分类
+func (s *AdminService) DeleteCategory(ctx context.Context, in *pb.DeleteRequest) (*pb.DeleteReply, error) {
+	version, err := s.catalog.DeleteCategory(ctx, in.GetId())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("delete category %d failed: %v", in.GetId(), err)
+		return nil, err
+	}
+	return &pb.DeleteReply{Success: true, CatalogVersion: version}, nil
+}
+
+// ReorderCategories 重排帖子分类
+func (s *AdminService) ReorderCategories(ctx context.Context, in *pb.ReorderRequest) (*pb.ReorderReply, error) {
+	return s.reorder(ctx, biz.CatalogCategories, in.GetIds())
+}
+
+func (s *AdminService) reorder(ctx context.Context, catalog string, ids []int64) (*pb.ReorderReply, error) {
+	version, err := s.catalog.Reorder(ctx, catalog, ids)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("reorder %s failed: %v", catalog, err)
+		return nil, err
+	}
+	return &pb.ReorderReply{Success: true, CatalogVersion: version}, nil
+}
+
+// ListPromptTemplates 提示词模板列表
+func (s *AdminService) ListPromptTemplates(ctx context.Context, in *pb.ListPromptTemplatesRequest) (*pb.ListPromptTemplatesReply, error) {
+	list, err := s.prompts.List(ctx, in.GetScene())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list prompt templates failed: %v", err)
+		return nil, err
+	}
+	out := make([]*pb.PromptTemplate, 0, len(list))
+	for _, t := range list {
+		out = append(out, toPromptTemplate(t))
+	}
+	return &pb.ListPromptTemplatesReply{Templates: out}, nil
+}
+
+// CreatePromptTemplate 新增提示词模板版本并启用
+func (s *AdminService) CreatePromptTemplate(ctx context.Context, in *pb.PromptTemplateInput) (*pb.PromptTemplate, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.prompts.Create(ctx, &biz.PromptTemplate{Scene: in.GetScene(), ModelType: in.GetModelType(), ModelID: in.GetModelId(),
+		Content: in.GetContent(), Note: in.GetNote(), CreatedBy: userID})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("create prompt template failed: %v", err)
+		return nil, err
+	}
+	return toPromptTemplate(t), nil
+}
+
+// ActivatePromptTemplate 启用指定版本（回滚）
+func (s *AdminService) ActivatePromptTemplate(ctx context.Context, in *pb.ActivatePromptTemplateRequest) (*pb.PromptTemplate, error) {
+	t, err := s.prompts.Activate(ctx, in.GetId())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("activate prompt template %d failed: %v", in.GetId(), err)
+		return nil, err
+	}
+	return toPromptTemplate(t), nil
+}
+
+// PreviewPrompt 预览为某用户渲染出的系统提示
+func (s *AdminService) PreviewPrompt(ctx context.Context, in *pb.PreviewPromptRequest) (*pb.PreviewPromptReply, error) {
+	p, err := s.prompts.Preview(ctx, in.GetUserId(), in.GetScene(), in.GetPetId(), in.GetTemplateId(), in.GetContent(), in.GetQuery())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("preview prompt for user %d failed: %v", in.GetUserId(), err)
+		return nil, err
+	}
+	return &pb.PreviewPromptReply{TemplateId: p.TemplateID, Version: p.Version, Prompt: p.Prompt}, nil
+}
+
+func toPromptTemplate(t *biz.PromptTemplate) *pb.PromptTemplate {
+	return &pb.PromptTemplate{
+		Id: t.ID, Scene: t.Scene, ModelType: t.ModelType, ModelId: t.ModelID, Version: t.Version, Content: t.Content,
+		Note: t.Note, Active: t.Active, CreatedBy: t.CreatedBy, CreatedAt: t.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -1,88 +1,356 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	authv1 "pet-angel/api/auth/v1"
 	aiclient "pet-angel/internal/ai"
+	"pet-angel/internal/auth"
 	"pet-angel/internal/biz"
-	"pet-angel/internal/conf"
-	jwtutil "pet-angel/internal/util/jwt"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
 )
 
 // AuthService 认证服务
-// 提供登录、重新登录校验、获取与更新用户信息的接口
+// 提供注册、登录（含第三方登录）、刷新/退出、重新登录校验、获取与更新用户信息、注销账号与个人数据导出的接口
 
 type AuthService struct {
 	authv1.UnimplementedAuthServiceServer
-	uc        *biz.AuthUsecase
-	jwtSecret string
-	logger    *log.Helper
+	uc      *biz.AuthUsecase
+	account *biz.AccountUsecase
+	oauth   *biz.OAuthUsecase
+	phone   *biz.PhoneLoginUsecase
+	proxies *auth.TrustedProxies
+	logger  *log.Helper
 }
 
-// NewAuthService 构造函数，注入用例与 JWT 配置
-func NewAuthService(uc *biz.AuthUsecase, authCfg *conf.Auth, l log.Logger) *AuthService {
-	secret := ""
-	if authCfg != nil {
-		secret = authCfg.JwtSecret
-	}
-	jwtutil.SetGlobalSecret(secret)
+// NewAuthService 构造函数，注入用例
+func NewAuthService(uc *biz.AuthUsecase, account *biz.AccountUsecase, oauth *biz.OAuthUsecase, phone *biz.PhoneLoginUsecase, proxies *auth.TrustedProxies, l log.Logger) *AuthService {
 	// 初始化 AI 客户端（从全局配置加载）。若未配置将使用默认（硅基流动）
 	// 这里通过 kratos config 不易直接获取整体 config，因此采用默认构造，
 	// 在 main/wire 初始化阶段可考虑加载 ai 配置并 SetClient；此处兜底。
 	if aiclient.Default() == nil {
 		aiclient.SetClient(aiclient.NewClient(aiclient.Config{}))
 	}
-	return &AuthService{uc: uc, jwtSecret: secret, logger: log.NewHelper(l)}
+	return &AuthService{uc: uc, account: account, oauth: oauth, phone: phone, proxies: proxies, logger: log.NewHelper(l)}
+}
+
+// Register 注册新用户，成功后直接返回 JWT
+func (s *AuthService) Register(ctx context.Context, in *authv1.RegisterRequest) (*authv1.RegisterReply, error) {
+	u, pair, err := s.uc.Register(ctx, in.GetUsername(), in.GetPassword(), in.GetNickname(), s.clientInfo(ctx))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("register failed: %v", err)
+		return nil, err
+	}
+	return &authv1.RegisterReply{
+		UserId:           u.Id,
+		Token:            pair.AccessToken,
+		ExpiresIn:        pair.ExpiresIn,
+		RefreshToken:     pair.RefreshToken,
+		RefreshExpiresIn: pair.RefreshExpiresIn,
+	}, nil
 }
 
 // Login 用户名+密码登录，返回 JWT
 func (s *AuthService) Login(ctx context.Context, in *authv1.LoginRequest) (*authv1.LoginReply, error) {
-	u, token, exp, err := s.uc.Login(ctx, in.GetUsername(), in.GetPassword())
+	u, pair, err := s.uc.Login(ctx, in.GetUsername(), in.GetPassword(), s.clientInfo(ctx))
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("login failed: %v", err)
 		return nil, err
 	}
-	return &authv1.LoginReply{UserId: u.Id, Token: token, ExpiresIn: exp}, nil
+	return &authv1.LoginReply{
+		UserId:           u.Id,
+		Token:            pair.AccessToken,
+		ExpiresIn:        pair.ExpiresIn,
+		RefreshToken:     pair.RefreshToken,
+		RefreshExpiresIn: pair.RefreshExpiresIn,
+	}, nil
 }
 
-// Relogin 校验当前请求头中的 JWT 是否有效
-func (s *AuthService) Relogin(ctx context.Context, in *authv1.ReloginRequest) (*authv1.ReloginReply, error) {
-	ts, ok := transport.FromServerContext(ctx)
+// SendLoginCode 发送手机登录验证码
+func (s *AuthService) SendLoginCode(ctx context.Context, in *authv1.SendLoginCodeRequest) (*authv1.SendLoginCodeReply, error) {
+	ttl, resendAfter, err := s.phone.SendCode(ctx, in.GetPhone(), s.clientInfo(ctx))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("send login code failed: %v", err)
+		return nil, err
+	}
+	return &authv1.SendLoginCodeReply{
+		Success:     true,
+		ExpiresIn:   int32(ttl.Seconds()),
+		ResendAfter: int32(resendAfter.Seconds()),
+	}, nil
+}
+
+// LoginWithCode 手机验证码登录（未注册的手机号自动注册）
+func (s *AuthService) LoginWithCode(ctx context.Context, in *authv1.LoginWithCodeRequest) (*authv1.LoginWithCodeReply, error) {
+	u, pair, created, err := s.phone.Login(ctx, in.GetPhone(), in.GetCode(), s.clientInfo(ctx))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("login with code failed: %v", err)
+		return nil, err
+	}
+	return &authv1.LoginWithCodeReply{
+		UserId:           u.Id,
+		Token:            pair.AccessToken,
+		ExpiresIn:        pair.ExpiresIn,
+		RefreshToken:     pair.RefreshToken,
+		RefreshExpiresIn: pair.RefreshExpiresIn,
+		IsNewUser:        created,
+	}, nil
+}
+
+// OAuthLogin 第三方登录（未绑定的外部账号自动注册）
+func (s *AuthService) OAuthLogin(ctx context.Context, in *authv1.OAuthLoginRequest) (*authv1.OAuthLoginReply, error) {
+	u, pair, created, err := s.oauth.Login(ctx, in.GetProvider(), in.GetCode(), in.GetRedirectUri(), s.clientInfo(ctx))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("oauth login failed: provider=%s: %v", in.GetProvider(), err)
+		return nil, err
+	}
+	return &authv1.OAuthLoginReply{
+		UserId:           u.Id,
+		Token:            pair.AccessToken,
+		ExpiresIn:        pair.ExpiresIn,
+		RefreshToken:     pair.RefreshToken,
+		RefreshExpiresIn: pair.RefreshExpiresIn,
+		IsNewUser:        created,
+	}, nil
+}
+
+// LinkOAuthIdentity 为当前用户绑定第三方账号
+func (s *AuthService) LinkOAuthIdentity(ctx context.Context, in *authv1.LinkOAuthIdentityRequest) (*authv1.LinkOAuthIdentityReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.oauth.Link(ctx, userID, in.GetProvider(), in.GetCode(), in.GetRedirectUri()); err != nil {
+		s.logger.WithContext(ctx).Errorf("link oauth identity: provider=%s: %v", in.GetProvider(), err)
+		return nil, err
+	}
+	return &authv1.LinkOAuthIdentityReply{Success: true}, nil
+}
+
+// ListOAuthIdentities 当前用户已绑定的第三方账号
+func (s *AuthService) ListOAuthIdentities(ctx context.Context, in *authv1.ListOAuthIdentitiesRequest) (*authv1.ListOAuthIdentitiesReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.oauth.ListIdentities(ctx, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list oauth identities: usecase error: %v", err)
+		return nil, err
+	}
+	out := make([]*authv1.OAuthIdentity, 0, len(list))
+	for _, i := range list {
+		item := &authv1.OAuthIdentity{
+			Provider:  i.Provider,
+			Nickname:  i.Nickname,
+			Avatar:    i.Avatar,
+			CreatedAt: i.CreatedAt.Format("2006-01-02 15:04:05"),
+		}
+		if !i.LastLoginAt.IsZero() {
+			item.LastLoginAt = i.LastLoginAt.Format("2006-01-02 15:04:05")
+		}
+		out = append(out, item)
+	}
+	return &authv1.ListOAuthIdentitiesReply{Identities: out}, nil
+}
+
+// Refresh 使用 refresh token 换取新的令牌对
+func (s *AuthService) Refresh(ctx context.Context, in *authv1.RefreshRequest) (*authv1.RefreshReply, error) {
+	pair, err := s.uc.Refresh(ctx, in.GetRefreshToken())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("refresh failed: %v", err)
+		return nil, err
+	}
+	return &authv1.RefreshReply{
+		Token:            pair.AccessToken,
+		ExpiresIn:        pair.ExpiresIn,
+		RefreshToken:     pair.RefreshToken,
+		RefreshExpiresIn: pair.RefreshExpiresIn,
+	}, nil
+}
+
+// Logout 退出当前会话
+func (s *AuthService) Logout(ctx context.Context, in *authv1.LogoutRequest) (*authv1.LogoutReply, error) {
+	p, ok := auth.FromContext(ctx)
 	if !ok {
-		return &authv1.ReloginReply{Expire: true}, nil
+		return nil, auth.ErrUnauthorized
 	}
-	authHeader := ts.RequestHeader().Get("Authorization")
-	tok, err := jwtutil.FromAuthHeader(authHeader)
+	if err := s.uc.Logout(ctx, p.SessionID, p.TokenID, p.ExpiresAt); err != nil {
+		s.logger.WithContext(ctx).Errorf("logout: usecase error: %v", err)
+		return nil, err
+	}
+	return &authv1.LogoutReply{Success: true}, nil
+}
+
+// LogoutAll 退出全部设备
+func (s *AuthService) LogoutAll(ctx context.Context, in *authv1.LogoutAllRequest) (*authv1.LogoutAllReply, error) {
+	userID, err := auth.UserID(ctx)
 	if err != nil {
-		return &authv1.ReloginReply{Expire: true}, nil
+		s.logger.WithContext(ctx).Errorf("logout all: auth failed: %v", err)
+		return nil, err
+	}
+	if err := s.uc.LogoutAll(ctx, userID); err != nil {
+		s.logger.WithContext(ctx).Errorf("logout all: usecase error: %v", err)
+		return nil, err
 	}
-	_, perr := jwtutil.Parse(s.jwtSecret, tok)
-	return &authv1.ReloginReply{Expire: perr != nil}, nil
+	return &authv1.LogoutAllReply{Success: true}, nil
 }
 
-// GetUserInfo 解析 JWT 获取用户信息
-func (s *AuthService) GetUserInfo(ctx context.Context, in *authv1.GetUserInfoRequest) (*authv1.GetUserInfoReply, error) {
-	ts, ok := transport.FromServerContext(ctx)
+// ListSessions 当前用户的登录设备/会话列表
+func (s *AuthService) ListSessions(ctx context.Context, in *authv1.ListSessionsRequest) (*authv1.ListSessionsReply, error) {
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, auth.ErrUnauthorized
+	}
+	list, err := s.uc.ListSessions(ctx, p.UserID)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list sessions: usecase error: %v", err)
+		return nil, err
+	}
+	out := &authv1.ListSessionsReply{Sessions: make([]*authv1.SessionInfo, 0, len(list))}
+	for _, v := range list {
+		out.Sessions = append(out.Sessions, &authv1.SessionInfo{
+			Id:         v.ID,
+			UserAgent:  v.UserAgent,
+			Ip:         v.IP,
+			CreatedAt:  v.CreatedAt.Format("2006-01-02 15:04:05"),
+			LastSeenAt: v.LastSeenAt.Format("2006-01-02 15:04:05"),
+			Current:    v.ID == p.SessionID,
+		})
+	}
+	return out, nil
+}
+
+// RevokeSession 踢下线指定会话
+func (s *AuthService) RevokeSession(ctx context.Context, in *authv1.RevokeSessionRequest) (*authv1.RevokeSessionReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("revoke session: auth failed: %v", err)
+		return nil, err
+	}
+	if err := s.uc.RevokeSession(ctx, userID, in.GetSessionId()); err != nil {
+		s.logger.WithContext(ctx).Errorf("revoke session: usecase error: %v", err)
+		return nil, err
+	}
+	return &authv1.RevokeSessionReply{Success: true}, nil
+}
+
+// ChangePassword 修改密码
+func (s *AuthService) ChangePassword(ctx context.Context, in *authv1.ChangePasswordRequest) (*authv1.ChangePasswordReply, error) {
+	p, ok := auth.FromContext(ctx)
 	if !ok {
-		s.logger.WithContext(ctx).Errorf("get user info: no server context")
-		return nil, http.ErrNoCookie
+		return nil, auth.ErrUnauthorized
+	}
+	if err := s.uc.ChangePassword(ctx, p.UserID, p.SessionID, in.GetOldPassword(), in.GetNewPassword()); err != nil {
+		s.logger.WithContext(ctx).Errorf("change password: usecase error: %v", err)
+		return nil, err
+	}
+	return &authv1.ChangePasswordReply{Success: true}, nil
+}
+
+// RequestPasswordReset 申请密码重置验证码
+func (s *AuthService) RequestPasswordReset(ctx context.Context, in *authv1.RequestPasswordResetRequest) (*authv1.RequestPasswordResetReply, error) {
+	if err := s.uc.RequestPasswordReset(ctx, in.GetUsername()); err != nil {
+		s.logger.WithContext(ctx).Errorf("request password reset failed: %v", err)
+		return nil, err
+	}
+	return &authv1.RequestPasswordResetReply{Success: true}, nil
+}
+
+// ResetPassword 使用验证码重置密码
+func (s *AuthService) ResetPassword(ctx context.Context, in *authv1.ResetPasswordRequest) (*authv1.ResetPasswordReply, error) {
+	if err := s.uc.ResetPassword(ctx, in.GetUsername(), in.GetCode(), in.GetNewPassword()); err != nil {
+		s.logger.WithContext(ctx).Errorf("reset password failed: %v", err)
+		return nil, err
+	}
+	return &authv1.ResetPasswordReply{Success: true}, nil
+}
+
+// DeleteAccount 注销当前账号（校验密码；无密码账号须刚刚重新登录）
+func (s *AuthService) DeleteAccount(ctx context.Context, in *authv1.DeleteAccountRequest) (*authv1.DeleteAccountReply, error) {
+	p, ok := auth.FromContext(ctx)
+	if !ok || p.UserID <= 0 {
+		return nil, auth.ErrUnauthorized
+	}
+	userID := p.UserID
+	if err := s.account.DeleteAccount(ctx, userID, p.SessionID, in.GetPassword()); err != nil {
+		s.logger.WithContext(ctx).Errorf("delete account: usecase error: %v", err)
+		return nil, err
 	}
-	tok, err := jwtutil.FromAuthHeader(ts.RequestHeader().Get("Authorization"))
+	s.logger.WithContext(ctx).Infof("account %d deleted", userID)
+	return &authv1.DeleteAccountReply{Success: true}, nil
+}
+
+// ExportMyData 导出个人数据（gRPC 版本，ZIP 放在 data 字段）
+func (s *AuthService) ExportMyData(ctx context.Context, in *authv1.ExportMyDataRequest) (*authv1.ExportMyDataReply, error) {
+	userID, err := auth.UserID(ctx)
 	if err != nil {
-		s.logger.WithContext(ctx).Errorf("get user info: bad auth header: %v", err)
 		return nil, err
 	}
-	claims, err := jwtutil.Parse(s.jwtSecret, tok)
+	var buf bytes.Buffer
+	if err := s.account.ExportMyData(ctx, userID, &buf); err != nil {
+		s.logger.WithContext(ctx).Errorf("export data: usecase error: %v", err)
+		return nil, err
+	}
+	return &authv1.ExportMyDataReply{Filename: exportFilename(userID), Data: buf.Bytes()}, nil
+}
+
+// ExportMyDataHTTP 提供原生 HTTP 处理器，直接以附件形式返回 ZIP
+func (s *AuthService) ExportMyDataHTTP() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		// 鉴权（由 server 层 authFilter 写入 Principal）
+		userID, err := auth.UserID(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		// 先完整生成再写出，避免中途出错时客户端拿到损坏的 ZIP
+		var buf bytes.Buffer
+		if err := s.account.ExportMyData(r.Context(), userID, &buf); err != nil {
+			s.logger.WithContext(r.Context()).Errorf("export data: usecase error: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, exportFilename(userID)))
+		w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+		w.Header().Set("Cache-Control", "no-store")
+		_, _ = buf.WriteTo(w)
+	}
+}
+
+func exportFilename(userID int64) string {
+	return fmt.Sprintf("pet-angel-export-%d-%s.zip", userID, time.Now().Format("20060102"))
+}
+
+// Relogin 校验当前请求头中的 JWT 是否有效
+// 鉴权中间件仅在 token 有效且未被吊销时写入 Principal
+func (s *AuthService) Relogin(ctx context.Context, in *authv1.ReloginRequest) (*authv1.ReloginReply, error) {
+	_, err := auth.UserID(ctx)
+	return &authv1.ReloginReply{Expire: err != nil}, nil
+}
+
+// GetUserInfo 获取当前登录用户信息
+func (s *AuthService) GetUserInfo(ctx context.Context, in *authv1.GetUserInfoRequest) (*authv1.GetUserInfoReply, error) {
+	userID, err := auth.UserID(ctx)
 	if err != nil {
-		s.logger.WithContext(ctx).Errorf("get user info: parse jwt failed: %v", err)
+		s.logger.WithContext(ctx).Errorf("get user info: auth failed: %v", err)
 		return nil, err
 	}
-	u, err := s.uc.GetUserInfo(ctx, claims.UserID)
+	u, err := s.uc.GetUserInfo(ctx, userID)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("get user info: usecase error: %v", err)
 		return nil, err
@@ -102,28 +370,19 @@ func (s *AuthService) GetUserInfo(ctx context.Context, in *authv1.GetUserInfoReq
 		Coins:       u.Coins,
 		CreatedAt:   u.CreatedAt.Format("2006-01-02 15:04:05"),
 		ModelUrl:    u.ModelURL,
+		ActivePetId: u.ActivePetID,
 	}, nil
 }
 
 // UpdateUserInfo 更新当前用户资料
 func (s *AuthService) UpdateUserInfo(ctx context.Context, in *authv1.UpdateUserInfoRequest) (*authv1.UpdateUserInfoReply, error) {
-	ts, ok := transport.FromServerContext(ctx)
-	if !ok {
-		s.logger.WithContext(ctx).Errorf("update user info: no server context")
-		return nil, http.ErrNoCookie
-	}
-	token, err := jwtutil.FromAuthHeader(ts.RequestHeader().Get("Authorization"))
+	userID, err := auth.UserID(ctx)
 	if err != nil {
-		s.logger.WithContext(ctx).Errorf("update user info: bad auth header: %v", err)
-		return nil, err
-	}
-	claims, err := jwtutil.Parse(s.jwtSecret, token)
-	if err != nil {
-		s.logger.WithContext(ctx).Errorf("update user info: parse jwt failed: %v", err)
+		s.logger.WithContext(ctx).Errorf("update user info: auth failed: %v", err)
 		return nil, err
 	}
 	user := &biz.User{
-		Id:          claims.UserID,
+		Id:          userID,
 		Nickname:    in.GetNickname(),
 		Avatar:      in.GetAvatar(),
 		ModelID:     in.GetModelId(),
@@ -135,7 +394,6 @@ func (s *AuthService) UpdateUserInfo(ctx context.Context, in *authv1.UpdateUserI
 		Weight:      in.GetWeight(),
 		Hobby:       in.GetHobby(),
 		Description: in.GetDescription(),
-		Coins:       in.GetCoins(), // 允许直接修改金币（demo模式）
 	}
 	if err := s.uc.UpdateUserInfo(ctx, user); err != nil {
 		s.logger.WithContext(ctx).Errorf("update user info: usecase error: %v", err)
@@ -143,3 +401,22 @@ func (s *AuthService) UpdateUserInfo(ctx context.Context, in *authv1.UpdateUserI
 	}
 	return &authv1.UpdateUserInfoReply{Success: true}, nil
 }
+
+// clientInfo 提取发起请求的客户端 UA 与 IP
+// IP 默认取连接对端地址；仅当对端是配置的可信代理时才采用 X-Forwarded-For / X-Real-IP（防止伪造转发头绕过按 IP 的限流）
+func (s *AuthService) clientInfo(ctx context.Context) biz.ClientInfo {
+	var ci biz.ClientInfo
+	var remote, xff, realIP string
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		h := tr.RequestHeader()
+		ci.UserAgent = h.Get("User-Agent")
+		xff, realIP = h.Get("X-Forwarded-For"), h.Get("X-Real-IP")
+	}
+	if r, ok := khttp.RequestFromServerContext(ctx); ok {
+		remote = r.RemoteAddr
+	} else if pr, ok := peer.FromContext(ctx); ok {
+		remote = pr.Addr.String()
+	}
+	ci.IP = s.proxies.ClientIP(remote, xff, realIP)
+	return ci
+}
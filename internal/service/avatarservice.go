@@ -3,57 +3,77 @@ package service
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"math"
 	"net/http"
 	"time"
 
 	avatv1 "pet-angel/api/avatar/v1"
 	"pet-angel/internal/ai"
+	"pet-angel/internal/auth"
 	"pet-angel/internal/biz"
-	"pet-angel/internal/conf"
 	"pet-angel/internal/util"
-	jwtutil "pet-angel/internal/util/jwt"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/transport"
 )
 
 // AvatarService 虚拟形象/道具/聊天 服务
-// 负责：JWT 解析、参数与 proto 映射、错误日志
+// 负责：参数与 proto 映射、错误日志（登录用户由鉴权中间件写入 context）
 
 type AvatarService struct {
 	avatv1.UnimplementedAvatarServiceServer
 	uc        *biz.AvatarUsecase
-	jwtSecret string
+	inventory *biz.InventoryUsecase
+	pets      *biz.PetStateUsecase
+	levels    *biz.PetLevelUsecase
+	catalog   *biz.CatalogUsecase
 	logger    *log.Helper
 }
 
 // NewAvatarService 依赖注入构造器
-func NewAvatarService(uc *biz.AvatarUsecase, authCfg *conf.Auth, l log.Logger) *AvatarService {
-	secret := ""
-	if authCfg != nil {
-		secret = authCfg.JwtSecret
+func NewAvatarService(uc *biz.AvatarUsecase, inventory *biz.InventoryUsecase, pets *biz.PetStateUsecase, levels *biz.PetLevelUsecase, catalog *biz.CatalogUsecase, l log.Logger) *AvatarService {
+	return &AvatarService{uc: uc, inventory: inventory, pets: pets, levels: levels, catalog: catalog, logger: log.NewHelper(l)}
+}
+
+func itemToPB(it *biz.Item) *avatv1.Item {
+	effects := make([]*avatv1.ItemEffect, 0, len(it.Effects))
+	for _, e := range it.Effects {
+		effects = append(effects, &avatv1.ItemEffect{Stat: e.Stat, Delta: e.Delta})
+	}
+	return &avatv1.Item{Id: it.ID, Name: it.Name, Description: it.Description, IconPath: it.IconPath, CoinCost: it.CoinCost, SortOrder: it.SortOrder, Effects: effects}
+}
+
+func petStateToPB(st *biz.PetState) *avatv1.PetState {
+	return &avatv1.PetState{
+		Hunger:      int32(math.Round(st.Hunger)),
+		Mood:        int32(math.Round(st.Mood)),
+		Energy:      int32(math.Round(st.Energy)),
+		Cleanliness: int32(math.Round(st.Cleanliness)),
+		UpdatedAt:   st.UpdatedAt.Format("2006-01-02 15:04:05"),
+		PetId:       st.PetID,
 	}
-	return &AvatarService{uc: uc, jwtSecret: secret, logger: log.NewHelper(l)}
 }
 
 // GetModels 获取可用模型
 func (s *AvatarService) GetModels(ctx context.Context, in *avatv1.GetModelsRequest) (*avatv1.GetModelsReply, error) {
-	list, err := s.uc.GetModels(ctx)
+	list, err := s.uc.GetModels(ctx, auth.ViewerID(ctx))
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("get models failed: %v", err)
 		return nil, err
 	}
 	out := make([]*avatv1.PetModel, 0, len(list))
 	for _, m := range list {
-		out = append(out, &avatv1.PetModel{Id: m.ID, Name: m.Name, Path: m.Path, ModelType: m.ModelType, IsDefault: m.IsDefault, SortOrder: m.SortOrder})
+		out = append(out, &avatv1.PetModel{
+			Id: m.ID, Name: m.Name, Path: m.Path, ModelType: m.ModelType, IsDefault: m.IsDefault, SortOrder: m.SortOrder,
+			Locked:            m.Locked,
+			UnlockRequirement: &avatv1.UnlockRequirement{Level: m.UnlockLevel, Coins: m.UnlockCoins, Description: m.UnlockRequirement()},
+		})
 	}
-	return &avatv1.GetModelsReply{Models: out}, nil
+	return &avatv1.GetModelsReply{Models: out, CatalogVersion: catalogVersion(ctx, s.catalog, biz.CatalogPetModels, s.logger)}, nil
 }
 
 // SetPetModel 设置当前模型
 func (s *AvatarService) SetPetModel(ctx context.Context, in *avatv1.SetPetModelRequest) (*avatv1.SetPetModelReply, error) {
-	userID, err := s.userIDFromCtx(ctx)
+	userID, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("set model: auth failed: %v", err)
 		return nil, err
@@ -65,6 +85,21 @@ func (s *AvatarService) SetPetModel(ctx context.Context, in *avatv1.SetPetModelR
 	return &avatv1.SetPetModelReply{Success: true}, nil
 }
 
+// UnlockPetModel 用金币解锁模型
+func (s *AvatarService) UnlockPetModel(ctx context.Context, in *avatv1.UnlockPetModelRequest) (*avatv1.UnlockPetModelReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("unlock model: auth failed: %v", err)
+		return nil, err
+	}
+	coins, err := s.uc.UnlockPetModel(ctx, userID, in.GetModelId())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("unlock model: usecase error: %v", err)
+		return nil, err
+	}
+	return &avatv1.UnlockPetModelReply{Success: true, Coins: coins}, nil
+}
+
 // GetItems 获取道具列表
 func (s *AvatarService) GetItems(ctx context.Context, in *avatv1.GetItemsRequest) (*avatv1.GetItemsReply, error) {
 	list, err := s.uc.GetItems(ctx)
@@ -74,29 +109,113 @@ func (s *AvatarService) GetItems(ctx context.Context, in *avatv1.GetItemsRequest
 	}
 	out := make([]*avatv1.Item, 0, len(list))
 	for _, it := range list {
-		out = append(out, &avatv1.Item{Id: it.ID, Name: it.Name, Description: it.Description, IconPath: it.IconPath, CoinCost: it.CoinCost})
+		out = append(out, itemToPB(it))
+	}
+	return &avatv1.GetItemsReply{Items: out, CatalogVersion: catalogVersion(ctx, s.catalog, biz.CatalogItems, s.logger)}, nil
+}
+
+// PurchaseItem 购买道具放入背包
+func (s *AvatarService) PurchaseItem(ctx context.Context, in *avatv1.PurchaseItemRequest) (*avatv1.PurchaseItemReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("purchase item: auth failed: %v", err)
+		return nil, err
+	}
+	res, err := s.inventory.Purchase(ctx, userID, in.GetItemId(), in.GetQuantity())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("purchase item: usecase error: %v", err)
+		return nil, err
 	}
-	return &avatv1.GetItemsReply{Items: out}, nil
+	return &avatv1.PurchaseItemReply{PurchaseId: res.Purchase.ID, Quantity: res.Owned, TotalCost: res.Purchase.TotalCost, Coins: res.Coins}, nil
 }
 
-// UseItem 使用道具（扣金币）
+// ListMyItems 我的背包
+func (s *AvatarService) ListMyItems(ctx context.Context, in *avatv1.ListMyItemsRequest) (*avatv1.ListMyItemsReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list my items: auth failed: %v", err)
+		return nil, err
+	}
+	list, err := s.inventory.ListMine(ctx, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list my items: usecase error: %v", err)
+		return nil, err
+	}
+	out := make([]*avatv1.OwnedItem, 0, len(list))
+	for _, v := range list {
+		out = append(out, &avatv1.OwnedItem{Item: itemToPB(v.Item), Quantity: v.Quantity})
+	}
+	return &avatv1.ListMyItemsReply{List: out}, nil
+}
+
+// ListItemPurchases 道具购买记录
+func (s *AvatarService) ListItemPurchases(ctx context.Context, in *avatv1.ListItemPurchasesRequest) (*avatv1.ListItemPurchasesReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list purchases: auth failed: %v", err)
+		return nil, err
+	}
+	list, next, err := s.inventory.ListPurchases(ctx, userID, in.GetCursor(), in.GetPageSize())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list purchases: usecase error: %v", err)
+		return nil, err
+	}
+	out := make([]*avatv1.ItemPurchase, 0, len(list))
+	for _, p := range list {
+		out = append(out, &avatv1.ItemPurchase{
+			Id:        p.ID,
+			ItemId:    p.ItemID,
+			ItemName:  p.ItemName,
+			Quantity:  p.Quantity,
+			UnitCost:  p.UnitCost,
+			TotalCost: p.TotalCost,
+			CreatedAt: p.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return &avatv1.ListItemPurchasesReply{List: out, NextCursor: next}, nil
+}
+
+// UseItem 使用道具（消耗背包中的 1 个）
 func (s *AvatarService) UseItem(ctx context.Context, in *avatv1.UseItemRequest) (*avatv1.UseItemReply, error) {
-	userID, err := s.userIDFromCtx(ctx)
+	userID, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("use item: auth failed: %v", err)
 		return nil, err
 	}
-	_, err = s.uc.UseItem(ctx, userID, in.GetItemId())
+	res, err := s.inventory.Use(ctx, userID, in.GetPetId(), in.GetItemId())
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("use item: usecase error: %v", err)
 		return nil, err
 	}
-	return &avatv1.UseItemReply{Success: true, Message: "ok"}, nil
+	return &avatv1.UseItemReply{Success: true, Message: "ok", Remaining: res.Remaining, State: petStateToPB(res.State)}, nil
+}
+
+// GetPetState 宠物当前状态
+func (s *AvatarService) GetPetState(ctx context.Context, in *avatv1.GetPetStateRequest) (*avatv1.GetPetStateReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("get pet state: auth failed: %v", err)
+		return nil, err
+	}
+	st, err := s.pets.Get(ctx, userID, in.GetPetId())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("get pet state: usecase error: %v", err)
+		return nil, err
+	}
+	lv, err := s.levels.Get(ctx, userID, in.GetPetId())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("get pet level: usecase error: %v", err)
+		return nil, err
+	}
+	return &avatv1.GetPetStateReply{
+		State: petStateToPB(st),
+		Level: &avatv1.PetLevel{Level: lv.Level, Xp: lv.XP, LevelXp: lv.LevelXP, NextLevelXp: lv.NextLevelXP},
+	}, nil
 }
 
 // Chat 发送消息
 func (s *AvatarService) Chat(ctx context.Context, in *avatv1.ChatRequest) (*avatv1.ChatReply, error) {
-	userID, err := s.userIDFromCtx(ctx)
+	userID, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("chat: auth failed: %v", err)
 		return nil, err
@@ -142,16 +261,11 @@ func (s *AvatarService) ChatStreamHTTP() http.HandlerFunc {
 			w.WriteHeader(http.StatusMethodNotAllowed)
 			return
 		}
-		// 鉴权（demo模式放开校验）
-		userID := int64(1) // 默认用户ID
-		authHeader := r.Header.Get("Authorization")
-		if authHeader != "" {
-			tok, err := jwtutil.FromAuthHeader(authHeader)
-			if err == nil {
-				if claims, err := jwtutil.Parse(s.jwtSecret, tok); err == nil {
-					userID = claims.UserID
-				}
-			}
+		// 鉴权（由 server 层 authFilter 写入 Principal）
+		userID, err := auth.UserID(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
 		}
 		var body struct {
 			Content string `json:"content"`
@@ -161,8 +275,15 @@ func (s *AvatarService) ChatStreamHTTP() http.HandlerFunc {
 			return
 		}
 
+		// 聊天对象为当前宠物（人设与聊天记录均按宠物区分）
+		pet, err := s.uc.ActivePet(r.Context(), userID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
 		// 先保存用户消息
-		if _, err := s.uc.SaveUserMessage(r.Context(), userID, body.Content); err != nil {
+		if _, err := s.uc.SaveUserMessage(r.Context(), userID, pet, body.Content); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -189,12 +310,19 @@ func (s *AvatarService) ChatStreamHTTP() http.HandlerFunc {
 			})
 		}
 
-		system := "你是一个治愈系的宠物数字伙伴，以第一人称'我'的口吻，温柔简短地回复。"
+		// 系统提示按宠物模型选用的模板渲染（含宠物状态与相关记忆）
+		system := s.uc.SystemPrompt(r.Context(), userID, pet, body.Content)
+		// 最近几轮聊天（已包含刚保存的用户消息）作为上下文
+		history, err := s.uc.ChatHistory(r.Context(), userID, pet)
+		if err != nil {
+			s.logger.WithContext(r.Context()).Errorf("load chat history failed: %v", err)
+		}
+		msgs := biz.ChatMessages(system, history, body.Content)
 		var full string
 		var hasContent bool
 
 		// 调用AI流式接口
-		_, streamErr := client.Stream(r.Context(), system, body.Content, func(delta string) error {
+		_, streamErr := client.Stream(r.Context(), msgs, func(delta string) error {
 			if delta != "" {
 				hasContent = true
 				full += delta
@@ -209,7 +337,7 @@ func (s *AvatarService) ChatStreamHTTP() http.HandlerFunc {
 
 		// 处理错误
 		if streamErr != nil {
-			fmt.Printf("AI Stream Error: %v\n", streamErr)
+			s.logger.WithContext(r.Context()).Errorf("chat stream: ai stream failed: %v", streamErr)
 			// 发送兜底回复
 			fallbackReply := util.GetFallbackReply(body.Content)
 			_, _ = w.Write([]byte("data: " + fallbackReply + "\n\n"))
@@ -229,8 +357,8 @@ func (s *AvatarService) ChatStreamHTTP() http.HandlerFunc {
 
 		// 保存AI回复到数据库
 		if full != "" {
-			if _, err := s.uc.SaveAIMessage(r.Context(), userID, full); err != nil {
-				fmt.Printf("Save AI Message Error: %v\n", err)
+			if _, err := s.uc.SaveAIMessage(r.Context(), userID, pet, full); err != nil {
+				s.logger.WithContext(r.Context()).Errorf("chat stream: save ai message failed: %v", err)
 			}
 		}
 
@@ -242,23 +370,5 @@ func (s *AvatarService) ChatStreamHTTP() http.HandlerFunc {
 	}
 }
 
-// userIDFromCtx 从请求头解析 JWT 获取 user_id（demo模式放开校验）
-func (s *AvatarService) userIDFromCtx(ctx context.Context) (int64, error) {
-	// demo模式：如果没有token或token无效，返回默认用户ID
-	ts, ok := transport.FromServerContext(ctx)
-	if !ok {
-		return 1, nil // 默认用户ID
-	}
-	tok, err := jwtutil.FromAuthHeader(ts.RequestHeader().Get("Authorization"))
-	if err != nil {
-		return 1, nil // 默认用户ID
-	}
-	claims, err := jwtutil.Parse(s.jwtSecret, tok)
-	if err != nil {
-		return 1, nil // 默认用户ID
-	}
-	return claims.UserID, nil
-}
-
 // ensure time import used
 var _ = time.Second
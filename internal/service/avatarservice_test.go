@@ -27,14 +27,14 @@ func (m *mockAvatarUC) SaveAIMessage(_ interface{}, _ int64, content string) (*b
 
 func TestAvatarService_ChatRouteExist(t *testing.T) {
 	// just test route wiring works
-	s := &AvatarService{uc: &biz.AvatarUsecase{}, jwtSecret: "", logger: nil}
+	s := &AvatarService{uc: &biz.AvatarUsecase{}, logger: nil}
 	_ = s // no-op
 }
 
 func TestUploadRouteExists(t *testing.T) {
 	srv := khttp.NewServer()
 	svc := &GreeterService{}
-	avat := &AvatarService{uc: biz.NewAvatarUsecase(nil), jwtSecret: "", logger: nil}
+	avat := &AvatarService{uc: biz.NewAvatarUsecase(nil, nil, nil, nil, nil), logger: nil}
 	avatv1.RegisterAvatarServiceHTTPServer(srv, avat)
 	ts := httptest.NewServer(srv)
 	defer ts.Close()
@@ -5,13 +5,11 @@ import (
 	"strings"
 
 	pb "pet-angel/api/community/v1"
+	"pet-angel/internal/auth"
 	"pet-angel/internal/biz"
-	"pet-angel/internal/conf"
 	"pet-angel/internal/util"
-	jwtutil "pet-angel/internal/util/jwt"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/transport"
 )
 
 // CommunityService 提供社区相关接口的服务适配层
@@ -20,35 +18,13 @@ import (
 
 type CommunityService struct {
 	pb.UnimplementedCommunityServiceServer
-	uc        *biz.CommunityUsecase
-	jwtSecret string
-	logger    *log.Helper
+	uc      *biz.CommunityUsecase
+	catalog *biz.CatalogUsecase
+	logger  *log.Helper
 }
 
-func NewCommunityService(uc *biz.CommunityUsecase, cfg *conf.Auth, l log.Logger) *CommunityService {
-	secret := ""
-	if cfg != nil {
-		secret = cfg.JwtSecret
-	}
-	return &CommunityService{uc: uc, jwtSecret: secret, logger: log.NewHelper(l)}
-}
-
-// userID 解析登录用户ID（demo模式放开校验）
-func (s *CommunityService) userID(ctx context.Context) (int64, error) {
-	// demo模式：如果没有token或token无效，返回默认用户ID
-	ts, ok := transport.FromServerContext(ctx)
-	if !ok {
-		return 1, nil // 默认用户ID
-	}
-	tok, err := jwtutil.FromAuthHeader(ts.RequestHeader().Get("Authorization"))
-	if err != nil {
-		return 1, nil // 默认用户ID
-	}
-	claims, err := jwtutil.Parse(s.jwtSecret, tok)
-	if err != nil {
-		return 1, nil // 默认用户ID
-	}
-	return claims.UserID, nil
+func NewCommunityService(uc *biz.CommunityUsecase, catalog *biz.CatalogUsecase, l log.Logger) *CommunityService {
+	return &CommunityService{uc: uc, catalog: catalog, logger: log.NewHelper(l)}
 }
 
 // GetCategories 分类列表（公开）
@@ -58,22 +34,29 @@ func (s *CommunityService) GetCategories(ctx context.Context, req *pb.GetCategor
 		s.logger.WithContext(ctx).Errorf("get categories failed: %v", err)
 		return nil, err
 	}
-	out := &pb.GetCategoriesReply{}
+	out := &pb.GetCategoriesReply{CatalogVersion: catalogVersion(ctx, s.catalog, biz.CatalogCategories, s.logger)}
 	for _, c := range list {
-		out.Categories = append(out.Categories, &pb.Category{Id: c.ID, Name: c.Name})
+		out.Categories = append(out.Categories, &pb.Category{Id: c.ID, Name: c.Name, SortOrder: c.SortOrder})
 	}
 	return out, nil
 }
 
+// catalogVersion 查询目录版本号；失败只记录日志并返回 0（客户端按无版本处理，不影响列表本身）
+func catalogVersion(ctx context.Context, uc *biz.CatalogUsecase, catalog string, l *log.Helper) int64 {
+	if uc == nil {
+		return 0
+	}
+	v, err := uc.Version(ctx, catalog)
+	if err != nil {
+		l.WithContext(ctx).Warnf("get %s catalog version failed: %v", catalog, err)
+		return 0
+	}
+	return v
+}
+
 // GetPostList 帖子列表（公开）
 func (s *CommunityService) GetPostList(ctx context.Context, req *pb.GetPostListRequest) (*pb.GetPostListReply, error) {
-	viewerID := int64(0)
-	if _, ok := transport.FromServerContext(ctx); ok {
-		id, err := s.userID(ctx)
-		if err == nil {
-			viewerID = id
-		}
-	}
+	viewerID := auth.ViewerID(ctx)
 
 	// 标准化分页参数
 	pagination := util.NormalizePagination(req.GetPage(), req.GetPageSize())
@@ -109,13 +92,7 @@ func (s *CommunityService) GetPostList(ctx context.Context, req *pb.GetPostListR
 
 // GetPostDetail 帖子详情（公开）
 func (s *CommunityService) GetPostDetail(ctx context.Context, req *pb.GetPostDetailRequest) (*pb.GetPostDetailReply, error) {
-	viewerID := int64(0)
-	if _, ok := transport.FromServerContext(ctx); ok {
-		id, err := s.userID(ctx)
-		if err == nil {
-			viewerID = id
-		}
-	}
+	viewerID := auth.ViewerID(ctx)
 	p, err := s.uc.GetPostDetail(ctx, viewerID, req.GetPostId())
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("get post detail failed: %v", err)
@@ -143,7 +120,7 @@ func (s *CommunityService) GetPostDetail(ctx context.Context, req *pb.GetPostDet
 
 // CreatePost 发帖（鉴权）
 func (s *CommunityService) CreatePost(ctx context.Context, req *pb.CreatePostRequest) (*pb.CreatePostReply, error) {
-	uid, err := s.userID(ctx)
+	uid, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("create post: auth failed: %v", err)
 		return nil, err
@@ -169,7 +146,7 @@ func (s *CommunityService) CreatePost(ctx context.Context, req *pb.CreatePostReq
 
 // LikePost 点赞（鉴权）
 func (s *CommunityService) LikePost(ctx context.Context, req *pb.LikePostRequest) (*pb.LikePostReply, error) {
-	uid, err := s.userID(ctx)
+	uid, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("like post: auth failed: %v", err)
 		return nil, err
@@ -183,7 +160,7 @@ func (s *CommunityService) LikePost(ctx context.Context, req *pb.LikePostRequest
 
 // UnlikePost 取消点赞（鉴权）
 func (s *CommunityService) UnlikePost(ctx context.Context, req *pb.UnlikePostRequest) (*pb.UnlikePostReply, error) {
-	uid, err := s.userID(ctx)
+	uid, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("unlike post: auth failed: %v", err)
 		return nil, err
@@ -197,13 +174,7 @@ func (s *CommunityService) UnlikePost(ctx context.Context, req *pb.UnlikePostReq
 
 // GetCommentList 评论列表（公开）
 func (s *CommunityService) GetCommentList(ctx context.Context, req *pb.GetCommentListRequest) (*pb.GetCommentListReply, error) {
-	viewerID := int64(0)
-	if _, ok := transport.FromServerContext(ctx); ok {
-		id, err := s.userID(ctx)
-		if err == nil {
-			viewerID = id
-		}
-	}
+	viewerID := auth.ViewerID(ctx)
 
 	// 标准化分页参数
 	pagination := util.NormalizePagination(req.GetPage(), req.GetPageSize())
@@ -229,7 +200,7 @@ func (s *CommunityService) GetCommentList(ctx context.Context, req *pb.GetCommen
 
 // CreateComment 发表评论（鉴权）
 func (s *CommunityService) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CreateCommentReply, error) {
-	uid, err := s.userID(ctx)
+	uid, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("create comment: auth failed: %v", err)
 		return nil, err
@@ -244,7 +215,7 @@ func (s *CommunityService) CreateComment(ctx context.Context, req *pb.CreateComm
 
 // LikeComment 点赞评论（鉴权）
 func (s *CommunityService) LikeComment(ctx context.Context, req *pb.LikeCommentRequest) (*pb.LikeCommentReply, error) {
-	uid, err := s.userID(ctx)
+	uid, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("like comment: auth failed: %v", err)
 		return nil, err
@@ -258,7 +229,7 @@ func (s *CommunityService) LikeComment(ctx context.Context, req *pb.LikeCommentR
 
 // UnlikeComment 取消点赞评论（鉴权）
 func (s *CommunityService) UnlikeComment(ctx context.Context, req *pb.UnlikeCommentRequest) (*pb.UnlikeCommentReply, error) {
-	uid, err := s.userID(ctx)
+	uid, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("unlike comment: auth failed: %v", err)
 		return nil, err
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	memoryv1 "pet-angel/api/memory/v1"
+	"pet-angel/internal/auth"
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// MemoryService 宠物长期记忆：查看与删除（整理由 biz.MemoryWorker 负责）
+
+type MemoryService struct {
+	memoryv1.UnimplementedMemoryServiceServer
+	uc     *biz.PetMemoryUsecase
+	logger *log.Helper
+}
+
+// NewMemoryService 依赖注入构造器
+func NewMemoryService(uc *biz.PetMemoryUsecase, l log.Logger) *MemoryService {
+	return &MemoryService{uc: uc, logger: log.NewHelper(l)}
+}
+
+// ListMemories 记忆列表
+func (s *MemoryService) ListMemories(ctx context.Context, in *memoryv1.ListMemoriesRequest) (*memoryv1.ListMemoriesReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.uc.List(ctx, userID, in.GetPetId(), in.GetKind())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list memories failed: %v", err)
+		return nil, err
+	}
+	out := &memoryv1.ListMemoriesReply{List: make([]*memoryv1.Memory, 0, len(list))}
+	for _, m := range list {
+		out.List = append(out.List, &memoryv1.Memory{
+			Id: m.ID, PetId: m.PetID, Kind: m.Kind, Content: m.Content, CreatedAt: m.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return out, nil
+}
+
+// DeleteMemory 删除一条记忆
+func (s *MemoryService) DeleteMemory(ctx context.Context, in *memoryv1.DeleteMemoryRequest) (*memoryv1.DeleteMemoryReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.Delete(ctx, userID, in.GetId()); err != nil {
+		s.logger.WithContext(ctx).Errorf("delete memory failed: %v", err)
+		return nil, err
+	}
+	return &memoryv1.DeleteMemoryReply{Success: true}, nil
+}
@@ -4,57 +4,34 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"time"
 
 	msgv1 "pet-angel/api/message/v1"
 	"pet-angel/internal/ai"
+	"pet-angel/internal/auth"
 	"pet-angel/internal/biz"
-	"pet-angel/internal/conf"
 	"pet-angel/internal/util"
-	jwtutil "pet-angel/internal/util/jwt"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/transport"
 )
 
 // MessageService 提供消息/小纸条相关接口
 
 type MessageService struct {
 	msgv1.UnimplementedMessageServiceServer
-	uc        *biz.MessageUsecase
-	jwtSecret string
-	logger    *log.Helper
+	uc      *biz.MessageUsecase
+	prompts *biz.PromptUsecase
+	logger  *log.Helper
 }
 
-func NewMessageService(uc *biz.MessageUsecase, cfg *conf.Auth, l log.Logger) *MessageService {
-	secret := ""
-	if cfg != nil {
-		secret = cfg.JwtSecret
-	}
-	return &MessageService{uc: uc, jwtSecret: secret, logger: log.NewHelper(l)}
-}
-
-// userIDFromCtx 解析 JWT（demo模式放开校验）
-func (s *MessageService) userIDFromCtx(ctx context.Context) (int64, error) {
-	// demo模式：如果没有token或token无效，返回默认用户ID
-	ts, ok := transport.FromServerContext(ctx)
-	if !ok {
-		return 1, nil // 默认用户ID
-	}
-	tok, err := jwtutil.FromAuthHeader(ts.RequestHeader().Get("Authorization"))
-	if err != nil {
-		return 1, nil // 默认用户ID
-	}
-	claims, err := jwtutil.Parse(s.jwtSecret, tok)
-	if err != nil {
-		return 1, nil // 默认用户ID
-	}
-	return claims.UserID, nil
+func NewMessageService(uc *biz.MessageUsecase, prompts *biz.PromptUsecase, l log.Logger) *MessageService {
+	return &MessageService{uc: uc, prompts: prompts, logger: log.NewHelper(l)}
 }
 
 // GetMessageList 列表
 func (s *MessageService) GetMessageList(ctx context.Context, in *msgv1.GetMessageListRequest) (*msgv1.GetMessageListReply, error) {
-	userID, err := s.userIDFromCtx(ctx)
+	userID, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("get messages: parse auth failed: %v", err)
 		return nil, err
@@ -63,7 +40,7 @@ func (s *MessageService) GetMessageList(ctx context.Context, in *msgv1.GetMessag
 	// 标准化分页参数
 	pagination := util.NormalizePagination(in.GetPage(), in.GetPageSize())
 
-	total, list, err := s.uc.GetList(ctx, userID, in.GetOnlyNotes(), pagination.Page, pagination.PageSize)
+	total, list, err := s.uc.GetList(ctx, userID, in.GetPetId(), in.GetOnlyNotes(), pagination.Page, pagination.PageSize)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("get messages: usecase error: %v", err)
 		return nil, err
@@ -72,6 +49,7 @@ func (s *MessageService) GetMessageList(ctx context.Context, in *msgv1.GetMessag
 	for _, m := range list {
 		mm := &msgv1.Message{
 			Id:          m.ID,
+			PetId:       m.PetID,
 			Sender:      m.Sender,
 			MessageType: m.MessageType,
 			IsLocked:    m.IsLocked,
@@ -89,7 +67,7 @@ func (s *MessageService) GetMessageList(ctx context.Context, in *msgv1.GetMessag
 
 // UnlockMessage 解锁
 func (s *MessageService) UnlockMessage(ctx context.Context, in *msgv1.UnlockMessageRequest) (*msgv1.UnlockMessageReply, error) {
-	userID, err := s.userIDFromCtx(ctx)
+	userID, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("unlock message: parse auth failed: %v", err)
 		return nil, err
@@ -104,6 +82,7 @@ func (s *MessageService) UnlockMessage(ctx context.Context, in *msgv1.UnlockMess
 		RemainingCoins: remain,
 		Message: &msgv1.Message{
 			Id:          m.ID,
+			PetId:       m.PetID,
 			Sender:      m.Sender,
 			MessageType: m.MessageType,
 			IsLocked:    m.IsLocked,
@@ -118,34 +97,31 @@ func (s *MessageService) UnlockMessage(ctx context.Context, in *msgv1.UnlockMess
 // 预留：生成当日小纸条（仅用于脚本/后台触发，业务端暂不开放 HTTP）
 // 说明：这里不暴露接口，后续可在 service/script 或 job 中调用 usecase.CreateDailyNotes
 
-// ParseSecretFromCtx 辅助：若没有全局 secret，则用默认实现
-// 我们实现一个简单包装，在 jwt 包中提供
-
 func init() {
 	// 确保 time 包被引用（用于格式化）
 	_ = time.Now()
 }
 
-// GenerateNotesHTTP 暂供演示/内部工具：根据当前登录用户生成今日小纸条（3条免费+1条20金币）
+// GenerateNotesHTTP 运营工具（仅 admin，由 server 层 PolicyFilter 校验）：生成今日小纸条（3条免费+1条20金币）
+// 可通过 ?user_id= 指定目标用户，缺省为当前登录用户
 func (s *MessageService) GenerateNotesHTTP() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			w.WriteHeader(http.StatusMethodNotAllowed)
 			return
 		}
-		// 鉴权
-		authHeader := r.Header.Get("Authorization")
-		tok, err := jwtutil.FromAuthHeader(authHeader)
+		// 鉴权（由 server 层 authFilter 写入 Principal）
+		userID, err := auth.UserID(r.Context())
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusUnauthorized)
 			return
 		}
-		claims, err := jwtutil.Parse(s.jwtSecret, tok)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-			return
+		if v := r.URL.Query().Get("user_id"); v != "" {
+			if userID, err = strconv.ParseInt(v, 10, 64); err != nil || userID <= 0 {
+				http.Error(w, "invalid user_id", http.StatusBadRequest)
+				return
+			}
 		}
-		userID := claims.UserID
 		// 生成 4 条文案（调用统一 AI）
 		client := ai.Default()
 		if client == nil {
@@ -168,8 +144,10 @@ func (s *MessageService) GenerateNotesHTTP() http.HandlerFunc {
 			Coins   int32
 			Content string
 		}, 0, 4)
+		// 以目标用户当前宠物的口吻（系统提示按模板渲染）
+		system := s.prompts.NotePrompt(r.Context(), userID)
 		for i, p := range prompts {
-			txt, _ := client.Chat(r.Context(), "你是治愈系宠物数字伙伴，第一人称‘我’，中文简短。", p)
+			txt, _ := client.Chat(r.Context(), ai.Prompt(system, p))
 			coin := int32(0)
 			if i == 3 {
 				coin = 20
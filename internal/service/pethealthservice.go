@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+
+	pethealthv1 "pet-angel/api/pethealth/v1"
+	"pet-angel/internal/auth"
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PetHealthService 宠物健康档案：体重、疫苗/驱虫、就诊记录
+
+type PetHealthService struct {
+	pethealthv1.UnimplementedPetHealthServiceServer
+	uc     *biz.PetHealthUsecase
+	logger *log.Helper
+}
+
+// NewPetHealthService 依赖注入构造器
+func NewPetHealthService(uc *biz.PetHealthUsecase, l log.Logger) *PetHealthService {
+	return &PetHealthService{uc: uc, logger: log.NewHelper(l)}
+}
+
+// AddWeight 添加体重记录
+func (s *PetHealthService) AddWeight(ctx context.Context, in *pethealthv1.AddWeightRequest) (*pethealthv1.AddWeightReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	w, err := s.uc.AddWeight(ctx, &biz.WeightEntry{
+		UserID: userID, PetID: in.GetPetId(), WeightKg: in.GetWeightKg(), MeasuredOn: in.GetMeasuredOn(), Note: in.GetNote(),
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("add weight failed: %v", err)
+		return nil, err
+	}
+	return &pethealthv1.AddWeightReply{Entry: toWeightPB(w)}, nil
+}
+
+// ListWeights 体重记录列表
+func (s *PetHealthService) ListWeights(ctx context.Context, in *pethealthv1.ListWeightsRequest) (*pethealthv1.ListWeightsReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.uc.ListWeights(ctx, userID, in.GetPetId(), in.GetFrom(), in.GetTo())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list weights failed: %v", err)
+		return nil, err
+	}
+	out := &pethealthv1.ListWeightsReply{List: make([]*pethealthv1.WeightEntry, 0, len(list))}
+	for _, w := range list {
+		out.List = append(out.List, toWeightPB(w))
+	}
+	return out, nil
+}
+
+// DeleteWeight 删除体重记录
+func (s *PetHealthService) DeleteWeight(ctx context.Context, in *pethealthv1.DeleteWeightRequest) (*pethealthv1.DeleteWeightReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteWeight(ctx, userID, in.GetId()); err != nil {
+		s.logger.WithContext(ctx).Errorf("delete weight failed: %v", err)
+		return nil, err
+	}
+	return &pethealthv1.DeleteWeightReply{Success: true}, nil
+}
+
+// GetWeightTrend 体重趋势（图表数据）
+func (s *PetHealthService) GetWeightTrend(ctx context.Context, in *pethealthv1.GetWeightTrendRequest) (*pethealthv1.GetWeightTrendReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.uc.WeightTrend(ctx, userID, in.GetPetId(), in.GetDays())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("get weight trend failed: %v", err)
+		return nil, err
+	}
+	out := &pethealthv1.GetWeightTrendReply{
+		From: t.From, To: t.To, Points: make([]*pethealthv1.WeightPoint, 0, len(t.Points)),
+		LatestKg: t.Latest, MinKg: t.Min, MaxKg: t.Max, ChangeKg: t.Change,
+	}
+	for _, p := range t.Points {
+		out.Points = append(out.Points, &pethealthv1.WeightPoint{Date: p.Date, WeightKg: p.WeightKg})
+	}
+	return out, nil
+}
+
+// CreateCareRecord 添加疫苗/驱虫记录
+func (s *PetHealthService) CreateCareRecord(ctx context.Context, in *pethealthv1.CreateCareRecordRequest) (*pethealthv1.CareRecordReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.uc.CreateCare(ctx, &biz.CareRecord{
+		UserID: userID, PetID: in.GetPetId(), Type: in.GetType(), Name: in.GetName(), GivenOn: in.GetGivenOn(),
+		NextDueOn: in.GetNextDueOn(), Clinic: in.GetClinic(), Note: in.GetNote(),
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("create care record failed: %v", err)
+		return nil, err
+	}
+	return &pethealthv1.CareRecordReply{Record: toCareRecordPB(c)}, nil
+}
+
+// ListCareRecords 疫苗/驱虫记录列表
+func (s *PetHealthService) ListCareRecords(ctx context.Context, in *pethealthv1.ListCareRecordsRequest) (*pethealthv1.ListCareRecordsReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.uc.ListCare(ctx, userID, in.GetPetId(), in.GetType())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list care records failed: %v", err)
+		return nil, err
+	}
+	out := &pethealthv1.ListCareRecordsReply{List: make([]*pethealthv1.CareRecord, 0, len(list))}
+	for _, c := range list {
+		out.List = append(out.List, toCareRecordPB(c))
+	}
+	return out, nil
+}
+
+// UpdateCareRecord 修改疫苗/驱虫记录
+func (s *PetHealthService) UpdateCareRecord(ctx context.Context, in *pethealthv1.UpdateCareRecordRequest) (*pethealthv1.CareRecordReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.uc.UpdateCare(ctx, &biz.CareRecord{
+		ID: in.GetId(), UserID: userID, Name: in.GetName(), GivenOn: in.GetGivenOn(),
+		NextDueOn: in.GetNextDueOn(), Clinic: in.GetClinic(), Note: in.GetNote(),
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("update care record failed: %v", err)
+		return nil, err
+	}
+	return &pethealthv1.CareRecordReply{Record: toCareRecordPB(c)}, nil
+}
+
+// DeleteCareRecord 删除疫苗/驱虫记录
+func (s *PetHealthService) DeleteCareRecord(ctx context.Context, in *pethealthv1.DeleteCareRecordRequest) (*pethealthv1.DeleteCareRecordReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteCare(ctx, userID, in.GetId()); err != nil {
+		s.logger.WithContext(ctx).Errorf("delete care record failed: %v", err)
+		return nil, err
+	}
+	return &pethealthv1.DeleteCareRecordReply{Success: true}, nil
+}
+
+// CreateVetVisit 添加就诊记录
+func (s *PetHealthService) CreateVetVisit(ctx context.Context, in *pethealthv1.CreateVetVisitRequest) (*pethealthv1.VetVisitReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.uc.CreateVisit(ctx, &biz.VetVisit{
+		UserID: userID, PetID: in.GetPetId(), VisitedOn: in.GetVisitedOn(), Clinic: in.GetClinic(), Reason: in.GetReason(),
+		Diagnosis: in.GetDiagnosis(), Notes: in.GetNotes(), Attachments: in.GetAttachments(),
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("create vet visit failed: %v", err)
+		return nil, err
+	}
+	return &pethealthv1.VetVisitReply{Visit: toVetVisitPB(v)}, nil
+}
+
+// ListVetVisits 就诊记录列表
+func (s *PetHealthService) ListVetVisits(ctx context.Context, in *pethealthv1.ListVetVisitsRequest) (*pethealthv1.ListVetVisitsReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.uc.ListVisits(ctx, userID, in.GetPetId())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list vet visits failed: %v", err)
+		return nil, err
+	}
+	out := &pethealthv1.ListVetVisitsReply{List: make([]*pethealthv1.VetVisit, 0, len(list))}
+	for _, v := range list {
+		out.List = append(out.List, toVetVisitPB(v))
+	}
+	return out, nil
+}
+
+// UpdateVetVisit 修改就诊记录
+func (s *PetHealthService) UpdateVetVisit(ctx context.Context, in *pethealthv1.UpdateVetVisitRequest) (*pethealthv1.VetVisitReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.uc.UpdateVisit(ctx, &biz.VetVisit{
+		ID: in.GetId(), UserID: userID, VisitedOn: in.GetVisitedOn(), Clinic: in.GetClinic(), Reason: in.GetReason(),
+		Diagnosis: in.GetDiagnosis(), Notes: in.GetNotes(), Attachments: in.GetAttachments(),
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("update vet visit failed: %v", err)
+		return nil, err
+	}
+	return &pethealthv1.VetVisitReply{Visit: toVetVisitPB(v)}, nil
+}
+
+// DeleteVetVisit 删除就诊记录
+func (s *PetHealthService) DeleteVetVisit(ctx context.Context, in *pethealthv1.DeleteVetVisitRequest) (*pethealthv1.DeleteVetVisitReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteVisit(ctx, userID, in.GetId()); err != nil {
+		s.logger.WithContext(ctx).Errorf("delete vet visit failed: %v", err)
+		return nil, err
+	}
+	return &pethealthv1.DeleteVetVisitReply{Success: true}, nil
+}
+
+func toWeightPB(w *biz.WeightEntry) *pethealthv1.WeightEntry {
+	return &pethealthv1.WeightEntry{
+		Id: w.ID, PetId: w.PetID, WeightKg: w.WeightKg, MeasuredOn: w.MeasuredOn, Note: w.Note,
+		CreatedAt: w.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func toCareRecordPB(c *biz.CareRecord) *pethealthv1.CareRecord {
+	return &pethealthv1.CareRecord{
+		Id: c.ID, PetId: c.PetID, Type: c.Type, Name: c.Name, GivenOn: c.GivenOn, NextDueOn: c.NextDueOn,
+		Clinic: c.Clinic, Note: c.Note,
+		CreatedAt: c.CreatedAt.Format("2006-01-02 15:04:05"), UpdatedAt: c.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func toVetVisitPB(v *biz.VetVisit) *pethealthv1.VetVisit {
+	return &pethealthv1.VetVisit{
+		Id: v.ID, PetId: v.PetID, VisitedOn: v.VisitedOn, Clinic: v.Clinic, Reason: v.Reason, Diagnosis: v.Diagnosis,
+		Notes: v.Notes, Attachments: v.Attachments,
+		CreatedAt: v.CreatedAt.Format("2006-01-02 15:04:05"), UpdatedAt: v.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
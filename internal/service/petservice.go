@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+
+	petv1 "pet-angel/api/pet/v1"
+	"pet-angel/internal/auth"
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PetService 宠物服务：多宠物管理与切换当前宠物
+
+type PetService struct {
+	petv1.UnimplementedPetServiceServer
+	uc     *biz.PetUsecase
+	logger *log.Helper
+}
+
+// NewPetService 依赖注入构造器
+func NewPetService(uc *biz.PetUsecase, l log.Logger) *PetService {
+	return &PetService{uc: uc, logger: log.NewHelper(l)}
+}
+
+// ListPets 当前用户的全部宠物
+func (s *PetService) ListPets(ctx context.Context, in *petv1.ListPetsRequest) (*petv1.ListPetsReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.uc.List(ctx, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list pets failed: %v", err)
+		return nil, err
+	}
+	out := &petv1.ListPetsReply{List: make([]*petv1.Pet, 0, len(list))}
+	for _, p := range list {
+		out.List = append(out.List, toPetPB(p))
+		if p.Active {
+			out.ActivePetId = p.ID
+		}
+	}
+	return out, nil
+}
+
+// CreatePet 添加宠物
+func (s *PetService) CreatePet(ctx context.Context, in *petv1.CreatePetRequest) (*petv1.CreatePetReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.uc.Create(ctx, &biz.Pet{
+		UserID: userID, Name: in.GetName(), Avatar: in.GetAvatar(), Sex: in.GetSex(), Kind: in.GetKind(),
+		Weight: in.GetWeight(), Hobby: in.GetHobby(), ModelID: in.GetModelId(),
+	}, in.GetActivate())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("create pet failed: %v", err)
+		return nil, err
+	}
+	return &petv1.CreatePetReply{Pet: toPetPB(p)}, nil
+}
+
+// UpdatePet 修改宠物资料
+func (s *PetService) UpdatePet(ctx context.Context, in *petv1.UpdatePetRequest) (*petv1.UpdatePetReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.uc.Update(ctx, &biz.Pet{
+		ID: in.GetId(), UserID: userID, Name: in.GetName(), Avatar: in.GetAvatar(), Sex: in.GetSex(), Kind: in.GetKind(),
+		Weight: in.GetWeight(), Hobby: in.GetHobby(), ModelID: in.GetModelId(),
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("update pet failed: %v", err)
+		return nil, err
+	}
+	return &petv1.UpdatePetReply{Pet: toPetPB(p)}, nil
+}
+
+// DeletePet 删除宠物及其聊天记录
+func (s *PetService) DeletePet(ctx context.Context, in *petv1.DeletePetRequest) (*petv1.DeletePetReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	activeID, err := s.uc.Delete(ctx, userID, in.GetId())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("delete pet failed: %v", err)
+		return nil, err
+	}
+	return &petv1.DeletePetReply{Success: true, ActivePetId: activeID}, nil
+}
+
+// SetActivePet 切换当前宠物
+func (s *PetService) SetActivePet(ctx context.Context, in *petv1.SetActivePetRequest) (*petv1.SetActivePetReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.uc.SetActive(ctx, userID, in.GetId())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("set active pet failed: %v", err)
+		return nil, err
+	}
+	return &petv1.SetActivePetReply{Pet: toPetPB(p)}, nil
+}
+
+func toPetPB(p *biz.Pet) *petv1.Pet {
+	return &petv1.Pet{
+		Id: p.ID, Name: p.Name, Avatar: p.Avatar, Sex: p.Sex, Kind: p.Kind, Weight: p.Weight, Hobby: p.Hobby,
+		ModelId: p.ModelID, ModelUrl: p.ModelURL, Active: p.Active,
+		CreatedAt: p.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	reminderv1 "pet-angel/api/reminder/v1"
+	"pet-angel/internal/auth"
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ReminderService 护理提醒：增删改查与启用/停用（到点发送由 biz.ReminderScheduler 负责）
+
+type ReminderService struct {
+	reminderv1.UnimplementedReminderServiceServer
+	uc     *biz.ReminderUsecase
+	logger *log.Helper
+}
+
+// NewReminderService 依赖注入构造器
+func NewReminderService(uc *biz.ReminderUsecase, l log.Logger) *ReminderService {
+	return &ReminderService{uc: uc, logger: log.NewHelper(l)}
+}
+
+// CreateReminder 创建提醒
+func (s *ReminderService) CreateReminder(ctx context.Context, in *reminderv1.CreateReminderRequest) (*reminderv1.ReminderReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.uc.Create(ctx, &biz.Reminder{
+		UserID: userID, PetID: in.GetPetId(), Kind: in.GetKind(), Title: in.GetTitle(), Note: in.GetNote(),
+		RRule: in.GetRrule(), Timezone: in.GetTimezone(), StartAt: in.GetStartAt(),
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("create reminder failed: %v", err)
+		return nil, err
+	}
+	return &reminderv1.ReminderReply{Reminder: toReminderPB(r)}, nil
+}
+
+// ListReminders 提醒列表
+func (s *ReminderService) ListReminders(ctx context.Context, in *reminderv1.ListRemindersRequest) (*reminderv1.ListRemindersReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.uc.List(ctx, userID, in.GetPetId())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list reminders failed: %v", err)
+		return nil, err
+	}
+	out := &reminderv1.ListRemindersReply{List: make([]*reminderv1.Reminder, 0, len(list))}
+	for _, r := range list {
+		out.List = append(out.List, toReminderPB(r))
+	}
+	return out, nil
+}
+
+// GetReminder 提醒详情
+func (s *ReminderService) GetReminder(ctx context.Context, in *reminderv1.GetReminderRequest) (*reminderv1.ReminderReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.uc.Get(ctx, userID, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &reminderv1.ReminderReply{Reminder: toReminderPB(r)}, nil
+}
+
+// UpdateReminder 修改提醒
+func (s *ReminderService) UpdateReminder(ctx context.Context, in *reminderv1.UpdateReminderRequest) (*reminderv1.ReminderReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.uc.Update(ctx, &biz.Reminder{
+		ID: in.GetId(), UserID: userID, Kind: in.GetKind(), Title: in.GetTitle(), Note: in.GetNote(),
+		RRule: in.GetRrule(), Timezone: in.GetTimezone(), StartAt: in.GetStartAt(),
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("update reminder failed: %v", err)
+		return nil, err
+	}
+	return &reminderv1.ReminderReply{Reminder: toReminderPB(r)}, nil
+}
+
+// SetReminderEnabled 启用/停用提醒
+func (s *ReminderService) SetReminderEnabled(ctx context.Context, in *reminderv1.SetReminderEnabledRequest) (*reminderv1.ReminderReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.uc.SetEnabled(ctx, userID, in.GetId(), in.GetEnabled())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("set reminder enabled failed: %v", err)
+		return nil, err
+	}
+	return &reminderv1.ReminderReply{Reminder: toReminderPB(r)}, nil
+}
+
+// DeleteReminder 删除提醒
+func (s *ReminderService) DeleteReminder(ctx context.Context, in *reminderv1.DeleteReminderRequest) (*reminderv1.DeleteReminderReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.Delete(ctx, userID, in.GetId()); err != nil {
+		s.logger.WithContext(ctx).Errorf("delete reminder failed: %v", err)
+		return nil, err
+	}
+	return &reminderv1.DeleteReminderReply{Success: true}, nil
+}
+
+// toReminderPB 下次/上次提醒时间按提醒自己的时区展示
+func toReminderPB(r *biz.Reminder) *reminderv1.Reminder {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	out := &reminderv1.Reminder{
+		Id: r.ID, PetId: r.PetID, Kind: r.Kind, Title: r.Title, Note: r.Note, Rrule: r.RRule, Timezone: r.Timezone,
+		StartAt: r.StartAt, Enabled: r.Enabled, FiredCount: r.FiredCount, CreatedAt: r.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if r.NextRunAt != nil {
+		out.NextRunAt = r.NextRunAt.In(loc).Format(biz.ReminderStartLayout)
+	}
+	if r.LastRunAt != nil {
+		out.LastRunAt = r.LastRunAt.In(loc).Format(biz.ReminderStartLayout)
+	}
+	return out
+}
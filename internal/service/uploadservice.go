@@ -49,8 +49,9 @@ func saveMultipartFile(file multipart.File, dst string) error {
 
 // ------- 校验配置 -------
 const (
-	maxImageMB = 50
-	maxVideoMB = 1000
+	maxImageMB    = 50
+	maxVideoMB    = 1000
+	maxDocumentMB = 20
 )
 
 var (
@@ -60,6 +61,10 @@ var (
 	allowedVideoMIMEs = map[string]bool{
 		"video/mp4": true, "video/quicktime": true,
 	}
+	// 文档（病历、化验单等）：PDF 或图片
+	allowedDocumentMIMEs = map[string]bool{
+		"application/pdf": true, "image/jpeg": true, "image/png": true, "image/webp": true,
+	}
 )
 
 func mimeToExt(ct string) string {
@@ -76,6 +81,8 @@ func mimeToExt(ct string) string {
 		return ".mp4"
 	case "video/quicktime":
 		return ".mov"
+	case "application/pdf":
+		return ".pdf"
 	default:
 		return ""
 	}
@@ -122,10 +129,13 @@ func (s *UploadService) UploadFile(ctx context.Context, in *uploadv1.UploadFileR
 	// 归一化：avatar 视作 image 类目保存
 	isAvatar := category == "avatar"
 	isVideo := category == "video"
+	isDocument := category == "document"
 	if isAvatar {
 		category = "avatar"
 	} else if isVideo {
 		category = "video"
+	} else if isDocument {
+		category = "document"
 	} else {
 		category = "image"
 	}
@@ -152,6 +162,13 @@ func (s *UploadService) UploadFile(ctx context.Context, in *uploadv1.UploadFileR
 		if header.Size > 0 && header.Size > int64(maxVideoMB)*1024*1024 {
 			return nil, errors.New("video too large")
 		}
+	case "document":
+		if !allowedDocumentMIMEs[contentType] {
+			return nil, errors.New("unsupported document type")
+		}
+		if header.Size > 0 && header.Size > int64(maxDocumentMB)*1024*1024 {
+			return nil, errors.New("document too large")
+		}
 	}
 	subdir := filepath.Join(category, time.Now().Format("2006/01/02"))
 	baseDir := filepath.Join(root, subdir)
@@ -228,6 +245,8 @@ func (s *UploadService) UploadFileHTTP() http.HandlerFunc {
 			category = "avatar"
 		} else if category == "video" {
 			category = "video"
+		} else if category == "document" {
+			category = "document"
 		} else {
 			category = "image"
 		}
@@ -271,6 +290,15 @@ func (s *UploadService) UploadFileHTTP() http.HandlerFunc {
 				http.Error(w, "Video too large", http.StatusBadRequest)
 				return
 			}
+		case "document":
+			if !allowedDocumentMIMEs[contentType] {
+				http.Error(w, "Unsupported document type", http.StatusBadRequest)
+				return
+			}
+			if header.Size > 0 && header.Size > int64(maxDocumentMB)*1024*1024 {
+				http.Error(w, "Document too large", http.StatusBadRequest)
+				return
+			}
 		}
 
 		// 生成文件路径
@@ -4,58 +4,33 @@ import (
 	"context"
 
 	pb "pet-angel/api/user/v1"
+	"pet-angel/internal/auth"
 	"pet-angel/internal/biz"
-	"pet-angel/internal/conf"
 	"pet-angel/internal/util"
-	jwtutil "pet-angel/internal/util/jwt"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/transport"
 )
 
 // UserService 提供用户关系与主页相关接口的服务适配层
 // 责任：
-// - 读取 JWT 解析当前用户 ID
+// - 从 context 读取当前登录用户（由鉴权中间件写入）
 // - 参数校验与类型适配
 // - 调用 usecase 并将领域对象映射为 proto 返回体
 
 type UserService struct {
 	pb.UnimplementedUserServiceServer
-	uc        *biz.UserUsecase
-	jwtSecret string
-	logger    *log.Helper
+	uc     *biz.UserUsecase
+	logger *log.Helper
 }
 
 // NewUserService 创建 UserService
-func NewUserService(uc *biz.UserUsecase, cfg *conf.Auth, l log.Logger) *UserService {
-	secret := ""
-	if cfg != nil {
-		secret = cfg.JwtSecret
-	}
-	return &UserService{uc: uc, jwtSecret: secret, logger: log.NewHelper(l)}
-}
-
-// userID 从请求上下文解析当前登录用户 ID（demo模式放开校验）
-func (s *UserService) userID(ctx context.Context) (int64, error) {
-	// demo模式：如果没有token或token无效，返回默认用户ID
-	ts, ok := transport.FromServerContext(ctx)
-	if !ok {
-		return 1, nil // 默认用户ID
-	}
-	tok, err := jwtutil.FromAuthHeader(ts.RequestHeader().Get("Authorization"))
-	if err != nil {
-		return 1, nil // 默认用户ID
-	}
-	claims, err := jwtutil.Parse(s.jwtSecret, tok)
-	if err != nil {
-		return 1, nil // 默认用户ID
-	}
-	return claims.UserID, nil
+func NewUserService(uc *biz.UserUsecase, l log.Logger) *UserService {
+	return &UserService{uc: uc, logger: log.NewHelper(l)}
 }
 
 // FollowUser 关注用户
 func (s *UserService) FollowUser(ctx context.Context, req *pb.FollowUserRequest) (*pb.FollowUserReply, error) {
-	uid, err := s.userID(ctx)
+	uid, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("follow user: auth failed: %v", err)
 		return nil, err
@@ -69,7 +44,7 @@ func (s *UserService) FollowUser(ctx context.Context, req *pb.FollowUserRequest)
 
 // UnfollowUser 取消关注
 func (s *UserService) UnfollowUser(ctx context.Context, req *pb.UnfollowUserRequest) (*pb.UnfollowUserReply, error) {
-	uid, err := s.userID(ctx)
+	uid, err := auth.UserID(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Errorf("unfollow user: auth failed: %v", err)
 		return nil, err
@@ -83,7 +58,7 @@ func (s *UserService) UnfollowUser(ctx context.Context, req *pb.UnfollowUserRequ
 
 // GetUserProfile 获取用户主页信息
 func (s *UserService) GetUserProfile(ctx context.Context, req *pb.GetUserProfileRequest) (*pb.GetUserProfileReply, error) {
-	viewer, _ := s.userID(ctx)
+	viewer := auth.ViewerID(ctx)
 
 	u, posts, isFollowed, err := s.uc.GetProfile(ctx, viewer, req.GetUserId())
 	if err != nil {
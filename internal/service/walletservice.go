@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+
+	walletv1 "pet-angel/api/wallet/v1"
+	"pet-angel/internal/auth"
+	"pet-angel/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// WalletService 钱包服务：金币余额、交易记录与每日签到
+
+type WalletService struct {
+	walletv1.UnimplementedWalletServiceServer
+	uc      *biz.WalletUsecase
+	checkIn *biz.CheckInUsecase
+	logger  *log.Helper
+}
+
+// NewWalletService 依赖注入构造器
+func NewWalletService(uc *biz.WalletUsecase, checkIn *biz.CheckInUsecase, l log.Logger) *WalletService {
+	return &WalletService{uc: uc, checkIn: checkIn, logger: log.NewHelper(l)}
+}
+
+// GetBalance 当前用户金币余额
+func (s *WalletService) GetBalance(ctx context.Context, in *walletv1.GetBalanceRequest) (*walletv1.GetBalanceReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	coins, err := s.uc.Balance(ctx, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("get balance failed: %v", err)
+		return nil, err
+	}
+	return &walletv1.GetBalanceReply{Coins: coins}, nil
+}
+
+// ListTransactions 当前用户金币流水（按时间倒序，游标分页）
+func (s *WalletService) ListTransactions(ctx context.Context, in *walletv1.ListTransactionsRequest) (*walletv1.ListTransactionsReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, next, err := s.uc.ListTransactions(ctx, userID, in.GetCursor(), in.GetPageSize(), in.GetReason())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("list transactions failed: %v", err)
+		return nil, err
+	}
+	out := make([]*walletv1.CoinTransaction, 0, len(list))
+	for _, t := range list {
+		out = append(out, &walletv1.CoinTransaction{
+			Id:             t.ID,
+			Amount:         t.Amount,
+			Balance:        t.Balance,
+			Reason:         t.Reason,
+			RefType:        t.RefType,
+			RefId:          t.RefID,
+			RefTitle:       t.RefTitle,
+			CounterpartyId: t.CounterpartyID,
+			Migrated:       t.Migrated,
+			CreatedAt:      t.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return &walletv1.ListTransactionsReply{List: out, NextCursor: next}, nil
+}
+
+// CheckIn 每日签到
+func (s *WalletService) CheckIn(ctx context.Context, in *walletv1.CheckInRequest) (*walletv1.CheckInReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.checkIn.CheckIn(ctx, userID, in.GetTimezone())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("check in failed: %v", err)
+		return nil, err
+	}
+	return &walletv1.CheckInReply{
+		Date:             res.Date,
+		Streak:           res.Streak,
+		Reward:           res.Reward,
+		Coins:            res.Coins,
+		AlreadyCheckedIn: res.AlreadyCheckedIn,
+	}, nil
+}
+
+// GetCheckInStatus 签到状态与本月日历
+func (s *WalletService) GetCheckInStatus(ctx context.Context, in *walletv1.GetCheckInStatusRequest) (*walletv1.GetCheckInStatusReply, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.checkIn.Status(ctx, userID, in.GetTimezone())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("get check-in status failed: %v", err)
+		return nil, err
+	}
+	days := make([]*walletv1.CheckInDay, 0, len(st.Calendar))
+	for _, d := range st.Calendar {
+		days = append(days, &walletv1.CheckInDay{Date: d.Date, CheckedIn: d.CheckedIn, Reward: d.Reward})
+	}
+	return &walletv1.GetCheckInStatusReply{
+		Today:          st.Today,
+		Streak:         st.Streak,
+		CheckedInToday: st.CheckedInToday,
+		NextReward:     st.NextReward,
+		Calendar:       days,
+	}, nil
+}
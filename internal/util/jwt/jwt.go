@@ -1,6 +1,8 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"strings"
 	"time"
@@ -8,53 +10,56 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var globalSecret string
-
-// SetGlobalSecret 设置全局JWT密钥（由服务初始化时设置）
-func SetGlobalSecret(secret string) { globalSecret = secret }
-
 // Claims 自定义Claims
 type Claims struct {
-	UserID int64 `json:"uid"`
+	UserID    int64    `json:"uid"`
+	SessionID int64    `json:"sid,omitempty"`   // 所属登录会话（user_sessions.id）
+	Roles     []string `json:"roles,omitempty"` // 用户角色（user/admin）
 	jwt.RegisteredClaims
 }
 
-// Sign 生成 JWT 字符串
-func Sign(secret string, userID int64, ttl time.Duration) (string, time.Time, error) {
+// NewTokenID 生成随机 token ID（jti），用于服务端吊销
+func NewTokenID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// NewClaims 构造绑定登录会话的 Claims（sid 为 0 表示不绑定会话）
+func NewClaims(userID, sessionID int64, jti string, ttl time.Duration) *Claims {
 	now := time.Now()
-	exp := now.Add(ttl)
-	claims := &Claims{
-		UserID: userID,
+	return &Claims{
+		UserID:    userID,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(exp),
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
 			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	s, err := token.SignedString([]byte(secret))
-	return s, exp, err
 }
 
-// Parse 使用指定secret解析
+// Sign 使用单个 HS256 密钥生成 JWT 字符串（自动生成 jti，不绑定会话；用于脚本与测试）
+func Sign(secret string, userID int64, ttl time.Duration) (string, time.Time, error) {
+	return SignSession(secret, userID, 0, NewTokenID(), ttl)
+}
+
+// SignSession 使用单个 HS256 密钥生成绑定登录会话的 JWT 字符串（指定 sid 与 jti）
+func SignSession(secret string, userID, sessionID int64, jti string, ttl time.Duration) (string, time.Time, error) {
+	return hmacKeyring(secret).SignSession(userID, sessionID, jti, ttl)
+}
+
+// Parse 使用单个 HS256 密钥解析
 func Parse(secret, tokenString string) (*Claims, error) {
-	tk, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secret), nil
-	})
-	if err != nil {
-		return nil, err
-	}
-	if claims, ok := tk.Claims.(*Claims); ok && tk.Valid {
-		return claims, nil
-	}
-	return nil, errors.New("invalid token")
+	return hmacKeyring(secret).Parse(tokenString)
 }
 
-// ParseSecretFromCtx 使用全局密钥解析
-func ParseSecretFromCtx(_ interface{}, tokenString string) (*Claims, error) {
-	if globalSecret == "" {
-		return nil, errors.New("jwt secret not initialized")
+func hmacKeyring(secret string) *Keyring {
+	k, err := NewHMACKey("", secret)
+	if err != nil {
+		return &Keyring{}
 	}
-	return Parse(globalSecret, tokenString)
+	return &Keyring{keys: []*Key{k}}
 }
 
 // FromAuthHeader 提取 Bearer Token
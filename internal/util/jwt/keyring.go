@@ -0,0 +1,178 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 支持的签名算法
+const (
+	AlgHS256 = "HS256"
+	AlgES256 = "ES256"
+	AlgRS256 = "RS256"
+)
+
+var (
+	ErrNoSigningKey = errors.New("jwt: no signing key configured")
+	ErrUnknownKey   = errors.New("jwt: unknown key id")
+)
+
+// Key 一个签名/验签密钥
+// HS256 使用同一个对称密钥；ES256/RS256 只有公钥时为仅验签密钥（用于下线中的旧私钥）
+type Key struct {
+	ID     string // kid，写入 token header
+	Alg    string // HS256 | ES256 | RS256
+	sign   interface{}
+	verify interface{}
+}
+
+// CanSign 是否持有可用于签发的私钥/对称密钥
+func (k *Key) CanSign() bool { return k.sign != nil }
+
+func (k *Key) method() jwt.SigningMethod { return jwt.GetSigningMethod(k.Alg) }
+
+// NewHMACKey 构造 HS256 对称密钥
+func NewHMACKey(kid, secret string) (*Key, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("jwt: key %q: empty secret", kid)
+	}
+	return &Key{ID: kid, Alg: AlgHS256, sign: []byte(secret), verify: []byte(secret)}, nil
+}
+
+// NewPEMKey 从 PEM 构造 ES256/RS256 密钥
+// privatePEM 为空时只用于验签（此时必须提供 publicPEM）；提供私钥时公钥由私钥推出
+func NewPEMKey(kid, alg string, privatePEM, publicPEM []byte) (*Key, error) {
+	k := &Key{ID: kid, Alg: alg}
+	var err error
+	switch alg {
+	case AlgES256:
+		if len(privatePEM) > 0 {
+			var priv *ecdsa.PrivateKey
+			if priv, err = jwt.ParseECPrivateKeyFromPEM(privatePEM); err == nil {
+				k.sign, k.verify = priv, &priv.PublicKey
+			}
+		} else if len(publicPEM) > 0 {
+			k.verify, err = jwt.ParseECPublicKeyFromPEM(publicPEM)
+		}
+		if pub, ok := k.verify.(*ecdsa.PublicKey); ok && pub.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("jwt: key %q: ES256 requires a P-256 key", kid)
+		}
+	case AlgRS256:
+		if len(privatePEM) > 0 {
+			var priv *rsa.PrivateKey
+			if priv, err = jwt.ParseRSAPrivateKeyFromPEM(privatePEM); err == nil {
+				k.sign, k.verify = priv, &priv.PublicKey
+			}
+		} else if len(publicPEM) > 0 {
+			k.verify, err = jwt.ParseRSAPublicKeyFromPEM(publicPEM)
+		}
+	default:
+		return nil, fmt.Errorf("jwt: key %q: unsupported alg %q", kid, alg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jwt: key %q: %w", kid, err)
+	}
+	if k.verify == nil {
+		return nil, fmt.Errorf("jwt: key %q: no private or public key", kid)
+	}
+	return k, nil
+}
+
+// Keyring 多密钥签发与校验
+// - 签发：使用最新（列表中最后一个）可签发的密钥，并在 header 写入 kid
+// - 校验：按 kid 查找密钥；无 kid 的旧 token 依次尝试同算法的密钥
+// 轮换流程：追加新密钥 -> 发布 -> 等旧 token 过期后移除旧密钥，期间已登录用户不受影响
+type Keyring struct {
+	keys []*Key
+}
+
+// NewKeyring 按时间先后传入密钥（最后一个可签发的密钥用于签发），kid 不可重复
+func NewKeyring(keys ...*Key) (*Keyring, error) {
+	seen := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		if seen[k.ID] {
+			return nil, fmt.Errorf("jwt: duplicate key id %q", k.ID)
+		}
+		seen[k.ID] = true
+	}
+	return &Keyring{keys: keys}, nil
+}
+
+// SigningKey 当前用于签发的密钥
+func (r *Keyring) SigningKey() (*Key, error) {
+	for i := len(r.keys) - 1; i >= 0; i-- {
+		if r.keys[i].CanSign() {
+			return r.keys[i], nil
+		}
+	}
+	return nil, ErrNoSigningKey
+}
+
+// SignSession 生成绑定登录会话的 JWT 字符串（指定 sid 与 jti）
+func (r *Keyring) SignSession(userID, sessionID int64, jti string, ttl time.Duration) (string, time.Time, error) {
+	claims := NewClaims(userID, sessionID, jti, ttl)
+	s, err := r.Sign(claims)
+	return s, claims.ExpiresAt.Time, err
+}
+
+// Sign 使用当前签发密钥签名
+func (r *Keyring) Sign(claims *Claims) (string, error) {
+	k, err := r.SigningKey()
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(k.method(), claims)
+	if k.ID != "" {
+		token.Header["kid"] = k.ID
+	}
+	return token.SignedString(k.sign)
+}
+
+// Parse 校验签名与有效期
+func (r *Keyring) Parse(tokenString string) (*Claims, error) {
+	var lastErr error = ErrUnknownKey
+	for _, k := range r.candidates(tokenString) {
+		claims := &Claims{}
+		tk, err := jwt.ParseWithClaims(tokenString, claims, func(*jwt.Token) (interface{}, error) {
+			return k.verify, nil
+		}, jwt.WithValidMethods([]string{k.Alg}))
+		if err == nil && tk.Valid {
+			return claims, nil
+		}
+		if err == nil {
+			err = errors.New("invalid token")
+		}
+		lastErr = err
+		// 签名正确但已过期等情况无需再尝试其它密钥
+		if !errors.Is(err, jwt.ErrTokenSignatureInvalid) && !errors.Is(err, jwt.ErrTokenUnverifiable) {
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+// candidates 根据 header 中的 kid/alg 选出待尝试的密钥
+func (r *Keyring) candidates(tokenString string) []*Key {
+	tk, _, err := jwt.NewParser().ParseUnverified(tokenString, &Claims{})
+	if err != nil {
+		return nil
+	}
+	kid, _ := tk.Header["kid"].(string)
+	alg, _ := tk.Header["alg"].(string)
+	var out []*Key
+	for _, k := range r.keys {
+		if kid != "" && k.ID == kid {
+			return []*Key{k}
+		}
+		if kid == "" && k.Alg == alg {
+			out = append(out, k)
+		}
+	}
+	return out
+}